@@ -25,6 +25,7 @@ func main() {
 		pluginManager.GetCustomResourceData,
 		pluginManager.DeleteCustomResource,
 		pluginManager.GetCustomResourceInfo,
+		pluginManager.PatchCustomResource,
 		func(resourceType string) bool {
 			for _, rt := range pluginManager.GetRegistry().GetCustomResourceTypes() {
 				if rt.Type == resourceType {
@@ -41,6 +42,7 @@ func main() {
 			logger.Error(fmt.Sprintf("Panic recovered: %v", r))
 			debug.PrintStack()
 		}
+		resources.PortForwards.StopAll()
 		if err := pluginManager.Shutdown(); err != nil {
 			logger.Error(fmt.Sprintf("Plugin shutdown error: %v", err))
 		}
@@ -7,6 +7,9 @@ import (
 
 type Config struct {
 	KubeconfigPath string
+	Context        string
+	Cluster        string
+	User           string
 	Namespace      string
 	PluginDir      string
 }
@@ -20,7 +23,10 @@ func ParseFlags() Config {
 		defaultPluginDir = appConfig.PluginDir
 	}
 
-	flag.StringVar(&cfg.KubeconfigPath, "kubeconfig", "", "path to the kubeconfig file")
+	flag.StringVar(&cfg.KubeconfigPath, "kubeconfig", "", "path to the kubeconfig file (defaults to the merged KUBECONFIG paths)")
+	flag.StringVar(&cfg.Context, "context", "", "kubeconfig context to use")
+	flag.StringVar(&cfg.Cluster, "cluster", "", "kubeconfig cluster to use instead of the context's")
+	flag.StringVar(&cfg.User, "user", "", "kubeconfig user to use instead of the context's")
 	flag.StringVar(&cfg.Namespace, "namespace", "", "namespace to use")
 	flag.StringVar(&cfg.PluginDir, "plugin-dir", defaultPluginDir, "directory containing plugin files")
 
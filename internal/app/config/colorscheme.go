@@ -23,6 +23,7 @@ type ColorScheme struct {
 	HelpTextColor       string `json:"help_text_color,omitempty"`
 	HeaderValueColor    string `json:"header_value_color,omitempty"`
 	HeaderLoadingColor  string `json:"header_loading_color,omitempty"`
+	WarningColor        string `json:"warning_color,omitempty"`
 }
 
 type AppConfig struct {
@@ -31,6 +32,12 @@ type AppConfig struct {
 	AutoRefresh      bool              `json:"auto_refresh"`
 	DefaultNamespace string            `json:"default_namespace"`
 	PluginDir        string            `json:"plugin_dir,omitempty"`
+	ExportDir        string            `json:"export_dir,omitempty"`
+	DebugImage       string            `json:"debug_image,omitempty"`
+	DebugImages      []string          `json:"debug_images,omitempty"`
+	DrainGracePeriod int               `json:"drain_grace_period_seconds,omitempty"`
+	DrainTimeout     int               `json:"drain_timeout_seconds,omitempty"`
+	RequestTimeout   int               `json:"request_timeout_seconds,omitempty"`
 	KeyBindings      map[string]string `json:"key_bindings,omitempty"`
 }
 
@@ -49,6 +56,7 @@ func DefaultColorScheme() ColorScheme {
 		HelpTextColor:       "#757575",
 		HeaderValueColor:    "#A1EFD3",
 		HeaderLoadingColor:  "#FFA500",
+		WarningColor:        "#FFD700",
 	}
 }
 
@@ -126,6 +134,9 @@ func LoadColorScheme() (ColorScheme, error) {
 	if loadedScheme.HeaderLoadingColor != "" {
 		scheme.HeaderLoadingColor = loadedScheme.HeaderLoadingColor
 	}
+	if loadedScheme.WarningColor != "" {
+		scheme.WarningColor = loadedScheme.WarningColor
+	}
 
 	return scheme, nil
 }
@@ -137,15 +148,23 @@ func DefaultAppConfig() AppConfig {
 		AutoRefresh:      true,
 		DefaultNamespace: "default",
 		PluginDir:        "~/.local/share/k8s-tui/plugins",
+		ExportDir:        "~/.local/share/k8s-tui/exports",
+		DebugImage:       "busybox:latest",
+		DebugImages:      []string{"busybox:latest", "nicolaka/netshoot:latest", "alpine:latest"},
+		DrainTimeout:     300,
+		RequestTimeout:   30,
 		KeyBindings: map[string]string{
-			"quit":      "q",
-			"help":      "?",
-			"refresh":   "r",
-			"back":      "[",
-			"forward":   "]",
-			"new_tab":   "ctrl+t",
-			"close_tab": "ctrl+w",
-			"quick_nav": "g",
+			"quit":             "q",
+			"help":             "?",
+			"refresh":          "r",
+			"back":             "[",
+			"forward":          "]",
+			"new_tab":          "ctrl+t",
+			"close_tab":        "ctrl+w",
+			"quick_nav":        "g",
+			"create":           "ctrl+n",
+			"switch_context":   "ctrl+k",
+			"switch_namespace": "ctrl+p",
 		},
 	}
 }
@@ -194,17 +213,57 @@ func LoadAppConfig() (AppConfig, error) {
 	if loadedConfig.PluginDir == "" {
 		loadedConfig.PluginDir = config.PluginDir
 	}
+	if loadedConfig.ExportDir == "" {
+		loadedConfig.ExportDir = config.ExportDir
+	}
 
-	if strings.HasPrefix(loadedConfig.PluginDir, "~/") {
-		homeDir, err := os.UserHomeDir()
-		if err == nil {
-			loadedConfig.PluginDir = filepath.Join(homeDir, loadedConfig.PluginDir[2:])
-		}
+	if len(loadedConfig.DebugImages) == 0 {
+		loadedConfig.DebugImages = config.DebugImages
+	}
+	if loadedConfig.DebugImage == "" {
+		loadedConfig.DebugImage = loadedConfig.DebugImages[0]
+	}
+	if loadedConfig.DrainTimeout <= 0 {
+		loadedConfig.DrainTimeout = config.DrainTimeout
 	}
+	if loadedConfig.RequestTimeout <= 0 {
+		loadedConfig.RequestTimeout = config.RequestTimeout
+	}
+
+	loadedConfig.PluginDir = ExpandHome(loadedConfig.PluginDir)
+	loadedConfig.ExportDir = ExpandHome(loadedConfig.ExportDir)
 
 	return loadedConfig, nil
 }
 
+func (c AppConfig) DebugImageChoices() []string {
+	choices := []string{}
+	seen := map[string]bool{}
+	for _, image := range append([]string{c.DebugImage}, c.DebugImages...) {
+		if image == "" || seen[image] {
+			continue
+		}
+		seen[image] = true
+		choices = append(choices, image)
+	}
+	return choices
+}
+
+func TemplatesDir() string {
+	return filepath.Join(os.Getenv("HOME"), ".config", "k8s-tui", "templates")
+}
+
+func ExpandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(homeDir, path[2:])
+}
+
 func setupThemes() error {
 	themesDir := filepath.Join(os.Getenv("HOME"), ".local", "share", "k8s-tui", "themes")
 
@@ -58,3 +58,15 @@ func TestDefaultColorScheme(t *testing.T) {
 		t.Errorf("Default BackgroundColor should be #000000, got %s", scheme.BackgroundColor)
 	}
 }
+
+func TestDebugImageChoices(t *testing.T) {
+	config := AppConfig{
+		DebugImage:  "nicolaka/netshoot:latest",
+		DebugImages: []string{"busybox:latest", "nicolaka/netshoot:latest", ""},
+	}
+
+	choices := config.DebugImageChoices()
+	if len(choices) != 2 || choices[0] != "nicolaka/netshoot:latest" || choices[1] != "busybox:latest" {
+		t.Errorf("Expected the default image first without duplicates, got %v", choices)
+	}
+}
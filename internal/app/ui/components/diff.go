@@ -0,0 +1,45 @@
+package components
+
+import (
+	"strings"
+
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func NewDiffViewer(title, content, helpText string) *YAMLViewer {
+	if content == "" {
+		content = "No differences"
+	}
+	viewer := NewYAMLViewerWithHelp(title, content, helpText)
+	viewer.view = newTextSearchView(content, renderDiffLine)
+	viewer.exportExt = ".diff"
+
+	var rendered strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		rendered.WriteString(renderDiffLine(TextLine(line), Highlighter{}) + "\n")
+	}
+	viewer.content = rendered.String()
+	return viewer
+}
+
+func renderDiffLine(line SearchLine, highlighter Highlighter) string {
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color(customstyles.TextColor)).Background(background)
+
+	text := line.SearchText()
+	switch {
+	case strings.HasPrefix(text, "+++"), strings.HasPrefix(text, "---"):
+		style = style.Bold(true)
+	case strings.HasPrefix(text, "@@"):
+		style = style.Foreground(lipgloss.Color(customstyles.AccentColor))
+	case strings.HasPrefix(text, "+"):
+		style = style.Foreground(lipgloss.Color(customstyles.HeaderValueColor))
+	case strings.HasPrefix(text, "-"):
+		style = style.Foreground(lipgloss.Color(customstyles.ErrorColor))
+	}
+
+	return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, highlighter.Render(text, style), lipgloss.WithWhitespaceBackground(background))
+}
@@ -0,0 +1,84 @@
+package components
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type ExportMsg struct {
+	Path string
+	Err  error
+}
+
+func ExportFileName(ext string, parts ...string) string {
+	var cleaned []string
+	for _, part := range parts {
+		if part = sanitizeExportPart(part); part != "" {
+			cleaned = append(cleaned, part)
+		}
+	}
+	cleaned = append(cleaned, time.Now().Format("20060102-150405"))
+	return strings.Join(cleaned, "_") + ext
+}
+
+func ExportCmd(fileName string, write func(w io.Writer) error) tea.Cmd {
+	return func() tea.Msg {
+		path, err := WriteExport(fileName, write)
+		return ExportMsg{Path: path, Err: err}
+	}
+}
+
+func ExportDir() string {
+	appConfig, err := config.LoadAppConfig()
+	if err != nil {
+		appConfig = config.DefaultAppConfig()
+	}
+	return config.ExpandHome(appConfig.ExportDir)
+}
+
+func WriteExport(fileName string, write func(w io.Writer) error) (string, error) {
+	dir := ExportDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %v", err)
+	}
+
+	path := filepath.Join(dir, fileName)
+	file, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create export file: %v", err)
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		return path, fmt.Errorf("failed to write export: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return path, fmt.Errorf("failed to write export: %v", err)
+	}
+	return path, nil
+}
+
+func exportNotice(msg ExportMsg) string {
+	if msg.Err != nil {
+		return "export failed: " + msg.Err.Error()
+	}
+	return "saved " + msg.Path
+}
+
+func sanitizeExportPart(part string) string {
+	part = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		}
+		return '-'
+	}, strings.TrimSpace(part))
+	return strings.Trim(part, "-.")
+}
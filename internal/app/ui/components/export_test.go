@@ -0,0 +1,62 @@
+package components
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExportFileName(t *testing.T) {
+	name := ExportFileName(".log", "kind-dev", "default", "", "web/app:1")
+
+	if !strings.HasPrefix(name, "kind-dev_default_web-app-1_") {
+		t.Errorf("Unexpected export file name prefix: %s", name)
+	}
+	if !strings.HasSuffix(name, ".log") {
+		t.Errorf("Expected .log extension, got %s", name)
+	}
+	if strings.ContainsAny(name, "/: ") {
+		t.Errorf("Expected file name to be sanitized, got %s", name)
+	}
+}
+
+func TestWriteExport(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	path, err := WriteExport("test.yaml", func(w io.Writer) error {
+		_, err := io.WriteString(w, "kind: Pod\n")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedDir := filepath.Join(home, ".local", "share", "k8s-tui", "exports")
+	if filepath.Dir(path) != expectedDir {
+		t.Errorf("Expected export under %s, got %s", expectedDir, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read export: %v", err)
+	}
+	if string(data) != "kind: Pod\n" {
+		t.Errorf("Unexpected export content %q", string(data))
+	}
+}
+
+func TestExportDirWithMalformedConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	configDir := filepath.Join(home, ".config", "k8s-tui")
+	os.MkdirAll(configDir, 0755)
+	os.WriteFile(filepath.Join(configDir, "config.json"), []byte("{not json"), 0644)
+
+	expectedDir := filepath.Join(home, ".local", "share", "k8s-tui", "exports")
+	if dir := ExportDir(); dir != expectedDir {
+		t.Errorf("Expected the default export directory %s, got %s", expectedDir, dir)
+	}
+}
@@ -0,0 +1,28 @@
+package components
+
+import (
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type TargetedMsg interface {
+	Target() any
+}
+
+func NewSpinner() spinner.Model {
+	return spinner.New(
+		spinner.WithSpinner(spinner.Dot),
+		spinner.WithStyle(lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.AccentColor)).
+			Background(lipgloss.Color(customstyles.BackgroundColor))),
+	)
+}
+
+func LoadingView(s spinner.Model, text string) string {
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(lipgloss.Color(customstyles.BackgroundColor)).
+		Render(s.View() + " " + text)
+}
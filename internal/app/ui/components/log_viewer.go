@@ -0,0 +1,328 @@
+package components
+
+import (
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const DefaultMaxLogLines = 10000
+
+type LogLevel int
+
+const (
+	LogLevelNone LogLevel = iota
+	LogLevelDebug
+	LogLevelWarn
+	LogLevelError
+)
+
+var (
+	logErrorPattern = regexp.MustCompile(`(?i)\b(error|fatal|panic|critical|crit)\b|^[EF]\d{4} `)
+	logWarnPattern  = regexp.MustCompile(`(?i)\b(warn|warning)\b|^W\d{4} `)
+	logDebugPattern = regexp.MustCompile(`(?i)\b(debug|trace)\b`)
+)
+
+func DetectLogLevel(text string) LogLevel {
+	switch {
+	case logErrorPattern.MatchString(text):
+		return LogLevelError
+	case logWarnPattern.MatchString(text):
+		return LogLevelWarn
+	case logDebugPattern.MatchString(text):
+		return LogLevelDebug
+	}
+	return LogLevelNone
+}
+
+type LogEntry struct {
+	Prefix      string
+	PrefixColor string
+	Text        string
+}
+
+func (e LogEntry) String() string {
+	if e.Prefix == "" {
+		return e.Text
+	}
+	if e.Text == "" {
+		return e.Prefix
+	}
+	return e.Prefix + " " + e.Text
+}
+
+func (e LogEntry) SearchText() string {
+	return e.String()
+}
+
+type LogViewer struct {
+	title      string
+	status     string
+	view       *SearchView
+	pending    []LogEntry
+	maxLines   int
+	paused     bool
+	ready      bool
+	styles     *YAMLViewerStyles
+	customHelp string
+	exportName []string
+	exporter   func(w io.Writer) error
+	notice     string
+}
+
+func NewLogViewer(title string) *LogViewer {
+	view := NewSearchView(styles.ScreenWidth, styles.ScreenHeight-1, renderLogEntry)
+	view.SetMaxLines(DefaultMaxLogLines)
+	view.SetFollow(true)
+
+	return &LogViewer{
+		title:    title,
+		view:     view,
+		maxLines: DefaultMaxLogLines,
+		styles:   DefaultYAMLViewerStyles,
+	}
+}
+
+func (m *LogViewer) SetTitle(title string) {
+	m.title = title
+}
+
+func (m *LogViewer) SetStatus(status string) {
+	m.status = status
+}
+
+func (m *LogViewer) SetCustomHelp(helpText string) {
+	m.customHelp = helpText
+}
+
+func (m *LogViewer) SetExportName(parts ...string) {
+	m.exportName = parts
+}
+
+func (m *LogViewer) SetExporter(exporter func(w io.Writer) error) {
+	m.exporter = exporter
+}
+
+func (m *LogViewer) SetMaxLines(maxLines int) {
+	m.maxLines = maxLines
+	m.view.SetMaxLines(maxLines)
+}
+
+func (m *LogViewer) Lines() []string {
+	lines := make([]string, 0, len(m.view.Lines())+len(m.pending))
+	for _, line := range m.view.Lines() {
+		lines = append(lines, line.SearchText())
+	}
+	for _, entry := range m.pending {
+		lines = append(lines, entry.String())
+	}
+	return lines
+}
+
+func (m *LogViewer) IsPaused() bool {
+	return m.paused
+}
+
+func (m *LogViewer) CapturingInput() bool {
+	return m.view.CapturingInput()
+}
+
+func (m *LogViewer) TogglePause() {
+	m.paused = !m.paused
+	if !m.paused && len(m.pending) > 0 {
+		pending := m.pending
+		m.pending = nil
+		m.AppendEntries(pending...)
+	}
+}
+
+func (m *LogViewer) AppendLines(lines ...string) {
+	entries := make([]LogEntry, len(lines))
+	for i, line := range lines {
+		entries[i] = LogEntry{Text: line}
+	}
+	m.AppendEntries(entries...)
+}
+
+func (m *LogViewer) AppendEntries(entries ...LogEntry) {
+	if m.paused {
+		m.pending = append(m.pending, entries...)
+		if m.maxLines > 0 && len(m.pending) > m.maxLines {
+			m.pending = m.pending[len(m.pending)-m.maxLines:]
+		}
+		return
+	}
+	lines := make([]SearchLine, len(entries))
+	for i, entry := range entries {
+		lines[i] = entry
+	}
+	m.view.AppendLines(lines...)
+}
+
+func (m *LogViewer) Clear() {
+	m.pending = nil
+	m.view.Clear()
+	m.view.SetFollow(true)
+}
+
+func (m *LogViewer) Init() tea.Cmd {
+	m.view.SetSize(styles.ScreenWidth, styles.ScreenHeight-1)
+	return nil
+}
+
+func (m *LogViewer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if handled, cmd := m.view.HandleKey(msg); handled {
+			return m, cmd
+		}
+		switch msg.String() {
+		case " ":
+			m.TogglePause()
+			return m, nil
+		case "G", "end":
+			m.view.SetFollow(true)
+			return m, nil
+		case "home":
+			m.view.GotoTop()
+			return m, nil
+		case "ctrl+s":
+			return m, m.export()
+		}
+	case ExportMsg:
+		m.notice = exportNotice(msg)
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.view.SetSize(styles.ScreenWidth, styles.ScreenHeight-1)
+		m.ready = true
+	}
+
+	return m, m.view.Update(msg)
+}
+
+func (m *LogViewer) View() string {
+	m.view.SetSize(styles.ScreenWidth, styles.ScreenHeight-1)
+
+	content := lipgloss.NewStyle().
+		Background(lipgloss.Color(customstyles.BackgroundColor)).
+		Render(m.view.View())
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.headerView(),
+		content,
+		m.footerView(),
+	)
+}
+
+func (m *LogViewer) headerView() string {
+	title := m.styles.TitleBar.Background(lipgloss.Color(customstyles.BackgroundColor)).Render(m.title)
+
+	var flags []string
+	if m.paused {
+		flags = append(flags, "PAUSED")
+		if len(m.pending) > 0 {
+			flags[len(flags)-1] += " (" + strconv.Itoa(len(m.pending)) + " new)"
+		}
+	}
+	if m.status != "" {
+		flags = append(flags, m.status)
+	}
+	if m.notice != "" {
+		flags = append(flags, m.notice)
+	}
+	if search := m.view.Status(); search != "" {
+		flags = append(flags, search)
+	}
+	if len(flags) > 0 {
+		status := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+			Background(lipgloss.Color(customstyles.BackgroundColor)).
+			Render("  [" + strings.Join(flags, " • ") + "]")
+		title += status
+	}
+
+	return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, title, lipgloss.WithWhitespaceBackground(lipgloss.Color(customstyles.BackgroundColor)))
+}
+
+func (m *LogViewer) footerView() string {
+	helpText := "↑/↓: Scroll • space: Pause • G: Follow • /: Search • n/N: Next/Prev • &: Filter • !: Exclude • ctrl+s: Export • q: Back"
+	if m.customHelp != "" {
+		helpText = m.customHelp
+	}
+	if prompt := m.view.PromptView(); prompt != "" {
+		helpText = prompt
+	}
+
+	help := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(lipgloss.Color(customstyles.BackgroundColor)).
+		Render(helpText)
+
+	return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, help, lipgloss.WithWhitespaceBackground(lipgloss.Color(customstyles.BackgroundColor)))
+}
+
+func (m *LogViewer) export() tea.Cmd {
+	parts := m.exportName
+	if len(parts) == 0 {
+		parts = []string{m.title}
+	}
+	exporter := m.exporter
+	if exporter == nil {
+		lines := m.Lines()
+		exporter = func(w io.Writer) error {
+			for _, line := range lines {
+				if _, err := io.WriteString(w, line+"\n"); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	m.notice = "exporting..."
+	return ExportCmd(ExportFileName(".log", parts...), exporter)
+}
+
+func renderLogEntry(line SearchLine, highlighter Highlighter) string {
+	entry, ok := line.(LogEntry)
+	if !ok {
+		entry = LogEntry{Text: line.SearchText()}
+	}
+
+	lineStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(lipgloss.Color(customstyles.BackgroundColor))
+	switch DetectLogLevel(entry.Text) {
+	case LogLevelError:
+		lineStyle = lineStyle.Foreground(lipgloss.Color(customstyles.ErrorColor))
+	case LogLevelWarn:
+		lineStyle = lineStyle.Foreground(lipgloss.Color(customstyles.WarningColor))
+	case LogLevelDebug:
+		lineStyle = lineStyle.Foreground(lipgloss.Color(customstyles.HelpTextColor))
+	}
+
+	segments := []StyledText{{Text: entry.Text, Style: lineStyle}}
+	if entry.Prefix != "" {
+		prefixColor := entry.PrefixColor
+		if prefixColor == "" {
+			prefixColor = customstyles.HelpTextColor
+		}
+		prefixStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(prefixColor)).
+			Background(lipgloss.Color(customstyles.BackgroundColor)).
+			Bold(true)
+		segments = []StyledText{{Text: entry.Prefix, Style: prefixStyle}}
+		if entry.Text != "" {
+			segments = append(segments, StyledText{Text: " ", Style: lineStyle}, StyledText{Text: entry.Text, Style: lineStyle})
+		}
+	}
+	rendered := highlighter.RenderSegments(segments...)
+
+	return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, rendered, lipgloss.WithWhitespaceBackground(lipgloss.Color(customstyles.BackgroundColor)))
+}
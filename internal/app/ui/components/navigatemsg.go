@@ -13,6 +13,8 @@ type NavigateMsg struct {
 	Breadcrumb string
 }
 
+type BackMsg struct{}
+
 type RefreshMsg struct{}
 
 type EditMsg struct {
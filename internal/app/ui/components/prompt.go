@@ -0,0 +1,119 @@
+package components
+
+import (
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type PromptModel struct {
+	title       string
+	description string
+	input       textinput.Model
+	validate    func(value string) error
+	keyHandler  func(key, value string) (string, bool)
+	onSubmit    func(value string) tea.Cmd
+	err         error
+}
+
+func NewPrompt(title, description, value string, onSubmit func(value string) tea.Cmd) *PromptModel {
+	input := textinput.New()
+	input.Prompt = "> "
+	input.SetValue(value)
+	input.CursorEnd()
+
+	return &PromptModel{
+		title:       title,
+		description: description,
+		input:       input,
+		onSubmit:    onSubmit,
+	}
+}
+
+func (m *PromptModel) SetValidator(validate func(value string) error) {
+	m.validate = validate
+}
+
+func (m *PromptModel) SetKeyHandler(handler func(key, value string) (string, bool)) {
+	m.keyHandler = handler
+}
+
+func (m *PromptModel) Value() string {
+	return m.input.Value()
+}
+
+func (m *PromptModel) CapturingInput() bool {
+	return true
+}
+
+func (m *PromptModel) Init() tea.Cmd {
+	return m.input.Focus()
+}
+
+func (m *PromptModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "enter":
+			value := m.input.Value()
+			if m.validate != nil {
+				if err := m.validate(value); err != nil {
+					m.err = err
+					return m, nil
+				}
+			}
+			m.err = nil
+			back := func() tea.Msg { return BackMsg{} }
+			if m.onSubmit == nil {
+				return m, back
+			}
+			return m, tea.Sequence(back, m.onSubmit(value))
+		case "esc", "ctrl+c":
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+		if m.keyHandler != nil {
+			if value, ok := m.keyHandler(msg.String(), m.input.Value()); ok {
+				m.input.SetValue(value)
+				m.input.CursorEnd()
+				m.err = nil
+				return m, nil
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *PromptModel) View() string {
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+
+	lines := []string{customstyles.TitleStyle().Render(m.title), ""}
+	if m.description != "" {
+		lines = append(lines, textStyle.Render(m.description), "")
+	}
+	lines = append(lines, textStyle.Render(m.input.View()))
+	if m.err != nil {
+		lines = append(lines, "", lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.ErrorColor)).
+			Background(background).
+			Render(m.err.Error()))
+	}
+	lines = append(lines, "", helpStyle.Render("enter: Confirm • esc: Cancel"))
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
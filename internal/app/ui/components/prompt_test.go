@@ -0,0 +1,79 @@
+package components
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPromptSubmit(t *testing.T) {
+	var submitted string
+	prompt := NewPrompt("Title", "", "3", func(value string) tea.Cmd {
+		submitted = value
+		return nil
+	})
+	prompt.SetValidator(func(value string) error {
+		if value == "" {
+			return errors.New("value required")
+		}
+		return nil
+	})
+	prompt.Init()
+
+	prompt.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	_, cmd := prompt.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || prompt.err == nil {
+		t.Fatal("Expected validation to block an empty value")
+	}
+
+	prompt.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+	_, cmd = prompt.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected a command after a valid submit")
+	}
+	if submitted != "5" {
+		t.Errorf("Expected submitted value 5, got %q", submitted)
+	}
+	if prompt.err != nil {
+		t.Errorf("Expected the validation error to be cleared, got %v", prompt.err)
+	}
+}
+
+func TestPromptCancel(t *testing.T) {
+	prompt := NewPrompt("Title", "", "", func(value string) tea.Cmd {
+		t.Error("Expected cancel not to submit")
+		return nil
+	})
+
+	if !prompt.CapturingInput() {
+		t.Error("Expected the prompt to capture input")
+	}
+	_, cmd := prompt.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected a command on cancel")
+	}
+	if _, ok := cmd().(BackMsg); !ok {
+		t.Error("Expected cancel to navigate back")
+	}
+}
+
+func TestPromptKeyHandler(t *testing.T) {
+	prompt := NewPrompt("Title", "", "3", nil)
+	prompt.SetKeyHandler(func(key, value string) (string, bool) {
+		if key == "+" {
+			return value + "0", true
+		}
+		return value, false
+	})
+	prompt.Init()
+
+	prompt.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	if prompt.Value() != "30" {
+		t.Errorf("Expected the key handler to rewrite the value, got %q", prompt.Value())
+	}
+	prompt.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if prompt.Value() != "301" {
+		t.Errorf("Expected unhandled keys to reach the input, got %q", prompt.Value())
+	}
+}
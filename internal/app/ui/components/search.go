@@ -0,0 +1,480 @@
+package components
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type InputCapturer interface {
+	CapturingInput() bool
+}
+
+type SearchLine interface {
+	SearchText() string
+}
+
+type TextLine string
+
+func (l TextLine) SearchText() string {
+	return string(l)
+}
+
+type LineRenderer func(line SearchLine, highlighter Highlighter) string
+
+type Highlighter struct {
+	pattern *regexp.Regexp
+	current bool
+}
+
+type StyledText struct {
+	Text  string
+	Style lipgloss.Style
+}
+
+func (h Highlighter) Render(text string, base lipgloss.Style) string {
+	return h.RenderSegments(StyledText{Text: text, Style: base})
+}
+
+func (h Highlighter) RenderSegments(segments ...StyledText) string {
+	var full strings.Builder
+	for _, segment := range segments {
+		full.WriteString(segment.Text)
+	}
+
+	var locs [][]int
+	if h.pattern != nil {
+		locs = h.pattern.FindAllStringIndex(full.String(), -1)
+	}
+
+	var b strings.Builder
+	offset := 0
+	for _, segment := range segments {
+		start, end := offset, offset+len(segment.Text)
+		offset = end
+		if segment.Text == "" {
+			continue
+		}
+
+		matchStyle := segment.Style.
+			Foreground(lipgloss.Color(customstyles.SelectionForeground)).
+			Background(lipgloss.Color(customstyles.SelectionBackground))
+		if h.current {
+			matchStyle = matchStyle.Background(lipgloss.Color(customstyles.AccentColor))
+		}
+
+		last := start
+		for _, loc := range locs {
+			from, to := max(loc[0], start), min(loc[1], end)
+			if from >= to {
+				continue
+			}
+			if from > last {
+				b.WriteString(segment.Style.Render(full.String()[last:from]))
+			}
+			b.WriteString(matchStyle.Render(full.String()[from:to]))
+			last = to
+		}
+		if last < end {
+			b.WriteString(segment.Style.Render(full.String()[last:end]))
+		}
+	}
+	return b.String()
+}
+
+type searchPrompt int
+
+const (
+	searchPromptNone searchPrompt = iota
+	searchPromptFind
+	searchPromptInclude
+	searchPromptExclude
+)
+
+type SearchView struct {
+	viewport viewport.Model
+	lines    []SearchLine
+	rendered []string
+	visible  []int
+	matches  []int
+	current  int
+	maxLines int
+	follow   bool
+	render   LineRenderer
+	input    textinput.Model
+	prompt   searchPrompt
+	query    *regexp.Regexp
+	include  *regexp.Regexp
+	exclude  *regexp.Regexp
+	err      error
+}
+
+func NewSearchView(width, height int, render LineRenderer) *SearchView {
+	input := textinput.New()
+	input.Prompt = ""
+
+	return &SearchView{
+		viewport: viewport.New(width, max(height, 0)),
+		current:  -1,
+		render:   render,
+		input:    input,
+	}
+}
+
+func (s *SearchView) SetSize(width, height int) {
+	height = max(height, 0)
+	if width == s.viewport.Width && height == s.viewport.Height {
+		return
+	}
+	widthChanged := width != s.viewport.Width
+	s.viewport.Width = width
+	s.viewport.Height = height
+	if widthChanged {
+		s.rerender()
+	}
+	s.refresh()
+}
+
+func (s *SearchView) SetMaxLines(maxLines int) {
+	s.maxLines = maxLines
+	s.trim()
+	s.refresh()
+}
+
+func (s *SearchView) SetFollow(follow bool) {
+	s.follow = follow
+	if follow {
+		s.viewport.GotoBottom()
+	}
+}
+
+func (s *SearchView) Following() bool {
+	return s.follow
+}
+
+func (s *SearchView) GotoTop() {
+	s.follow = false
+	s.viewport.GotoTop()
+}
+
+func (s *SearchView) Lines() []SearchLine {
+	return s.lines
+}
+
+func (s *SearchView) SetLines(lines []SearchLine) {
+	s.lines = nil
+	s.rendered = nil
+	s.visible = nil
+	s.matches = nil
+	s.current = -1
+	s.AppendLines(lines...)
+}
+
+func (s *SearchView) AppendLines(lines ...SearchLine) {
+	for _, line := range lines {
+		index := len(s.lines)
+		s.lines = append(s.lines, line)
+		s.rendered = append(s.rendered, s.render(line, Highlighter{pattern: s.query}))
+		if s.passesFilter(line) {
+			s.visible = append(s.visible, index)
+			if s.query != nil && s.query.MatchString(line.SearchText()) {
+				s.matches = append(s.matches, index)
+			}
+		}
+	}
+	s.trim()
+	s.refresh()
+}
+
+func (s *SearchView) Clear() {
+	s.SetLines(nil)
+}
+
+func (s *SearchView) CapturingInput() bool {
+	return s.prompt != searchPromptNone
+}
+
+func (s *SearchView) HandleKey(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if s.prompt != searchPromptNone {
+		switch msg.String() {
+		case "enter":
+			s.applyPrompt()
+			return true, nil
+		case "esc", "ctrl+c":
+			s.prompt = searchPromptNone
+			s.input.Blur()
+			return true, nil
+		}
+		var cmd tea.Cmd
+		s.input, cmd = s.input.Update(msg)
+		return true, cmd
+	}
+
+	switch msg.String() {
+	case "/":
+		return true, s.openPrompt(searchPromptFind, s.query)
+	case "&":
+		return true, s.openPrompt(searchPromptInclude, s.include)
+	case "!":
+		return true, s.openPrompt(searchPromptExclude, s.exclude)
+	case "n":
+		s.jump(1)
+		return true, nil
+	case "N":
+		s.jump(-1)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (s *SearchView) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	s.viewport, cmd = s.viewport.Update(msg)
+	if _, ok := msg.(tea.KeyMsg); ok {
+		s.follow = s.viewport.AtBottom()
+	}
+	return cmd
+}
+
+func (s *SearchView) View() string {
+	return s.viewport.View()
+}
+
+func (s *SearchView) PromptView() string {
+	switch s.prompt {
+	case searchPromptFind:
+		return "/" + s.input.View()
+	case searchPromptInclude:
+		return "filter: " + s.input.View()
+	case searchPromptExclude:
+		return "exclude: " + s.input.View()
+	}
+	return ""
+}
+
+func (s *SearchView) Status() string {
+	var status []string
+	if s.err != nil {
+		status = append(status, s.err.Error())
+	}
+	if s.query != nil {
+		position := 0
+		if s.current >= 0 {
+			position = s.current + 1
+		}
+		status = append(status, fmt.Sprintf("/%s %d/%d", displayPattern(s.query), position, len(s.matches)))
+	}
+	if s.include != nil {
+		status = append(status, "filter="+displayPattern(s.include))
+	}
+	if s.exclude != nil {
+		status = append(status, "exclude="+displayPattern(s.exclude))
+	}
+	return strings.Join(status, " • ")
+}
+
+func (s *SearchView) openPrompt(prompt searchPrompt, current *regexp.Regexp) tea.Cmd {
+	s.prompt = prompt
+	s.input.SetValue(displayPattern(current))
+	s.input.CursorEnd()
+	return s.input.Focus()
+}
+
+func (s *SearchView) applyPrompt() {
+	prompt := s.prompt
+	s.prompt = searchPromptNone
+	s.input.Blur()
+
+	pattern, err := compileSearchPattern(s.input.Value())
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.err = nil
+
+	switch prompt {
+	case searchPromptFind:
+		s.current = -1
+		s.query = pattern
+		s.rerender()
+		s.recomputeMatches()
+		s.refresh()
+		s.jumpFromTop()
+	case searchPromptInclude:
+		s.include = pattern
+		s.refilter()
+	case searchPromptExclude:
+		s.exclude = pattern
+		s.refilter()
+	}
+}
+
+func (s *SearchView) passesFilter(line SearchLine) bool {
+	text := line.SearchText()
+	if s.include != nil && !s.include.MatchString(text) {
+		return false
+	}
+	if s.exclude != nil && s.exclude.MatchString(text) {
+		return false
+	}
+	return true
+}
+
+func (s *SearchView) refilter() {
+	s.visible = s.visible[:0]
+	for i, line := range s.lines {
+		if s.passesFilter(line) {
+			s.visible = append(s.visible, i)
+		}
+	}
+	s.setCurrent(-1)
+	s.recomputeMatches()
+	s.refresh()
+}
+
+func (s *SearchView) recomputeMatches() {
+	s.matches = s.matches[:0]
+	if s.query == nil {
+		return
+	}
+	for _, index := range s.visible {
+		if s.query.MatchString(s.lines[index].SearchText()) {
+			s.matches = append(s.matches, index)
+		}
+	}
+}
+
+func (s *SearchView) jumpFromTop() {
+	if len(s.matches) == 0 {
+		s.setCurrent(-1)
+		s.refresh()
+		return
+	}
+	next := 0
+	if s.viewport.YOffset < len(s.visible) {
+		top := s.visible[s.viewport.YOffset]
+		next = sort.SearchInts(s.matches, top) % len(s.matches)
+	}
+	s.setCurrent(next)
+	s.scrollToCurrent()
+}
+
+func (s *SearchView) jump(direction int) {
+	if len(s.matches) == 0 {
+		return
+	}
+	next := 0
+	if s.current >= 0 {
+		next = (s.current + direction + len(s.matches)) % len(s.matches)
+	} else if direction < 0 {
+		next = len(s.matches) - 1
+	}
+	s.setCurrent(next)
+	s.scrollToCurrent()
+}
+
+func (s *SearchView) setCurrent(current int) {
+	previous := s.currentLine()
+	s.current = current
+	if previous >= 0 {
+		s.rendered[previous] = s.render(s.lines[previous], Highlighter{pattern: s.query})
+	}
+	if line := s.currentLine(); line >= 0 {
+		s.rendered[line] = s.render(s.lines[line], Highlighter{pattern: s.query, current: true})
+	}
+}
+
+func (s *SearchView) currentLine() int {
+	if s.current < 0 || s.current >= len(s.matches) {
+		return -1
+	}
+	return s.matches[s.current]
+}
+
+func (s *SearchView) scrollToCurrent() {
+	s.follow = false
+	s.refresh()
+	line := s.currentLine()
+	if line < 0 {
+		return
+	}
+	position := sort.SearchInts(s.visible, line)
+	s.viewport.SetYOffset(max(position-s.viewport.Height/2, 0))
+}
+
+func (s *SearchView) rerender() {
+	current := s.currentLine()
+	for i, line := range s.lines {
+		s.rendered[i] = s.render(line, Highlighter{pattern: s.query, current: i == current})
+	}
+}
+
+func (s *SearchView) trim() {
+	if s.maxLines <= 0 || len(s.lines) <= s.maxLines {
+		return
+	}
+	drop := len(s.lines) - s.maxLines
+	current := s.currentLine()
+
+	s.lines = s.lines[drop:]
+	s.rendered = s.rendered[drop:]
+	s.visible = shiftIndexes(s.visible, drop)
+	s.matches = shiftIndexes(s.matches, drop)
+
+	s.current = -1
+	if current >= drop {
+		s.current = sort.SearchInts(s.matches, current-drop)
+	}
+}
+
+func (s *SearchView) refresh() {
+	content := make([]string, len(s.visible))
+	for i, index := range s.visible {
+		content[i] = s.rendered[index]
+	}
+	s.viewport.SetContent(strings.Join(content, "\n"))
+	if s.follow {
+		s.viewport.GotoBottom()
+	}
+}
+
+func shiftIndexes(indexes []int, drop int) []int {
+	shifted := indexes[:0]
+	for _, index := range indexes {
+		if index >= drop {
+			shifted = append(shifted, index-drop)
+		}
+	}
+	return shifted
+}
+
+func compileSearchPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	expr := pattern
+	if !strings.ContainsFunc(pattern, unicode.IsUpper) {
+		expr = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q", pattern)
+	}
+	return re, nil
+}
+
+func displayPattern(re *regexp.Regexp) string {
+	if re == nil {
+		return ""
+	}
+	return strings.TrimPrefix(re.String(), "(?i)")
+}
@@ -0,0 +1,159 @@
+package components
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func plainRenderer(line SearchLine, highlighter Highlighter) string {
+	return line.SearchText()
+}
+
+func newTestSearchView(lines ...string) *SearchView {
+	view := NewSearchView(80, 5, plainRenderer)
+	searchLines := make([]SearchLine, len(lines))
+	for i, line := range lines {
+		searchLines[i] = TextLine(line)
+	}
+	view.SetLines(searchLines)
+	return view
+}
+
+func typeInto(view *SearchView, keys ...string) {
+	for _, key := range keys {
+		var msg tea.KeyMsg
+		switch key {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		}
+		view.HandleKey(msg)
+	}
+}
+
+func TestSearchView_FindAndNavigate(t *testing.T) {
+	view := newTestSearchView("alpha", "beta", "gamma alpha", "delta", "ALPHA")
+
+	typeInto(view, "/")
+	if !view.CapturingInput() {
+		t.Fatal("Expected search prompt to capture input")
+	}
+	typeInto(view, "a", "l", "p", "h", "a", "enter")
+	if view.CapturingInput() {
+		t.Fatal("Expected prompt to close after enter")
+	}
+
+	if len(view.matches) != 3 {
+		t.Fatalf("Expected 3 case-insensitive matches, got %d", len(view.matches))
+	}
+	if view.currentLine() != 0 {
+		t.Errorf("Expected first match on line 0, got %d", view.currentLine())
+	}
+
+	typeInto(view, "n")
+	if view.currentLine() != 2 {
+		t.Errorf("Expected next match on line 2, got %d", view.currentLine())
+	}
+	typeInto(view, "N", "N")
+	if view.currentLine() != 4 {
+		t.Errorf("Expected previous match to wrap to line 4, got %d", view.currentLine())
+	}
+	if status := view.Status(); status != "/alpha 3/3" {
+		t.Errorf("Unexpected status %q", status)
+	}
+}
+
+func TestSearchView_SmartCase(t *testing.T) {
+	view := newTestSearchView("Error here", "error there")
+
+	typeInto(view, "/", "E", "r", "r", "o", "r", "enter")
+	if len(view.matches) != 1 {
+		t.Errorf("Expected uppercase pattern to be case sensitive, got %d matches", len(view.matches))
+	}
+}
+
+func TestSearchView_Filters(t *testing.T) {
+	view := newTestSearchView("GET /health", "POST /orders", "GET /orders", "DELETE /orders")
+
+	typeInto(view, "&", "o", "r", "d", "e", "r", "s", "enter")
+	if len(view.visible) != 3 {
+		t.Fatalf("Expected include filter to keep 3 lines, got %v", view.visible)
+	}
+
+	typeInto(view, "!", "d", "e", "l", "e", "t", "e", "enter")
+	if len(view.visible) != 2 {
+		t.Fatalf("Expected exclude filter to hide DELETE, got %v", view.visible)
+	}
+
+	view.AppendLines(TextLine("PUT /orders"), TextLine("GET /health"))
+	if len(view.visible) != 3 {
+		t.Errorf("Expected appended lines to be filtered live, got %v", view.visible)
+	}
+
+	typeInto(view, "&")
+	view.input.SetValue("")
+	typeInto(view, "enter")
+	if len(view.visible) != 5 {
+		t.Errorf("Expected clearing the include filter to show 5 lines, got %v", view.visible)
+	}
+}
+
+func TestSearchView_InvalidPattern(t *testing.T) {
+	view := newTestSearchView("one", "two")
+
+	typeInto(view, "&", "(", "enter")
+	if view.err == nil {
+		t.Fatal("Expected an error for an invalid pattern")
+	}
+	if len(view.visible) != 2 {
+		t.Errorf("Expected invalid filter to leave lines visible, got %v", view.visible)
+	}
+}
+
+func TestSearchView_CancelPrompt(t *testing.T) {
+	view := newTestSearchView("one", "two")
+
+	typeInto(view, "/", "o", "esc")
+	if view.CapturingInput() || view.query != nil {
+		t.Error("Expected esc to cancel the prompt without searching")
+	}
+}
+
+func TestSearchView_TrimKeepsMatches(t *testing.T) {
+	view := newTestSearchView("match 1", "other", "match 2")
+	typeInto(view, "/", "m", "a", "t", "c", "h", "enter", "n")
+	if view.currentLine() != 2 {
+		t.Fatalf("Expected current match on line 2, got %d", view.currentLine())
+	}
+
+	view.SetMaxLines(2)
+	if len(view.lines) != 2 || len(view.matches) != 1 {
+		t.Fatalf("Expected trimmed lines and matches, got %d lines %v matches", len(view.lines), view.matches)
+	}
+	if view.currentLine() != 1 {
+		t.Errorf("Expected current match to shift to line 1, got %d", view.currentLine())
+	}
+}
+
+func TestDetectLogLevel(t *testing.T) {
+	tests := map[string]LogLevel{
+		"2024/01/01 ERROR failed to connect":      LogLevelError,
+		`level=error msg="boom"`:                  LogLevelError,
+		"E0102 12:00:00.000000 1 main.go:1] oops": LogLevelError,
+		"WARN disk almost full":                   LogLevelWarn,
+		"W0102 12:00:00.000000 1 main.go:1] hmm":  LogLevelWarn,
+		"debug: cache hit":                        LogLevelDebug,
+		"INFO started":                            LogLevelNone,
+		"no errors found in terror":               LogLevelNone,
+	}
+
+	for line, expected := range tests {
+		if level := DetectLogLevel(line); level != expected {
+			t.Errorf("DetectLogLevel(%q) = %v, expected %v", line, level, expected)
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package components
 
 import (
+	"fmt"
 	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
 	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -26,13 +29,31 @@ type TableModel struct {
 	checkedRows     map[int]bool
 	refreshInterval time.Duration
 	lastRefresh     time.Time
-	refreshFunc     func() ([]table.Row, error)
+	refreshFunc     func() (func() []table.Row, error)
+	refreshErr      error
+	refreshSeq      int
+	refreshQueued   bool
+	spinner         spinner.Model
+	onLoading       func(loading bool)
 	updateActions   map[string]func() tea.Cmd
 }
 
-type loadedTableMsg struct{}
+const skeletonRows = 6
 
-func NewTable(columns []table.Column, colPercent []float64, rows []table.Row, title string, onSelect func(selected string) tea.Msg, selectColumn int, refreshFunc func() ([]table.Row, error), updateActions map[string]func() tea.Cmd) *TableModel {
+var skeletonWidths = []int{70, 45, 90, 60}
+
+type TableRowsMsg struct {
+	table *TableModel
+	seq   int
+	apply func() []table.Row
+	err   error
+}
+
+func (msg TableRowsMsg) Target() any {
+	return msg.table
+}
+
+func NewTable(columns []table.Column, colPercent []float64, rows []table.Row, title string, onSelect func(selected string) tea.Msg, selectColumn int, refreshFunc func() (func() []table.Row, error), updateActions map[string]func() tea.Cmd) *TableModel {
 	styles := table.DefaultStyles()
 	styles.Header = styles.Header.
 		BorderBottom(true).
@@ -79,22 +100,59 @@ func NewTable(columns []table.Column, colPercent []float64, rows []table.Row, ti
 		refreshInterval: 5 * time.Second,
 		refreshFunc:     refreshFunc,
 		lastRefresh:     time.Now(),
+		spinner:         NewSpinner(),
 		updateActions:   updateActions,
 	}
 }
 
 func (m *TableModel) Init() tea.Cmd {
-	return tea.Tick(time.Second, func(time.Time) tea.Msg {
-		return loadedTableMsg{}
-	})
+	return m.refreshCmd()
+}
+
+func (m *TableModel) OnLoading(hook func(loading bool)) {
+	m.onLoading = hook
+}
+
+func (m *TableModel) Loading() bool {
+	return m.loading
+}
+
+func (m *TableModel) setLoading(loading bool) {
+	m.loading = loading
+	if m.onLoading != nil {
+		m.onLoading(loading)
+	}
+}
+
+func (m *TableModel) awaitingFirstLoad() bool {
+	return m.loading && !m.initialized
 }
 
 func (m *TableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case loadedTableMsg:
-		m.loading = false
+	case TableRowsMsg:
+		if msg.table != m || msg.seq != m.refreshSeq {
+			return m, nil
+		}
+		m.setLoading(false)
 		m.initialized = true
+		m.lastRefresh = time.Now()
+		m.refreshErr = msg.err
+		if msg.err == nil && msg.apply != nil {
+			m.UpdateRows(msg.apply())
+		}
+		if m.refreshQueued {
+			m.refreshQueued = false
+			return m, m.refreshCmd()
+		}
 		return m, nil
+	case spinner.TickMsg:
+		if !m.awaitingFirstLoad() {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
 	case tea.WindowSizeMsg:
 		m.updateColumnWidths(msg.Width)
 		return m, nil
@@ -102,22 +160,20 @@ func (m *TableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch msg.Type {
 		case tea.KeySpace:
-			if !m.loading {
+			if !m.awaitingFirstLoad() {
 				selectedIdx := m.Table.Cursor()
 				m.toggleCheckbox(selectedIdx)
 				return m, nil
 			}
 		case tea.KeyRunes:
 			if action, exists := m.updateActions[string(msg.Runes)]; exists {
-				cmd := action()
-				m.refreshData()
-				return m, cmd
+				return m, action()
 			}
 			if string(msg.Runes) == "r" {
-				return m, m.refreshData()
+				return m, m.refreshCmd()
 			}
 		case tea.KeyEnter:
-			if !m.loading && m.OnSelected != nil {
+			if !m.awaitingFirstLoad() && m.OnSelected != nil {
 				if len(m.Table.SelectedRow()) > 0 {
 					selected := m.Table.SelectedRow()[m.selectColumn]
 					return m, func() tea.Msg {
@@ -155,24 +211,71 @@ func (m *TableModel) toggleCheckbox(rowIdx int) {
 }
 
 func (m *TableModel) View() string {
-	if m.loading {
-		return lipgloss.NewStyle().
-			Align(lipgloss.Center, lipgloss.Center).
-			Background(lipgloss.Color(customstyles.BackgroundColor)).
-			Render("Loading...")
-	}
-
 	m.updateColumnWidths(styles.ScreenWidth)
+	if m.awaitingFirstLoad() {
+		return m.skeletonView()
+	}
 
 	tableHeight := styles.ScreenHeight + 1
+	if m.refreshErr != nil {
+		tableHeight--
+	}
 	m.Table.SetHeight(tableHeight)
 	m.Table.SetWidth(styles.ScreenWidth)
 
 	tableView := m.Table.View()
+	if m.refreshErr != nil {
+		errorLine := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.ErrorColor)).
+			Background(lipgloss.Color(customstyles.BackgroundColor)).
+			Width(styles.ScreenWidth).
+			MaxHeight(1).
+			Render(m.refreshErrorText())
+		tableView = lipgloss.JoinVertical(lipgloss.Left, tableView, errorLine)
+	}
 
 	return tableView
 }
 
+func (m *TableModel) RefreshError() error {
+	return m.refreshErr
+}
+
+func (m *TableModel) refreshErrorText() string {
+	if len(m.Table.Rows()) == 0 {
+		return fmt.Sprintf("Failed to load: %v • r: Retry", m.refreshErr)
+	}
+	return fmt.Sprintf("Refresh failed, showing last known data: %v", m.refreshErr)
+}
+
+func (m *TableModel) skeletonView() string {
+	columns := m.Table.Columns()
+	rows := make([]table.Row, skeletonRows)
+	for i := range rows {
+		row := make(table.Row, len(columns))
+		for j, column := range columns {
+			if j == 0 {
+				continue
+			}
+			width := max(column.Width*skeletonWidths[(i+j)%len(skeletonWidths)]/100, 1)
+			row[j] = strings.Repeat("░", width)
+		}
+		rows[i] = row
+	}
+
+	skeleton := m.Table
+	skeleton.SetRows(rows)
+	skeleton.SetHeight(styles.ScreenHeight)
+	skeleton.SetWidth(styles.ScreenWidth)
+
+	status := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(lipgloss.Color(customstyles.BackgroundColor)).
+		Width(styles.ScreenWidth).
+		Render(LoadingView(m.spinner, "Loading..."))
+	return lipgloss.JoinVertical(lipgloss.Left, skeleton.View(), status)
+}
+
 func (m *TableModel) updateColumnWidths(totalWidth int) {
 	columns := m.Table.Columns()
 	widths := make([]int, len(columns))
@@ -272,27 +375,37 @@ func (m *TableModel) UpdateColumns(columns []table.Column) {
 	m.Table.SetColumns(columns)
 }
 
-func (m *TableModel) refreshData() tea.Cmd {
-	return func() tea.Msg {
-		rows, err := m.refreshFunc()
-		if err != nil {
-			return err
-		}
-		m.UpdateRows(rows)
+func (m *TableModel) refreshCmd() tea.Cmd {
+	if m.refreshFunc == nil {
+		return nil
+	}
+	if m.loading {
+		m.refreshQueued = true
 		return nil
 	}
+
+	m.setLoading(true)
+	m.refreshSeq++
+	seq := m.refreshSeq
+	fetch := m.refreshFunc
+	load := func() tea.Msg {
+		apply, err := fetch()
+		return TableRowsMsg{table: m, seq: seq, apply: apply, err: err}
+	}
+	if m.initialized {
+		return load
+	}
+	return tea.Batch(m.spinner.Tick, load)
 }
 
 func (t *TableModel) Refresh() (tea.Model, tea.Cmd) {
-	if t.refreshFunc == nil {
-		return t, nil
-	}
+	return t, t.refreshCmd()
+}
 
-	rows, err := t.refreshFunc()
-	if err != nil {
-		return t, nil
+func (m *TableModel) Close() {
+	m.refreshSeq++
+	m.refreshQueued = false
+	if m.loading {
+		m.setLoading(false)
 	}
-
-	t.UpdateRows(rows)
-	return t, nil
 }
@@ -1,8 +1,12 @@
 package components
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -57,3 +61,126 @@ func TestTableModel_Selection(t *testing.T) {
 		t.Errorf("Expected one checked item at index 0, got %v", checked)
 	}
 }
+
+func runTableCmd(m *TableModel, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runTableCmd(m, c)
+		}
+	case TableRowsMsg:
+		_, next := m.Update(msg)
+		runTableCmd(m, next)
+	}
+}
+
+func TestTableModel_RefreshErrorKeepsRows(t *testing.T) {
+	columns := []table.Column{{Title: "Name", Width: 10}}
+	rows := []table.Row{{"pod-a"}}
+
+	failing := false
+	refresh := func() (func() []table.Row, error) {
+		if failing {
+			return nil, errors.New("the API server did not respond within 30s")
+		}
+		return func() []table.Row { return []table.Row{{"pod-b"}} }, nil
+	}
+
+	tableModel := NewTable(columns, []float64{1}, rows, "Test", nil, 1, refresh, nil)
+
+	runTableCmd(tableModel, tableModel.Init())
+	if tableModel.RefreshError() != nil {
+		t.Fatalf("Expected no refresh error, got %v", tableModel.RefreshError())
+	}
+
+	failing = true
+	_, cmd := tableModel.Refresh()
+	runTableCmd(tableModel, cmd)
+	if tableModel.RefreshError() == nil {
+		t.Fatal("Expected the refresh error to be kept")
+	}
+	if got := tableModel.Table.Rows(); len(got) != 1 || got[0][1] != "pod-b" {
+		t.Errorf("Expected the last rows to be kept, got %v", got)
+	}
+	if view := tableModel.View(); !strings.Contains(view, "Refresh failed") {
+		t.Errorf("Expected the view to show the refresh error, got %q", view)
+	}
+
+	failing = false
+	_, cmd = tableModel.Refresh()
+	runTableCmd(tableModel, cmd)
+	if tableModel.RefreshError() != nil {
+		t.Errorf("Expected the refresh error to clear, got %v", tableModel.RefreshError())
+	}
+}
+
+func TestTableModel_LoadsAsynchronously(t *testing.T) {
+	columns := []table.Column{{Title: "Name", Width: 10}}
+	fetches := 0
+	refresh := func() (func() []table.Row, error) {
+		fetches++
+		return func() []table.Row { return []table.Row{{"pod-a"}} }, nil
+	}
+
+	width, height := styles.ScreenWidth, styles.ScreenHeight
+	styles.ScreenWidth, styles.ScreenHeight = 80, 20
+	defer func() { styles.ScreenWidth, styles.ScreenHeight = width, height }()
+
+	tableModel := NewTable(columns, []float64{1}, nil, "Test", nil, 1, refresh, nil)
+	cmd := tableModel.Init()
+	if fetches != 0 {
+		t.Fatal("Expected Init not to fetch before its command runs")
+	}
+	if !tableModel.Loading() {
+		t.Error("Expected the table to be loading until the rows arrive")
+	}
+	if view := tableModel.View(); !strings.Contains(view, "Loading...") || !strings.Contains(view, "░") {
+		t.Errorf("Expected skeleton rows while loading, got %q", view)
+	}
+
+	if _, queued := tableModel.Refresh(); queued != nil {
+		t.Error("Expected a refresh during a load to wait for it")
+	}
+
+	runTableCmd(tableModel, cmd)
+	if tableModel.Loading() {
+		t.Error("Expected loading to end once the rows arrived")
+	}
+	if fetches != 2 {
+		t.Errorf("Expected the queued refresh to run after the first load, got %d fetches", fetches)
+	}
+	if got := tableModel.Table.Rows(); len(got) != 1 || got[0][1] != "pod-a" {
+		t.Errorf("Expected the loaded rows, got %v", got)
+	}
+}
+
+func TestTableModel_IgnoresStaleRows(t *testing.T) {
+	columns := []table.Column{{Title: "Name", Width: 10}}
+	name := "pod-old"
+	refresh := func() (func() []table.Row, error) {
+		rows := []table.Row{{name}}
+		return func() []table.Row { return rows }, nil
+	}
+
+	tableModel := NewTable(columns, []float64{1}, nil, "Test", nil, 1, refresh, nil)
+	stale := tableModel.Init()
+	tableModel.Close()
+
+	name = "pod-new"
+	fresh := tableModel.Init()
+	runTableCmd(tableModel, fresh)
+	runTableCmd(tableModel, stale)
+
+	if got := tableModel.Table.Rows(); len(got) != 1 || got[0][1] != "pod-new" {
+		t.Errorf("Expected a late response to be ignored, got %v", got)
+	}
+
+	other := NewTable(columns, []float64{1}, []table.Row{{"mine"}}, "Other", nil, 1, nil, nil)
+	runTableCmd(other, tableModel.Init())
+	if got := other.Table.Rows(); got[0][1] != "mine" {
+		t.Errorf("Expected rows of another table to be ignored, got %v", got)
+	}
+}
@@ -3,10 +3,10 @@ package components
 import (
 	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"io"
 	"strings"
 	"time"
 
-	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -15,10 +15,14 @@ type YAMLViewer struct {
 	title           string
 	content         string
 	originalContent string
-	viewport        viewport.Model
+	view            *SearchView
 	ready           bool
 	styles          *YAMLViewerStyles
 	customHelp      string
+	exportName      []string
+	exportExt       string
+	notice          string
+	editTarget      EditMsg
 }
 
 type YAMLViewerStyles struct {
@@ -45,6 +49,7 @@ func NewYAMLViewer(title, content string) *YAMLViewer {
 		title:           title,
 		content:         highlighted,
 		originalContent: content,
+		view:            newYAMLSearchView(content),
 		styles: &YAMLViewerStyles{
 			TitleBar: lipgloss.NewStyle().
 				Bold(true).
@@ -62,6 +67,7 @@ func NewYAMLViewerWithHelp(title, content, helpText string) *YAMLViewer {
 		title:           title,
 		content:         highlighted,
 		originalContent: content,
+		view:            newYAMLSearchView(content),
 		styles: &YAMLViewerStyles{
 			TitleBar: lipgloss.NewStyle().
 				Bold(true).
@@ -78,6 +84,14 @@ func (m *YAMLViewer) SetCustomHelp(helpText string) {
 	m.customHelp = helpText
 }
 
+func (m *YAMLViewer) SetExportName(parts ...string) {
+	m.exportName = parts
+}
+
+func (m *YAMLViewer) SetEditTarget(resourceType, namespace, name string) {
+	m.editTarget = EditMsg{ResourceType: resourceType, Namespace: namespace, ResourceName: name}
+}
+
 func (m *YAMLViewer) GetContent() string {
 	return m.content
 }
@@ -86,9 +100,12 @@ func (m *YAMLViewer) GetOriginalContent() string {
 	return m.originalContent
 }
 
+func (m *YAMLViewer) CapturingInput() bool {
+	return m.view.CapturingInput()
+}
+
 func (m *YAMLViewer) Init() tea.Cmd {
-	contentWidth := styles.ScreenWidth
-	m.viewport = viewport.New(contentWidth, styles.ScreenHeight-1)
+	m.view.SetSize(styles.ScreenWidth, styles.ScreenHeight-1)
 	return tea.Tick(time.Second, func(time.Time) tea.Msg {
 		return loadedMsg{}
 	})
@@ -99,27 +116,31 @@ func (m *YAMLViewer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if handled, cmd := m.view.HandleKey(msg); handled {
+			return m, cmd
+		}
 		switch msg.String() {
 		case "q", "esc":
 			return m, tea.Quit
 		case "e":
+			edit := m.editTarget
+			edit.Content = m.originalContent
+			edit.Title = m.title
 			return m, func() tea.Msg {
-				return EditMsg{Content: m.originalContent, Title: m.title}
+				return edit
 			}
+		case "ctrl+s":
+			return m, m.export()
 		}
+	case ExportMsg:
+		m.notice = exportNotice(msg)
+		return m, nil
 	case tea.WindowSizeMsg:
-		if !m.ready {
-			contentWidth := styles.ScreenWidth
-			m.viewport = viewport.New(contentWidth, styles.ScreenHeight-1)
-			m.ready = true
-			m.viewport.SetContent(m.content)
-		} else {
-			m.viewport.Width = styles.ScreenWidth
-			m.viewport.Height = styles.ScreenHeight - 1
-		}
+		m.view.SetSize(styles.ScreenWidth, styles.ScreenHeight-1)
+		m.ready = true
 	}
 
-	m.viewport, cmd = m.viewport.Update(msg)
+	cmd = m.view.Update(msg)
 	return m, cmd
 }
 
@@ -129,11 +150,9 @@ func (m *YAMLViewer) View() string {
 	content := lipgloss.NewStyle().
 		PaddingLeft(m.styles.ContentPadding).
 		Background(lipgloss.Color(customstyles.BackgroundColor)).
-		Render(m.viewport.View())
+		Render(m.view.View())
 
-	m.viewport.Width = styles.ScreenWidth
-	m.viewport.Height = styles.ScreenHeight - 1
-	m.viewport.SetContent(m.content)
+	m.view.SetSize(styles.ScreenWidth, styles.ScreenHeight-1)
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -145,14 +164,33 @@ func (m *YAMLViewer) View() string {
 
 func (m *YAMLViewer) headerView() string {
 	title := m.styles.TitleBar.Background(lipgloss.Color(customstyles.BackgroundColor)).Render(m.title)
+	var flags []string
+	if m.notice != "" {
+		flags = append(flags, m.notice)
+	}
+	if search := m.view.Status(); search != "" {
+		flags = append(flags, search)
+	}
+	if len(flags) > 0 {
+		title += lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+			Background(lipgloss.Color(customstyles.BackgroundColor)).
+			Render("  [" + strings.Join(flags, " • ") + "]")
+	}
 	return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, title, lipgloss.WithWhitespaceBackground(lipgloss.Color(customstyles.BackgroundColor)))
 }
 
 func (m *YAMLViewer) footerView() string {
-	helpText := "↑/↓: Scroll • q: Quit"
+	helpText := "↑/↓: Scroll • /: Search • ctrl+s: Export • q: Quit"
+	if m.editTarget.ResourceType != "" {
+		helpText = "↑/↓: Scroll • /: Search • e: Edit • ctrl+s: Export • q: Quit"
+	}
 	if m.customHelp != "" {
 		helpText = m.customHelp
 	}
+	if prompt := m.view.PromptView(); prompt != "" {
+		helpText = prompt
+	}
 
 	help := lipgloss.NewStyle().
 		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
@@ -162,31 +200,62 @@ func (m *YAMLViewer) footerView() string {
 	return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, help, lipgloss.WithWhitespaceBackground(lipgloss.Color(customstyles.BackgroundColor)))
 }
 
+func (m *YAMLViewer) export() tea.Cmd {
+	parts := m.exportName
+	if len(parts) == 0 {
+		parts = []string{m.title}
+	}
+	ext := m.exportExt
+	if ext == "" {
+		ext = ".yaml"
+	}
+	content := m.originalContent
+	return ExportCmd(ExportFileName(ext, parts...), func(w io.Writer) error {
+		_, err := io.WriteString(w, content)
+		return err
+	})
+}
+
+func newYAMLSearchView(content string) *SearchView {
+	return newTextSearchView(content, renderYAMLLine)
+}
+
+func newTextSearchView(content string, render LineRenderer) *SearchView {
+	view := NewSearchView(styles.ScreenWidth, styles.ScreenHeight-1, render)
+	lines := strings.Split(content, "\n")
+	searchLines := make([]SearchLine, len(lines))
+	for i, line := range lines {
+		searchLines[i] = TextLine(line)
+	}
+	view.SetLines(searchLines)
+	return view
+}
+
 func highlightYAML(yamlStr string) string {
 	lines := strings.Split(yamlStr, "\n")
 	var highlighted strings.Builder
 
+	for _, line := range lines {
+		highlighted.WriteString(renderYAMLLine(TextLine(line), Highlighter{}) + "\n")
+	}
+	return highlighted.String()
+}
+
+func renderYAMLLine(line SearchLine, highlighter Highlighter) string {
 	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(customstyles.YAMLKeyColor)).Background(lipgloss.Color(customstyles.BackgroundColor))
 	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(customstyles.YAMLValueColor)).Background(lipgloss.Color(customstyles.BackgroundColor))
 	plainStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(customstyles.YAMLValueColor)).Background(lipgloss.Color(customstyles.BackgroundColor))
 
-	width := styles.ScreenWidth
-
-	for _, line := range lines {
-		var renderedLine string
-		if strings.Contains(line, ":") {
-			parts := strings.SplitN(line, ":", 2)
-			if len(parts) == 2 {
-				renderedLine = keyStyle.Render(parts[0]+":") + valueStyle.Render(parts[1])
-			} else {
-				renderedLine = plainStyle.Render(line)
-			}
-		} else {
-			renderedLine = plainStyle.Render(line)
-		}
-
-		filledLine := lipgloss.PlaceHorizontal(width, lipgloss.Left, renderedLine, lipgloss.WithWhitespaceBackground(lipgloss.Color(customstyles.BackgroundColor)))
-		highlighted.WriteString(filledLine + "\n")
+	text := line.SearchText()
+	var renderedLine string
+	if key, value, found := strings.Cut(text, ":"); found {
+		renderedLine = highlighter.RenderSegments(
+			StyledText{Text: key + ":", Style: keyStyle},
+			StyledText{Text: value, Style: valueStyle},
+		)
+	} else {
+		renderedLine = highlighter.Render(text, plainStyle)
 	}
-	return highlighted.String()
+
+	return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, renderedLine, lipgloss.WithWhitespaceBackground(lipgloss.Color(customstyles.BackgroundColor)))
 }
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"time"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/cli"
@@ -55,6 +56,8 @@ func (ui *UIInjector) RenderInjections(location string) string {
 	return strings.Join(rendered, " | ")
 }
 
+const maxRecent = 10
+
 type AppModel struct {
 	tabManager          *models.TabManager
 	kube                resources.Client
@@ -63,6 +66,10 @@ type AppModel struct {
 	configSelected      bool
 	errorPopup          *models.ErrorModel
 	quickNav            tea.Model
+	picker              tea.Model
+	switcher            tea.Model
+	recentContexts      []string
+	recentNamespaces    []string
 	currentResourceType string
 	breadcrumbTrail     []string
 	pluginManager       *plugins.PluginManager
@@ -79,76 +86,98 @@ func NewAppModel(cfg cli.Config, pluginManager *plugins.PluginManager) *AppModel
 		panic("Failed to initialize colors: " + err.Error())
 	}
 
-	kubeClient, err := resources.NewClient(cfg.KubeconfigPath, cfg.Namespace)
-	if err == nil && kubeClient != nil {
-		header := models.NewHeader("K8s TUI", kubeClient)
-		header.SetNamespace(cfg.Namespace)
-
-		tabManager := models.NewTabManager(kubeClient, cfg.Namespace, appConfig.KeyBindings)
-
-		uiInjector := NewUIInjector()
-
-		appModel := &AppModel{
-			tabManager:     tabManager,
-			header:         header,
-			kube:           *kubeClient,
-			config:         appConfig,
-			configSelected: true,
-			pluginManager:  pluginManager,
-			uiInjector:     uiInjector,
-		}
+	resources.SetRequestTimeout(time.Duration(appConfig.RequestTimeout) * time.Second)
 
-		if pluginManager != nil {
-			appModel.loadPluginUIExtensions()
-		}
+	appModel := &AppModel{
+		header:        models.NewHeader("K8s TUI", nil),
+		config:        appConfig,
+		pluginManager: pluginManager,
+		uiInjector:    NewUIInjector(),
+	}
+	if pluginManager != nil {
+		appModel.loadPluginUIExtensions()
+	}
 
-		tabs := tabManager.GetTabsForComponent()
-		activeIndex := -1
-		for i, tab := range tabs {
-			header.AddTab(tab.ID, tab.Title, tab.ResourceType)
-			if tab.IsActive {
-				activeIndex = i
-			}
-		}
-		if activeIndex >= 0 {
-			header.SetActiveTab(activeIndex)
+	kubeconfig := resources.KubeconfigOptions{
+		Path:    cfg.KubeconfigPath,
+		Context: cfg.Context,
+		Cluster: cfg.Cluster,
+		User:    cfg.User,
+	}
+	if kubeconfig.Path != "" || kubeconfig.Context != "" {
+		kubeClient, err := resources.NewClient(kubeconfig, cfg.Namespace)
+		if err != nil {
+			popup := models.NewErrorScreen(err, "Failed to initialize Kubernetes config", "")
+			appModel.errorPopup = &popup
+			return appModel
 		}
-
+		appModel.connect(*kubeClient)
 		return appModel
 	}
 
-	_, err = models.NewKubeconfigModel().InitComponent(nil)
+	picker, err := models.NewKubeconfigModel(kubeconfig).InitComponent(nil)
 	if err != nil {
 		popup := models.NewErrorScreen(err, "Failed to initialize Kubernetes config", "")
-		uiInjector := NewUIInjector()
-		appModel := &AppModel{
-			header:        models.NewHeader("K8s TUI", nil),
-			config:        appConfig,
-			errorPopup:    &popup,
-			pluginManager: pluginManager,
-			uiInjector:    uiInjector,
-		}
-
-		if pluginManager != nil {
-			appModel.loadPluginUIExtensions()
-		}
-
+		appModel.errorPopup = &popup
 		return appModel
 	}
+	appModel.picker = picker
+	return appModel
+}
 
-	uiInjector := NewUIInjector()
-	appModel := &AppModel{
-		header:        models.NewHeader("K8s TUI", nil),
-		config:        appConfig,
-		pluginManager: pluginManager,
-		uiInjector:    uiInjector,
-	}
+func (m *AppModel) connect(kubeClient resources.Client) {
+	m.kube = kubeClient
+	m.tabManager = models.NewTabManager(&m.kube, kubeClient.Namespace, m.config.KeyBindings)
+	m.header.SetKubeconfig(&m.kube)
+	m.configSelected = true
+	m.picker = nil
+	m.updateHeaderTabs()
+	m.rememberClient()
+	m.syncPluginAPI()
+}
 
-	if pluginManager != nil {
-		appModel.loadPluginUIExtensions()
+func (m *AppModel) switchClient(kubeClient resources.Client) tea.Cmd {
+	previous := m.kube
+	m.kube = kubeClient
+	cmd := m.tabManager.SwitchClient(&m.kube)
+	if previous.Cache != nil && previous.Cache != m.kube.Cache {
+		previous.Cache.Stop()
+	}
+	m.header.SetKubeconfig(&m.kube)
+	m.updateHeaderTabs()
+	m.rememberClient()
+	m.syncPluginAPI()
+	return cmd
+}
+
+func (m *AppModel) rememberClient() {
+	m.recentContexts = remember(m.recentContexts, m.kube.ContextName())
+	m.recentNamespaces = remember(m.recentNamespaces, m.kube.Namespace)
+}
+
+func remember(recent []string, item string) []string {
+	if item == "" {
+		return recent
+	}
+	updated := []string{item}
+	for _, existing := range recent {
+		if existing != item && len(updated) < maxRecent {
+			updated = append(updated, existing)
+		}
 	}
+	return updated
+}
 
-	return appModel
+func (m *AppModel) syncPluginAPI() {
+	if m.pluginManager == nil {
+		return
+	}
+	if api := m.pluginManager.GetAPI(); api != nil {
+		api.SetClient(m.kube)
+		if api.GetCurrentNamespace() != m.kube.Namespace {
+			api.SetCurrentNamespace(m.kube.Namespace)
+		}
+	}
 }
 
 func ParseFlags() cli.Config {
@@ -162,6 +191,10 @@ func (m *AppModel) Init() tea.Cmd {
 		cmds = append(cmds, m.tabManager.Init())
 	}
 
+	if m.picker != nil {
+		cmds = append(cmds, m.picker.Init())
+	}
+
 	if m.configSelected {
 		cmds = append(cmds, m.header.Init())
 	}
@@ -174,14 +207,17 @@ func (m *AppModel) getKeyBinding(action string) string {
 		return binding
 	}
 	defaults := map[string]string{
-		"quit":      "q",
-		"help":      "?",
-		"refresh":   "r",
-		"back":      "[",
-		"forward":   "]",
-		"new_tab":   "ctrl+t",
-		"close_tab": "ctrl+w",
-		"quick_nav": "g",
+		"quit":             "q",
+		"help":             "?",
+		"refresh":          "r",
+		"back":             "[",
+		"forward":          "]",
+		"new_tab":          "ctrl+t",
+		"close_tab":        "ctrl+w",
+		"quick_nav":        "g",
+		"create":           "ctrl+n",
+		"switch_context":   "ctrl+k",
+		"switch_namespace": "ctrl+p",
 	}
 	return defaults[action]
 }
@@ -226,9 +262,21 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, cmd)
 			}
 		}
+
+		if m.picker != nil {
+			var cmd tea.Cmd
+			m.picker, cmd = m.picker.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 		return m, tea.Batch(cmds...)
 
 	case tea.KeyMsg:
+		if m.switcher != nil {
+			var cmd tea.Cmd
+			m.switcher, cmd = m.switcher.Update(msg)
+			return m, cmd
+		}
+
 		if m.quickNav != nil {
 			switch msg.String() {
 			case "esc", m.getKeyBinding("quick_nav"):
@@ -241,6 +289,24 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if m.errorPopup == nil && m.picker != nil {
+			switch msg.String() {
+			case "esc", "q", "Q", "ctrl+c":
+				return m, tea.Quit
+			}
+			var cmd tea.Cmd
+			m.picker, cmd = m.picker.Update(msg)
+			return m, cmd
+		}
+
+		if m.errorPopup == nil && m.tabManager != nil && m.tabManager.CapturingInput() {
+			updatedManager, cmd := m.tabManager.Update(msg)
+			if manager, ok := updatedManager.(*models.TabManager); ok {
+				m.tabManager = manager
+			}
+			return m, cmd
+		}
+
 		switch msg.String() {
 		case "esc":
 			if m.errorPopup != nil {
@@ -267,6 +333,23 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			m.quickNav = models.NewQuickNavModel(m.kube, m.kube.Namespace)
 			return m, m.quickNav.Init()
+		case m.getKeyBinding("switch_context"):
+			if m.tabManager != nil {
+				m.switcher = models.NewContextSwitcher(m.kube, m.recentContexts)
+				return m, m.switcher.Init()
+			}
+			return m, nil
+		case m.getKeyBinding("switch_namespace"):
+			if m.tabManager != nil {
+				m.switcher = models.NewNamespaceSwitcher(m.kube, m.recentNamespaces)
+				return m, m.switcher.Init()
+			}
+			return m, nil
+		case m.getKeyBinding("create"):
+			if m.tabManager != nil {
+				return m, models.CreateResourceCmd(m.kube, m.kube.Namespace)
+			}
+			return m, nil
 		case m.getKeyBinding("new_tab"):
 			if m.tabManager != nil {
 				updatedManager, cmd := m.tabManager.Update(msg)
@@ -332,17 +415,27 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			)
 			popup.SetDimensions(styles.ScreenWidth, styles.ScreenHeight)
 
-			return &AppModel{
-				tabManager: m.tabManager,
-				header:     m.header,
-				kube:       msg.Cluster,
-				errorPopup: &popup,
-				quickNav:   nil,
-			}, nil
+			m.errorPopup = &popup
+			m.quickNav = nil
+			return m, nil
 		}
 
 		m.quickNav = nil
 
+		if m.tabManager == nil {
+			m.connect(msg.Cluster)
+			cmds := []tea.Cmd{m.tabManager.Init(), m.header.Init(), tea.WindowSize()}
+			if msg.NewScreen != nil {
+				updatedManager, cmd := m.tabManager.Update(msg)
+				if manager, ok := updatedManager.(*models.TabManager); ok {
+					m.tabManager = manager
+				}
+				m.updateHeaderTabs()
+				cmds = append(cmds, cmd)
+			}
+			return m, tea.Batch(cmds...)
+		}
+
 		if m.tabManager != nil {
 			updatedManager, cmd := m.tabManager.Update(msg)
 			if manager, ok := updatedManager.(*models.TabManager); ok {
@@ -367,7 +460,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		return m, nil
 
-	case components.TabMsg:
+	case components.TabMsg, components.BackMsg:
 		if m.tabManager != nil {
 			updatedManager, cmd := m.tabManager.Update(msg)
 			if manager, ok := updatedManager.(*models.TabManager); ok {
@@ -386,23 +479,73 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case components.EditMsg:
+		if msg.ResourceType != "" {
+			return m, models.EditResourceCmd(m.kube, resources.ResourceType(msg.ResourceType), msg.Namespace, msg.ResourceName)
+		}
+		if m.tabManager != nil {
+			updatedManager, cmd := m.tabManager.Update(msg)
+			if manager, ok := updatedManager.(*models.TabManager); ok {
+				m.tabManager = manager
+			}
+			return m, cmd
+		}
+		return m, nil
+
 	case models.CloseQuickNavMsg:
 		m.quickNav = nil
 		return m, nil
 
+	case models.CloseSwitcherMsg:
+		m.switcher = nil
+		return m, nil
+
+	case models.SwitchContextMsg:
+		m.switcher = nil
+		if m.tabManager == nil {
+			return m, nil
+		}
+		kubeClient, err := resources.NewClient(resources.KubeconfigOptions{Path: m.kube.KubeconfigPath, Context: msg.Context}, "")
+		if err != nil {
+			popup := models.NewErrorScreen(err, "Kubernetes Connection Error", "Failed to switch to context "+msg.Context)
+			popup.SetDimensions(styles.ScreenWidth, styles.ScreenHeight)
+			m.errorPopup = &popup
+			return m, nil
+		}
+		return m, m.switchClient(*kubeClient)
+
+	case models.SwitchNamespaceMsg:
+		m.switcher = nil
+		if m.tabManager == nil {
+			return m, nil
+		}
+		kubeClient := m.kube
+		kubeClient.Namespace = msg.Namespace
+		return m, m.switchClient(kubeClient)
+
 	default:
+		var cmds []tea.Cmd
+		if m.switcher != nil {
+			var cmd tea.Cmd
+			m.switcher, cmd = m.switcher.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 		if m.tabManager != nil {
 			updatedManager, cmd := m.tabManager.Update(msg)
 			if manager, ok := updatedManager.(*models.TabManager); ok {
 				m.tabManager = manager
 			}
-			return m, cmd
+			cmds = append(cmds, cmd)
 		}
-		return m, nil
+		return m, tea.Batch(cmds...)
 	}
 }
 
 func (m *AppModel) View() string {
+	if m.switcher != nil {
+		return m.switcher.View()
+	}
+
 	if m.quickNav != nil {
 		return m.quickNav.View()
 	}
@@ -412,7 +555,17 @@ func (m *AppModel) View() string {
 	}
 
 	if m.tabManager == nil {
-		return "Loading..."
+		if m.picker == nil {
+			return "Loading..."
+		}
+		return lipgloss.NewStyle().
+			Width(styles.ScreenWidth).
+			Height(styles.ScreenHeight + styles.HeaderSize).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color(customstyles.BorderColor)).
+			BorderBackground(lipgloss.Color(customstyles.BackgroundColor)).
+			Background(lipgloss.Color(customstyles.BackgroundColor)).
+			Render(m.picker.View())
 	}
 
 	currentView := m.tabManager.View()
@@ -538,6 +691,7 @@ func (m *AppModel) getResourceTypeFromKey(key string) string {
 		"m": "DaemonSets",
 		"t": "StatefulSets",
 		"l": "ResourceList",
+		"f": "PortForwards",
 	}
 
 	if resourceType, exists := resourceMap[key]; exists {
@@ -2,10 +2,14 @@ package ui
 
 import (
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/models"
 	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
 	"strings"
 	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGetResourceTypeFromKey(t *testing.T) {
@@ -30,6 +34,7 @@ func TestGetResourceTypeFromKey(t *testing.T) {
 		{"m", "DaemonSets", "DaemonSets mapping"},
 		{"t", "StatefulSets", "StatefulSets mapping"},
 		{"l", "ResourceList", "ResourceList mapping"},
+		{"f", "PortForwards", "PortForwards mapping"},
 		{"x", "", "Invalid key returns empty string"},
 		{"", "", "Empty key returns empty string"},
 	}
@@ -209,3 +214,36 @@ func TestDuplicateNavigationPrevention(t *testing.T) {
 		t.Errorf("Expected to detect that we're NOT on Pods, but got true")
 	}
 }
+
+func TestSwitchNamespaceRebuildsClient(t *testing.T) {
+	pluginManager := plugins.NewPluginManager("")
+	var changed []string
+	pluginManager.GetAPI().RegisterEventHandler(plugins.EventNamespaceChanged, func(data any) error {
+		changed = append(changed, data.(string))
+		return nil
+	})
+
+	appModel := &AppModel{
+		kube:          k8s.Client{Namespace: "default", Clientset: fake.NewSimpleClientset()},
+		header:        models.NewHeader("K8s TUI", nil),
+		pluginManager: pluginManager,
+	}
+	appModel.tabManager = models.NewTabManager(&appModel.kube, "default", nil)
+
+	appModel.Update(models.SwitchNamespaceMsg{Namespace: "staging"})
+
+	if appModel.kube.Namespace != "staging" {
+		t.Errorf("Expected the client to move to staging, got %q", appModel.kube.Namespace)
+	}
+	if len(changed) != 1 || changed[0] != "staging" {
+		t.Errorf("Expected one namespace change event for staging, got %v", changed)
+	}
+	if len(appModel.recentNamespaces) != 1 || appModel.recentNamespaces[0] != "staging" {
+		t.Errorf("Expected staging to be remembered, got %v", appModel.recentNamespaces)
+	}
+
+	appModel.Update(models.SwitchNamespaceMsg{Namespace: "staging"})
+	if len(changed) != 1 {
+		t.Errorf("Expected no event when staying in staging, got %v", changed)
+	}
+}
@@ -4,11 +4,25 @@ import (
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	resources "github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"github.com/otavioCosta2110/k8s-tui/pkg/logger"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+const (
+	watchDebounce       = 200 * time.Millisecond
+	watchResyncInterval = time.Minute
+)
+
+type watchEventMsg struct {
+	sub *resources.WatchSubscription
+}
+
+type watchResyncMsg struct {
+	sub *resources.WatchSubscription
+}
+
 type RefreshableModel interface {
 	tea.Model
 	Refresh() (tea.Model, tea.Cmd)
@@ -20,6 +34,10 @@ type AutoRefreshModel struct {
 	lastRefresh     time.Time
 	k8sClient       *resources.Client
 	footerText      string
+	watchType       resources.ResourceType
+	watchNamespace  string
+	sub             *resources.WatchSubscription
+	closer          ClosableModel
 }
 
 func NewAutoRefreshModel(inner RefreshableModel, interval time.Duration, client *resources.Client, footerText string) *AutoRefreshModel {
@@ -35,13 +53,95 @@ func NewAutoRefreshModel(inner RefreshableModel, interval time.Duration, client
 	}
 }
 
+func (m *AutoRefreshModel) Watch(resourceType resources.ResourceType, namespace string) *AutoRefreshModel {
+	m.watchType = resourceType
+	m.watchNamespace = namespace
+	return m
+}
+
 func (m *AutoRefreshModel) Init() tea.Cmd {
+	if m.sub != nil {
+		return m.inner.Init()
+	}
+	if m.subscribe() {
+		return tea.Batch(
+			m.inner.Init(),
+			m.waitForEvent(),
+			m.resyncTick(),
+		)
+	}
 	return tea.Batch(
 		m.inner.Init(),
 		m.refreshTick(),
 	)
 }
 
+func (m *AutoRefreshModel) subscribe() bool {
+	if m.watchType == "" || m.k8sClient == nil || m.k8sClient.Cache == nil {
+		return false
+	}
+	sub, err := m.k8sClient.Cache.Subscribe(m.watchType, m.watchNamespace)
+	if err != nil {
+		logger.Debug("Falling back to polling: " + err.Error())
+		return false
+	}
+	m.sub = sub
+	return true
+}
+
+func (m *AutoRefreshModel) waitForEvent() tea.Cmd {
+	sub := m.sub
+	return func() tea.Msg {
+		select {
+		case <-sub.Events():
+		case <-sub.Done():
+			return nil
+		}
+		select {
+		case <-time.After(watchDebounce):
+			return watchEventMsg{sub: sub}
+		case <-sub.Done():
+			return nil
+		}
+	}
+}
+
+func (m *AutoRefreshModel) resyncTick() tea.Cmd {
+	sub := m.sub
+	return tea.Tick(watchResyncInterval, func(t time.Time) tea.Msg {
+		return watchResyncMsg{sub: sub}
+	})
+}
+
+func (m *AutoRefreshModel) watching(sub *resources.WatchSubscription) bool {
+	return sub != nil && m.sub == sub
+}
+
+func (m *AutoRefreshModel) CloseWith(closer ClosableModel) *AutoRefreshModel {
+	m.closer = closer
+	return m
+}
+
+func (m *AutoRefreshModel) owns(target any) bool {
+	if owner, ok := m.inner.(resultOwner); ok && owner.owns(target) {
+		return true
+	}
+	return target == any(m.inner)
+}
+
+func (m *AutoRefreshModel) Close() {
+	if m.sub != nil {
+		m.sub.Close()
+		m.sub = nil
+	}
+	if closable, ok := m.inner.(ClosableModel); ok {
+		closable.Close()
+	}
+	if m.closer != nil {
+		m.closer.Close()
+	}
+}
+
 func (m *AutoRefreshModel) refreshTick() tea.Cmd {
 	return tea.Tick(m.refreshInterval, func(t time.Time) tea.Msg {
 		return components.RefreshMsg{}
@@ -50,6 +150,22 @@ func (m *AutoRefreshModel) refreshTick() tea.Cmd {
 
 func (m *AutoRefreshModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case watchEventMsg:
+		if !m.watching(msg.sub) {
+			return m, nil
+		}
+		updatedModel, cmd := m.inner.Refresh()
+		m.inner = updatedModel.(RefreshableModel)
+		return m, tea.Batch(cmd, m.waitForEvent())
+
+	case watchResyncMsg:
+		if !m.watching(msg.sub) {
+			return m, nil
+		}
+		updatedModel, cmd := m.inner.Refresh()
+		m.inner = updatedModel.(RefreshableModel)
+		return m, tea.Batch(cmd, m.resyncTick())
+
 	case components.RefreshMsg:
 		var cmd tea.Cmd
 		updatedModel, cmd := m.inner.Refresh()
@@ -9,6 +9,9 @@ import (
 
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 type MockRefreshableModel struct {
@@ -208,9 +211,9 @@ func TestAutoRefreshModelWithTableModel(t *testing.T) {
 	rows := []table.Row{{"test data"}}
 
 	refreshCallCount := 0
-	refreshFunc := func() ([]table.Row, error) {
+	refreshFunc := func() (func() []table.Row, error) {
 		refreshCallCount++
-		return rows, nil
+		return func() []table.Row { return rows }, nil
 	}
 
 	tableModel := ui.NewTable(columns, []float64{1.0}, rows, "Test Table", nil, 0, refreshFunc, nil)
@@ -227,8 +230,11 @@ func TestAutoRefreshModelWithTableModel(t *testing.T) {
 		t.Error("Update should return a model")
 	}
 
-	if refreshCallCount != 1 {
-		t.Errorf("Expected refresh function to be called once, got %d", refreshCallCount)
+	if refreshCallCount != 0 {
+		t.Errorf("Expected the refresh to run in a command rather than in Update, got %d calls", refreshCallCount)
+	}
+	if !tableModel.Loading() {
+		t.Error("Expected the table to be loading while the refresh is in flight")
 	}
 
 	if cmd == nil {
@@ -271,3 +277,58 @@ func TestAutoRefreshModelRefreshInterval(t *testing.T) {
 		}
 	}
 }
+
+func TestAutoRefreshModelRefreshesOnWatchEvents(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+	client := &k8s.Client{Clientset: clientset, Cache: k8s.NewWatchCache(clientset)}
+	defer client.Cache.Stop()
+
+	mockInner := &MockRefreshableModel{}
+	model := NewAutoRefreshModel(mockInner, 5*time.Second, client, "").Watch(k8s.ResourceTypePod, "default")
+	model.Init()
+	if model.sub == nil {
+		t.Fatal("Expected Init to subscribe to pod events")
+	}
+	sub := model.sub
+
+	done := make(chan tea.Msg, 1)
+	go func() { done <- model.waitForEvent()() }()
+	select {
+	case msg := <-done:
+		model.Update(msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a watch event once the informer synced")
+	}
+	if mockInner.refreshCount != 1 {
+		t.Errorf("Expected a refresh per watch event, got %d", mockInner.refreshCount)
+	}
+
+	model.Update(watchEventMsg{})
+	if mockInner.refreshCount != 1 {
+		t.Error("Expected events of other subscriptions to be ignored")
+	}
+
+	model.Close()
+	if model.watching(sub) || client.Cache.Watching(k8s.ResourceTypePod, "default") {
+		t.Error("Expected Close to release the informer")
+	}
+	if cmd := model.Init(); cmd == nil || model.sub == nil {
+		t.Error("Expected Init after Close to subscribe again")
+	}
+	model.Close()
+}
+
+func TestAutoRefreshModel_CloseCancelsRequests(t *testing.T) {
+	owner := &requestScope{}
+	ctx := owner.requestContext()
+
+	model := NewAutoRefreshModel(&MockRefreshableModel{}, 5*time.Second, nil, "").CloseWith(owner)
+	model.Close()
+
+	if ctx.Err() == nil {
+		t.Error("Expected Close to cancel the owner's in-flight requests")
+	}
+	if owner.requestContext().Err() != nil {
+		t.Error("Expected a fresh request context after the screen is shown again")
+	}
+}
@@ -1,7 +1,7 @@
 package models
 
 import (
-	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
@@ -44,10 +44,6 @@ func NewConfigmaps(k k8s.Client, namespace string) (*configmapsModel, error) {
 func (c *configmapsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	c.k8sClient = k
 
-	if err := c.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		cmDetails, err := NewConfigmapDetails(*k, c.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -61,38 +57,34 @@ func (c *configmapsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := c.fetchData(); err != nil {
-			return nil, err
-		}
-		return c.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(c.config.Columns, c.config.ColumnWidths, c.dataToRows(), c.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := c.newTable(onSelect, c.fetchData, c.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": c.createDeleteAction(tableModel),
+		"e": c.createEditAction(tableModel),
+		"L": c.createEditMetadataAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, c.refreshInterval, c.k8sClient, "ConfigMaps"), nil
+	return NewAutoRefreshModel(tableModel, c.refreshInterval, c.k8sClient, "ConfigMaps").Watch(k8s.ResourceTypeConfigMap, c.namespace).CloseWith(c), nil
 }
 
-func (c *configmapsModel) fetchData() error {
+func (c *configmapsModel) fetchData(ctx context.Context) (func(), error) {
 	var cms []k8s.Configmap
 	var err error
 
-	cms, err = c.pluginAPI.GetConfigMaps(c.namespace)
+	cms, err = c.pluginAPI.GetConfigMaps(ctx, c.namespace)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
-	c.cms = cms
 
-	c.resourceData = make([]types.ResourceData, len(cms))
-	for i, cm := range cms {
-		c.resourceData[i] = ConfigMapData{&cm}
-	}
+	return func() {
+		c.cms = cms
 
-	return nil
+		c.resourceData = make([]types.ResourceData, len(cms))
+		for i, cm := range cms {
+			c.resourceData[i] = ConfigMapData{&cm}
+		}
+	}, nil
 }
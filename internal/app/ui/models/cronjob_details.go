@@ -1,43 +1,89 @@
 package models
 
 import (
-	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type cronjobDetailsModel struct {
+	describeView
 	cronjob   *k8s.CronJobInfo
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
 }
 
 func NewCronJobDetails(k k8s.Client, namespace, cronjobName string) *cronjobDetailsModel {
 	return &cronjobDetailsModel{
 		cronjob:   k8s.NewCronJob(cronjobName, namespace, k),
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
 	}
 }
 
 func (cj *cronjobDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	cj.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeCronJob(cj.cronjob.Namespace, cj.cronjob.Name)
 
-	if err != nil {
-		return nil, err
+	cj.setup("cronjob", func(ctx context.Context) (string, error) {
+		return api.DescribeCronJob(ctx, cj.cronjob.Namespace, cj.cronjob.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewerWithHelp(
+			"CronJob: "+cj.cronjob.Name,
+			desc,
+			"↑/↓: Scroll • /: Search • j: Jobs • t: Trigger • e: Edit • ctrl+s: Export • q: Quit",
+		)
+		viewer.SetExportName(k.ContextName(), cj.cronjob.Namespace, "cronjob", cj.cronjob.Name)
+		viewer.SetEditTarget("cronjob", cj.cronjob.Namespace, cj.cronjob.Name)
+		return viewer
+	})
+	return cj, nil
+}
+
+func (cj *cronjobDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := cj.update(msg); ok {
+		return cj, cmd
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok && !cj.CapturingInput() {
+		switch msg.String() {
+		case "j":
+			return cj, openCronJobJobs(*cj.k8sClient, cj.cronjob.Namespace, cj.cronjob.Name)
+		case "t":
+			return cj, triggerCronJobCmd(cj.requestContext(), *cj.k8sClient, cj.cronjob.Namespace, cj.cronjob.Name)
+		}
+	}
+
+	return cj, cj.forward(msg)
+}
+
+func openCronJobJobs(k k8s.Client, namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		jobs, err := NewCronJobJobs(k, namespace, name).InitComponent(&k)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		return components.NavigateMsg{
+			NewScreen:  jobs,
+			Breadcrumb: "Jobs",
+		}
 	}
+}
 
-	return components.NewYAMLViewer("CronJob: "+cj.cronjob.Name, desc), nil
+func triggerCronJobCmd(ctx context.Context, k k8s.Client, namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := k8s.NewCronJob(name, namespace, k).Trigger(ctx); err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		return openCronJobJobs(k, namespace, name)()
+	}
 }
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type cronjobJobsModel struct {
+	requestScope
+	cronjob   *k8s.CronJobInfo
+	k8sClient *k8s.Client
+	jobs      []k8s.JobInfo
+}
+
+func NewCronJobJobs(k k8s.Client, namespace, name string) *cronjobJobsModel {
+	return &cronjobJobsModel{
+		cronjob:   k8s.NewCronJob(name, namespace, k),
+		k8sClient: &k,
+	}
+}
+
+func (c *cronjobJobsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	c.k8sClient = k
+
+	columns := []table.Column{
+		components.NewColumn("NAME", 0),
+		components.NewColumn("TRIGGER", 0),
+		components.NewColumn("COMPLETIONS", 0),
+		components.NewColumn("DURATION", 0),
+		components.NewColumn("AGE", 0),
+	}
+	fetchFunc := func() (func() []table.Row, error) {
+		jobs, err := c.fetchData(c.requestContext())
+		if err != nil {
+			return nil, err
+		}
+		return func() []table.Row {
+			c.jobs = jobs
+			return c.rows()
+		}, nil
+	}
+	onSelect := func(selected string) tea.Msg {
+		jobDetails, err := NewJobDetails(*k, c.cronjob.Namespace, selected).InitComponent(k)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: *k,
+			}
+		}
+		return components.NavigateMsg{
+			NewScreen:  jobDetails,
+			Breadcrumb: selected,
+		}
+	}
+
+	title := customstyles.ResourceIcons["Jobs"] + " Jobs of " + c.cronjob.Name
+	tableModel := ui.NewTable(columns, []float64{1.5, 0.6, 0.6, 0.6, 0.5}, c.rows(), title, onSelect, 0, fetchFunc, nil)
+
+	return NewAutoRefreshModel(tableModel, 5*time.Second, c.k8sClient, "Jobs").Watch(k8s.ResourceTypeJob, c.cronjob.Namespace).CloseWith(c), nil
+}
+
+func (c *cronjobJobsModel) fetchData(ctx context.Context) ([]k8s.JobInfo, error) {
+	cronjob := *c.cronjob
+	cronjob.Raw = nil
+	return cronjob.GetJobs(ctx)
+}
+
+func (c *cronjobJobsModel) rows() []table.Row {
+	rows := make([]table.Row, len(c.jobs))
+	for i, job := range c.jobs {
+		trigger := "scheduled"
+		if job.Raw != nil && job.Raw.Annotations[k8s.InstantiateAnnotation] == "manual" {
+			trigger = "manual"
+		}
+		rows[i] = table.Row{
+			job.Name,
+			trigger,
+			job.Completions,
+			job.Duration,
+			job.Age,
+		}
+	}
+	return rows
+}
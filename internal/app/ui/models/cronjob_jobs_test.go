@@ -0,0 +1,51 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTriggerCronJobShowsJobs(t *testing.T) {
+	cronjob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default", UID: "nightly-uid"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 3 * * *"},
+	}
+	clientset := fake.NewSimpleClientset(
+		cronjob,
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Name: "nightly-1", Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronjob, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+		}},
+	)
+	client := k8s.Client{Clientset: clientset, Namespace: "default"}
+
+	msg := triggerCronJobCmd(context.Background(), client, "default", "nightly")()
+	nav, ok := msg.(components.NavigateMsg)
+	if !ok || nav.Error != nil {
+		t.Fatalf("Expected the jobs screen, got %+v", msg)
+	}
+	tableModel := nav.NewScreen.(*AutoRefreshModel).inner.(*components.TableModel)
+	runLoad(tableModel, tableModel.Init())
+	rows := tableModel.Table.Rows()
+	if len(rows) != 2 {
+		t.Fatalf("Expected the scheduled and manual jobs, got %v", rows)
+	}
+
+	jobs, _ := clientset.BatchV1().Jobs("default").List(context.Background(), metav1.ListOptions{})
+	manual := 0
+	for _, row := range rows {
+		if row[2] == "manual" {
+			manual++
+		}
+	}
+	if manual != 1 || len(jobs.Items) != 2 {
+		t.Errorf("Expected one manual job, got %v", rows)
+	}
+}
@@ -1,12 +1,14 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
 	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/table"
@@ -47,10 +49,6 @@ func NewCronJobs(k k8s.Client, namespace string) (*cronjobsModel, error) {
 func (cj *cronjobsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	cj.k8sClient = k
 
-	if err := cj.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		cronjobDetails, err := NewCronJobDetails(*k, cj.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -64,38 +62,82 @@ func (cj *cronjobsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := cj.fetchData(); err != nil {
-			return nil, err
-		}
-		return cj.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(cj.config.Columns, cj.config.ColumnWidths, cj.dataToRows(), cj.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := cj.newTable(onSelect, cj.fetchData, cj.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": cj.createDeleteAction(tableModel),
+		"e": cj.createEditAction(tableModel),
+		"L": cj.createEditMetadataAction(tableModel),
+		"i": cj.createSetImagesAction(tableModel),
+		"t": cj.createTriggerAction(tableModel),
+		"s": cj.createSuspendAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, cj.refreshInterval, cj.k8sClient, "CronJobs"), nil
+	return NewAutoRefreshModel(tableModel, cj.refreshInterval, cj.k8sClient, "CronJobs").Watch(k8s.ResourceTypeCronJob, cj.namespace).CloseWith(cj), nil
 }
 
-func (cj *cronjobsModel) fetchData() error {
+func (cj *cronjobsModel) createTriggerAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(cj.resourceData) {
+			return nil
+		}
+		resource := cj.resourceData[selected]
+		return triggerCronJobCmd(cj.requestContext(), *cj.k8sClient, resource.GetNamespace(), resource.GetName())
+	}
+}
+
+func (cj *cronjobsModel) createSuspendAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		var targets []k8s.CronJobInfo
+		for _, idx := range tableModel.GetCheckedItems() {
+			if idx >= 0 && idx < len(cj.cronjobsInfo) {
+				targets = append(targets, cj.cronjobsInfo[idx])
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+		tableModel.ClearCheckedItems()
+
+		var failures []string
+		for _, target := range targets {
+			suspend := target.Raw == nil || target.Raw.Spec.Suspend == nil || !*target.Raw.Spec.Suspend
+			if err := k8s.SetCronJobSuspended(cj.requestContext(), *cj.k8sClient, target.Namespace, target.Name, suspend); err != nil {
+				failures = append(failures, err.Error())
+			}
+		}
+		_, refresh := tableModel.Refresh()
+		if len(failures) > 0 {
+			client := *cj.k8sClient
+			return tea.Batch(refresh, func() tea.Msg {
+				return components.NavigateMsg{
+					Error:   fmt.Errorf("%s", strings.Join(failures, "\n")),
+					Cluster: client,
+				}
+			})
+		}
+		return refresh
+	}
+}
+
+func (cj *cronjobsModel) fetchData(ctx context.Context) (func(), error) {
 	var cronjobInfo []k8s.CronJobInfo
 	var err error
 
-	cronjobInfo, err = cj.pluginAPI.GetCronJobs(cj.namespace)
+	cronjobInfo, err = cj.pluginAPI.GetCronJobs(ctx, cj.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch cronjobs: %v", err)
+		return nil, fmt.Errorf("failed to fetch cronjobs: %v", err)
 	}
-	cj.cronjobsInfo = cronjobInfo
 
-	cj.resourceData = make([]types.ResourceData, len(cronjobInfo))
-	for idx, cronjob := range cronjobInfo {
-		cj.resourceData[idx] = CronJobData{&cronjob}
-	}
+	return func() {
+		cj.cronjobsInfo = cronjobInfo
 
-	return nil
+		cj.resourceData = make([]types.ResourceData, len(cronjobInfo))
+		for idx, cronjob := range cronjobInfo {
+			cj.resourceData[idx] = CronJobData{&cronjob}
+		}
+	}, nil
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
@@ -18,6 +19,8 @@ import (
 )
 
 type customResourceModel struct {
+	screenLoader
+	fetched          bool
 	resourceTypeName string
 	resourceData     []types.ResourceData
 	k8sClient        *k8s.Client
@@ -66,13 +69,6 @@ func NewCustomResourceModel(k k8s.Client, namespace string, resourceType string)
 	}
 	logger.Debug("Custom resource model instance created")
 
-	logger.Info("Fetching initial data")
-	if err := cr.fetchData(); err != nil {
-		logger.Error(fmt.Sprintf("Error fetching initial data: %v", err))
-		return nil, err
-	}
-	logger.Info(fmt.Sprintf("Initial data fetched successfully: %d items", len(cr.resourceData)))
-
 	logger.Debug("Creating view model based on display component")
 	var viewModel tea.Model
 
@@ -95,7 +91,7 @@ func NewCustomResourceModel(k k8s.Client, namespace string, resourceType string)
 
 	logger.Debug("Creating auto refresh model")
 	if refreshableModel, ok := viewModel.(RefreshableModel); ok {
-		result := NewAutoRefreshModel(refreshableModel, customRT.RefreshInterval, &k, customRT.Name)
+		result := NewAutoRefreshModel(refreshableModel, customRT.RefreshInterval, &k, customRT.Name).CloseWith(cr)
 		logger.Debug("Auto refresh model created successfully")
 		return result, nil
 	} else {
@@ -121,10 +117,13 @@ func NewCustomResourceTextModel(cr *customResourceModel, resourceName, icon, nam
 }
 
 func (ct *CustomResourceTextModel) Init() tea.Cmd {
-	return nil
+	return ct.crModel.load()
 }
 
 func (ct *CustomResourceTextModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := ct.crModel.update(msg); ok {
+		return ct, cmd
+	}
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		styles.ScreenWidth = msg.Width
@@ -133,7 +132,14 @@ func (ct *CustomResourceTextModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return ct, nil
 }
 
+func (ct *CustomResourceTextModel) owns(target any) bool {
+	return ct.crModel.owns(target)
+}
+
 func (ct *CustomResourceTextModel) View() string {
+	if view, ok := ct.crModel.placeholder(); ok {
+		return view
+	}
 	if ct.crModel.resourceData == nil || len(ct.crModel.resourceData) == 0 {
 		return ct.renderEmptyState()
 	}
@@ -200,28 +206,71 @@ func (ct *CustomResourceTextModel) renderData() string {
 }
 
 func (ct *CustomResourceTextModel) Refresh() (tea.Model, tea.Cmd) {
-	if err := ct.crModel.fetchData(); err != nil {
-		logger.PluginError(ct.crModel.resourceTypeName, fmt.Sprintf("Error refreshing data: %v", err))
-	}
-	return ct, nil
+	return ct, ct.crModel.load()
 }
 
-func (cr *customResourceModel) fetchData() error {
+func (cr *customResourceModel) fetchData(ctx context.Context) ([]types.ResourceData, error) {
 	logger.Debug(fmt.Sprintf("Fetching data for resource type: %s, namespace: %s", cr.resourceType, cr.namespace))
 
 	if pm := plugins.GetGlobalPluginManager(); pm != nil {
 		logger.Debug("Plugin manager available, calling GetCustomResourceData")
-		data, err := pm.GetCustomResourceData(*cr.k8sClient, cr.resourceType, cr.namespace)
+		data, err := pm.GetCustomResourceData(ctx, *cr.k8sClient, cr.resourceType, cr.namespace)
 		if err != nil {
 			logger.Error(fmt.Sprintf("Error from GetCustomResourceData: %v", err))
-			return err
+			return nil, err
 		}
-		cr.resourceData = data
 		logger.Debug(fmt.Sprintf("Data fetched successfully, %d items", len(data)))
-	} else {
-		logger.Debug("Plugin manager not available")
+		return data, nil
+	}
+	logger.Debug("Plugin manager not available")
+	return nil, nil
+}
+
+func (cr *customResourceModel) load() tea.Cmd {
+	return load(&cr.screenLoader, cr.fetchData)
+}
+
+func (cr *customResourceModel) update(msg tea.Msg) (tea.Cmd, bool) {
+	if msg, ok := msg.(loadedMsg[[]types.ResourceData]); ok {
+		if loaded(&cr.screenLoader, msg) {
+			if msg.err != nil {
+				logger.PluginError(cr.resourceTypeName, fmt.Sprintf("Error refreshing data: %v", msg.err))
+			} else {
+				cr.resourceData = msg.value
+				cr.fetched = true
+			}
+		}
+		return nil, true
+	}
+	return cr.tick(msg)
+}
+
+func (cr *customResourceModel) placeholder() (string, bool) {
+	if cr.fetched {
+		return "", false
+	}
+	if cr.loading {
+		return cr.loadingView("Loading " + cr.resourceTypeName + "..."), true
+	}
+	if cr.loadErr != nil {
+		return cr.errorView("load "+cr.resourceTypeName, "q: Back"), true
+	}
+	return "", false
+}
+
+func (cr *customResourceModel) createEditMetadataAction(tableModel *components.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		var targets []types.ResourceData
+		for _, idx := range tableModel.GetCheckedItems() {
+			if idx >= 0 && idx < len(cr.resourceData) && cr.resourceData[idx] != nil {
+				targets = append(targets, cr.resourceData[idx])
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+		return editMetadataCmd(*cr.k8sClient, k8s.ResourceType(cr.resourceType), targets, tableModel.ClearCheckedItems)
 	}
-	return nil
 }
 
 func (cr *customResourceModel) Init() tea.Cmd {
@@ -259,10 +308,13 @@ func NewCustomResourceYAMLModel(cr *customResourceModel, resourceName, icon, nam
 }
 
 func (cy *CustomResourceYAMLModel) Init() tea.Cmd {
-	return nil
+	return cy.crModel.load()
 }
 
 func (cy *CustomResourceYAMLModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := cy.crModel.update(msg); ok {
+		return cy, cmd
+	}
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		styles.ScreenWidth = msg.Width
@@ -271,7 +323,14 @@ func (cy *CustomResourceYAMLModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return cy, nil
 }
 
+func (cy *CustomResourceYAMLModel) owns(target any) bool {
+	return cy.crModel.owns(target)
+}
+
 func (cy *CustomResourceYAMLModel) View() string {
+	if view, ok := cy.crModel.placeholder(); ok {
+		return view
+	}
 	if cy.crModel.resourceData == nil || len(cy.crModel.resourceData) == 0 {
 		return cy.renderEmptyState()
 	}
@@ -336,10 +395,7 @@ func (cy *CustomResourceYAMLModel) renderYAML() string {
 }
 
 func (cy *CustomResourceYAMLModel) Refresh() (tea.Model, tea.Cmd) {
-	if err := cy.crModel.fetchData(); err != nil {
-		logger.PluginError(cy.crModel.resourceTypeName, fmt.Sprintf("Error refreshing data: %v", err))
-	}
-	return cy, nil
+	return cy, cy.crModel.load()
 }
 
 type CustomResourceChartModel struct {
@@ -361,10 +417,13 @@ func NewCustomResourceChartModel(cr *customResourceModel, resourceName, icon, na
 }
 
 func (cc *CustomResourceChartModel) Init() tea.Cmd {
-	return nil
+	return cc.crModel.load()
 }
 
 func (cc *CustomResourceChartModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := cc.crModel.update(msg); ok {
+		return cc, cmd
+	}
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		styles.ScreenWidth = msg.Width
@@ -373,7 +432,14 @@ func (cc *CustomResourceChartModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return cc, nil
 }
 
+func (cc *CustomResourceChartModel) owns(target any) bool {
+	return cc.crModel.owns(target)
+}
+
 func (cc *CustomResourceChartModel) View() string {
+	if view, ok := cc.crModel.placeholder(); ok {
+		return view
+	}
 	if cc.crModel.resourceData == nil || len(cc.crModel.resourceData) == 0 {
 		return cc.renderEmptyState()
 	}
@@ -441,10 +507,7 @@ func (cc *CustomResourceChartModel) renderChart() string {
 }
 
 func (cc *CustomResourceChartModel) Refresh() (tea.Model, tea.Cmd) {
-	if err := cc.crModel.fetchData(); err != nil {
-		logger.PluginError(cc.crModel.resourceTypeName, fmt.Sprintf("Error refreshing data: %v", err))
-	}
-	return cc, nil
+	return cc, cc.crModel.load()
 }
 
 type CustomResourceTableModel struct {
@@ -454,7 +517,7 @@ type CustomResourceTableModel struct {
 }
 
 func NewCustomResourceTableModel(cr *customResourceModel, resourceName, icon, namespace string, displayComp plugins.DisplayComponent) *CustomResourceTableModel {
-	logger.Info(fmt.Sprintf("Creating table model for %s with %d items", resourceName, len(cr.resourceData)))
+	logger.Info(fmt.Sprintf("Creating table model for %s", resourceName))
 
 	columns := []table.Column{
 		{Title: "Name", Width: 20},
@@ -499,78 +562,39 @@ func NewCustomResourceTableModel(cr *customResourceModel, resourceName, icon, na
 		logger.Info("Using default column widths")
 	}
 
-	rows := make([]table.Row, 0, len(cr.resourceData))
-	if len(cr.resourceData) == 0 {
-		rows = append(rows, table.Row{"No data available", "", "", ""})
-		logger.Warn("No resource data available, adding placeholder row")
-	} else {
-		for i, item := range cr.resourceData {
-			if item == nil {
-				logger.Warn(fmt.Sprintf("Item %d is nil, skipping", i))
-				continue
-			}
-			itemColumns := item.GetColumns()
-			if len(itemColumns) >= 4 {
-				rows = append(rows, itemColumns[:4]) 
-				logger.Debug(fmt.Sprintf("Row %d: %v", i, itemColumns[:4]))
-			} else if len(itemColumns) > 0 {
-				paddedRow := make(table.Row, 4)
-				copy(paddedRow, itemColumns)
-				for j := len(itemColumns); j < 4; j++ {
-					paddedRow[j] = ""
-				}
-				rows = append(rows, paddedRow)
-				logger.Debug(fmt.Sprintf("Row %d (padded): %v", i, paddedRow))
-			} else {
-				rows = append(rows, table.Row{"N/A", "N/A", "N/A", "N/A"})
-				logger.Warn(fmt.Sprintf("Item %d has no columns, using fallback", i))
-			}
-		}
-	}
-
 	title := icon + " " + resourceName
 	if namespace != "" && namespace != "default" {
 		title += " in " + namespace
 	}
 
-	logger.Info(fmt.Sprintf("Creating table with %d columns and %d rows", len(columns), len(rows)))
+	logger.Info(fmt.Sprintf("Creating table with %d columns", len(columns)))
 
 	tableModel := components.NewTable(
 		columns,
 		colWidths,
-		rows,
+		nil,
 		title,
 		nil, 
 		0,   
-		func() ([]table.Row, error) {
+		func() (func() []table.Row, error) {
 			logger.Debug("Refreshing table data")
-			if err := cr.fetchData(); err != nil {
+			data, err := cr.fetchData(cr.requestContext())
+			if err != nil {
 				logger.Error(fmt.Sprintf("Error refreshing data: %v", err))
 				return nil, err
 			}
-			newRows := make([]table.Row, 0, len(cr.resourceData))
-			for i, item := range cr.resourceData {
-				if item == nil {
-					logger.Warn(fmt.Sprintf("Item %d is nil during refresh, skipping", i))
-					continue
-				}
-				columns := item.GetColumns()
-				if len(columns) > 0 {
-					newRows = append(newRows, columns)
-				} else {
-					logger.Warn(fmt.Sprintf("Item %d has no columns during refresh, using fallback", i))
-					newRows = append(newRows, table.Row{"N/A", "N/A", "N/A", "N/A"})
-				}
-			}
-			if len(newRows) == 0 {
-				newRows = append(newRows, table.Row{"No data available", "", "", ""})
-			}
-			logger.Debug(fmt.Sprintf("Refreshed to %d rows", len(newRows)))
-			return newRows, nil
+			return func() []table.Row {
+				cr.resourceData = data
+				return cr.rows()
+			}, nil
 		},
 		nil, 
 	)
 
+	tableModel.SetUpdateActions(map[string]func() tea.Cmd{
+		"L": cr.createEditMetadataAction(tableModel),
+	})
+
 	logger.Info("Table model created successfully")
 
 	return &CustomResourceTableModel{
@@ -597,5 +621,41 @@ func (ct *CustomResourceTableModel) View() string {
 }
 
 func (ct *CustomResourceTableModel) Refresh() (tea.Model, tea.Cmd) {
-	return ct.tableModel.Refresh()
+	_, cmd := ct.tableModel.Refresh()
+	return ct, cmd
+}
+
+func (ct *CustomResourceTableModel) owns(target any) bool {
+	return target == any(ct.tableModel)
+}
+
+func (ct *CustomResourceTableModel) Close() {
+	ct.tableModel.Close()
+}
+
+func (cr *customResourceModel) rows() []table.Row {
+	rows := make([]table.Row, 0, len(cr.resourceData))
+	for i, item := range cr.resourceData {
+		if item == nil {
+			logger.Warn(fmt.Sprintf("Item %d is nil, skipping", i))
+			continue
+		}
+		columns := item.GetColumns()
+		switch {
+		case len(columns) >= 4:
+			rows = append(rows, columns[:4])
+		case len(columns) > 0:
+			padded := make(table.Row, 4)
+			copy(padded, columns)
+			rows = append(rows, padded)
+		default:
+			logger.Warn(fmt.Sprintf("Item %d has no columns, using fallback", i))
+			rows = append(rows, table.Row{"N/A", "N/A", "N/A", "N/A"})
+		}
+	}
+	if len(rows) == 0 {
+		rows = append(rows, table.Row{"No data available", "", "", ""})
+	}
+	logger.Debug(fmt.Sprintf("Built %d rows", len(rows)))
+	return rows
 }
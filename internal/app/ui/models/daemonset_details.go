@@ -1,43 +1,48 @@
 package models
 
 import (
-	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type daemonsetDetailsModel struct {
+	describeView
 	daemonset *k8s.DaemonSetInfo
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
 }
 
 func NewDaemonSetDetails(k k8s.Client, namespace, daemonsetName string) *daemonsetDetailsModel {
 	return &daemonsetDetailsModel{
 		daemonset: k8s.NewDaemonSet(daemonsetName, namespace, k),
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
 	}
 }
 
 func (ds *daemonsetDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	ds.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeDaemonSet(ds.daemonset.Namespace, ds.daemonset.Name)
 
-	if err != nil {
-		return nil, err
-	}
+	ds.setup("daemonset", func(ctx context.Context) (string, error) {
+		return api.DescribeDaemonSet(ctx, ds.daemonset.Namespace, ds.daemonset.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewer("DaemonSet: "+ds.daemonset.Name, desc)
+		viewer.SetExportName(k.ContextName(), ds.daemonset.Namespace, "daemonset", ds.daemonset.Name)
+		viewer.SetEditTarget("daemonset", ds.daemonset.Namespace, ds.daemonset.Name)
+		return viewer
+	})
+	return ds, nil
+}
 
-	return components.NewYAMLViewer("DaemonSet: "+ds.daemonset.Name, desc), nil
+func (ds *daemonsetDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := ds.update(msg); ok {
+		return ds, cmd
+	}
+	return ds, ds.forward(msg)
 }
@@ -1,8 +1,8 @@
 package models
 
 import (
+	"context"
 	"fmt"
-	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
@@ -49,10 +49,6 @@ func NewDaemonSets(k k8s.Client, namespace string) (*daemonsetsModel, error) {
 func (ds *daemonsetsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	ds.k8sClient = k
 
-	if err := ds.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		daemonsetDetails, err := NewDaemonSetDetails(*k, ds.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -66,38 +62,37 @@ func (ds *daemonsetsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := ds.fetchData(); err != nil {
-			return nil, err
-		}
-		return ds.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(ds.config.Columns, ds.config.ColumnWidths, ds.dataToRows(), ds.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := ds.newTable(onSelect, ds.fetchData, ds.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": ds.createDeleteAction(tableModel),
+		"e": ds.createEditAction(tableModel),
+		"L": ds.createEditMetadataAction(tableModel),
+		"i": ds.createSetImagesAction(tableModel),
+		"l": ds.createWorkloadLogsAction(tableModel),
+		"R": ds.createRestartAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, ds.refreshInterval, ds.k8sClient, "DaemonSets"), nil
+	return NewAutoRefreshModel(tableModel, ds.refreshInterval, ds.k8sClient, "DaemonSets").Watch(k8s.ResourceTypeDaemonSet, ds.namespace).CloseWith(ds), nil
 }
 
-func (ds *daemonsetsModel) fetchData() error {
+func (ds *daemonsetsModel) fetchData(ctx context.Context) (func(), error) {
 	var daemonsetInfo []k8s.DaemonSetInfo
 	var err error
 
-	daemonsetInfo, err = ds.pluginAPI.GetDaemonSets(ds.namespace)
+	daemonsetInfo, err = ds.pluginAPI.GetDaemonSets(ctx, ds.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch daemonsets: %v", err)
+		return nil, fmt.Errorf("failed to fetch daemonsets: %v", err)
 	}
-	ds.daemonsetsInfo = daemonsetInfo
 
-	ds.resourceData = make([]types.ResourceData, len(daemonsetInfo))
-	for idx, daemonset := range daemonsetInfo {
-		ds.resourceData[idx] = DaemonSetData{&daemonset}
-	}
+	return func() {
+		ds.daemonsetsInfo = daemonsetInfo
 
-	return nil
+		ds.resourceData = make([]types.ResourceData, len(daemonsetInfo))
+		for idx, daemonset := range daemonsetInfo {
+			ds.resourceData[idx] = DaemonSetData{&daemonset}
+		}
+	}, nil
 }
@@ -0,0 +1,199 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"github.com/otavioCosta2110/k8s-tui/pkg/format"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type deploymentHistoryModel struct {
+	requestScope
+	deployment *k8s.DeploymentInfo
+	k8sClient  *k8s.Client
+	revisions  []k8s.DeploymentRevision
+}
+
+func NewDeploymentHistory(k k8s.Client, namespace, name string) *deploymentHistoryModel {
+	return &deploymentHistoryModel{
+		deployment: k8s.NewDeployment(name, namespace, k),
+		k8sClient:  &k,
+	}
+}
+
+func (h *deploymentHistoryModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	h.k8sClient = k
+
+	columns := []table.Column{
+		components.NewColumn("REVISION", 0),
+		components.NewColumn("REPLICASET", 0),
+		components.NewColumn("CHANGE-CAUSE", 0),
+		components.NewColumn("IMAGES", 0),
+		components.NewColumn("AGE", 0),
+		components.NewColumn("CURRENT", 0),
+	}
+	fetchFunc := func() (func() []table.Row, error) {
+		revisions, err := h.fetchData(h.requestContext())
+		if err != nil {
+			return nil, err
+		}
+		return func() []table.Row {
+			h.revisions = revisions
+			return h.rows()
+		}, nil
+	}
+	onSelect := func(selected string) tea.Msg {
+		revision, ok := h.findRevision(selected)
+		if !ok {
+			return nil
+		}
+		template, err := revision.TemplateYAML()
+		if err != nil {
+			return components.NavigateMsg{Error: err, Cluster: *h.k8sClient}
+		}
+		viewer := components.NewYAMLViewer(fmt.Sprintf("Revision %d: %s", revision.Revision, h.deployment.Name), template)
+		viewer.SetExportName(h.k8sClient.ContextName(), h.deployment.Namespace, "deployment", h.deployment.Name, "revision", selected)
+		return components.NavigateMsg{
+			NewScreen:  viewer,
+			Breadcrumb: "Revision " + selected,
+		}
+	}
+
+	title := customstyles.ResourceIcons["Deployments"] + " History of " + h.deployment.Name
+	tableModel := ui.NewTable(columns, []float64{0.4, 1.2, 1.5, 2, 0.5, 0.4}, h.rows(), title, onSelect, 0, fetchFunc, nil)
+	tableModel.SetUpdateActions(map[string]func() tea.Cmd{
+		"D": h.createDiffAction(tableModel),
+		"u": h.createRollbackAction(tableModel),
+	})
+
+	return NewAutoRefreshModel(tableModel, 5*time.Second, h.k8sClient, "History").Watch(k8s.ResourceTypeReplicaSet, h.deployment.Namespace).CloseWith(h), nil
+}
+
+func (h *deploymentHistoryModel) fetchData(ctx context.Context) ([]k8s.DeploymentRevision, error) {
+	deployment := *h.deployment
+	deployment.Raw = nil
+	return deployment.GetRevisions(ctx)
+}
+
+func (h *deploymentHistoryModel) rows() []table.Row {
+	rows := make([]table.Row, len(h.revisions))
+	for i, revision := range h.revisions {
+		current := ""
+		if revision.Current {
+			current = "*"
+		}
+		changeCause := revision.ChangeCause
+		if changeCause == "" {
+			changeCause = "<none>"
+		}
+		rows[i] = table.Row{
+			strconv.FormatInt(revision.Revision, 10),
+			revision.ReplicaSet.Name,
+			changeCause,
+			strings.Join(revision.Images, ", "),
+			format.FormatAge(revision.Created),
+			current,
+		}
+	}
+	return rows
+}
+
+func (h *deploymentHistoryModel) findRevision(selected string) (k8s.DeploymentRevision, bool) {
+	for _, revision := range h.revisions {
+		if strconv.FormatInt(revision.Revision, 10) == selected {
+			return revision, true
+		}
+	}
+	return k8s.DeploymentRevision{}, false
+}
+
+func (h *deploymentHistoryModel) currentRevision() (k8s.DeploymentRevision, bool) {
+	for _, revision := range h.revisions {
+		if revision.Current {
+			return revision, true
+		}
+	}
+	return k8s.DeploymentRevision{}, false
+}
+
+func (h *deploymentHistoryModel) createDiffAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		var selected []k8s.DeploymentRevision
+		for _, idx := range tableModel.GetCheckedItems() {
+			if idx >= 0 && idx < len(h.revisions) {
+				selected = append(selected, h.revisions[idx])
+			}
+		}
+		if len(selected) == 1 {
+			if current, ok := h.currentRevision(); ok && current.Revision != selected[0].Revision {
+				selected = append(selected, current)
+			}
+		}
+		if len(selected) != 2 {
+			client := *h.k8sClient
+			return func() tea.Msg {
+				return components.NavigateMsg{
+					Error:   fmt.Errorf("select two revisions to compare, or one to compare with the current revision"),
+					Cluster: client,
+				}
+			}
+		}
+		tableModel.ClearCheckedItems()
+
+		from, to := selected[0], selected[1]
+		if from.Revision > to.Revision {
+			from, to = to, from
+		}
+		client := *h.k8sClient
+		name := h.deployment.Name
+		return func() tea.Msg {
+			changes, err := k8s.RevisionDiff(from, to)
+			if err != nil {
+				return components.NavigateMsg{Error: err, Cluster: client}
+			}
+			viewer := components.NewDiffViewer(
+				fmt.Sprintf("Diff %s: revision %d → %d", name, from.Revision, to.Revision),
+				changes,
+				"↑/↓: Scroll • /: Search • ctrl+s: Export • q: Back",
+			)
+			viewer.SetExportName(client.ContextName(), h.deployment.Namespace, "deployment", name, fmt.Sprintf("revision-%d-%d", from.Revision, to.Revision))
+			return components.NavigateMsg{
+				NewScreen:  viewer,
+				Breadcrumb: "Diff",
+			}
+		}
+	}
+}
+
+func (h *deploymentHistoryModel) createRollbackAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(h.revisions) {
+			return nil
+		}
+
+		revision := h.revisions[selected]
+		deployment := h.deployment
+		ctx := h.requestContext()
+		client := *h.k8sClient
+		return func() tea.Msg {
+			if err := deployment.Rollback(ctx, revision); err != nil {
+				return components.NavigateMsg{Error: err, Cluster: client}
+			}
+			return components.NavigateMsg{
+				NewScreen:  NewRolloutStatus(client, k8s.ResourceTypeDeployment, deployment.Namespace, deployment.Name, fmt.Sprintf("rollback to %d", revision.Revision)),
+				Breadcrumb: "Rollout",
+			}
+		}
+	}
+}
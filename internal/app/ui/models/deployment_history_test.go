@@ -0,0 +1,64 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDeploymentHistoryDiff(t *testing.T) {
+	controller := true
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web", Namespace: "default", UID: "web-uid",
+			Annotations: map[string]string{k8s.RevisionAnnotation: "2"},
+		},
+		Spec: appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+	}
+	replicaSet := func(name, revision, image string) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: "default",
+				Labels:          map[string]string{"app": "web"},
+				Annotations:     map[string]string{k8s.RevisionAnnotation: revision},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web", UID: "web-uid", Controller: &controller}},
+			},
+			Spec: appsv1.ReplicaSetSpec{Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+			}},
+		}
+	}
+	client := k8s.Client{
+		Clientset: fake.NewSimpleClientset(deployment, replicaSet("web-1", "1", "web:1"), replicaSet("web-2", "2", "web:2")),
+		Namespace: "default",
+	}
+
+	history := NewDeploymentHistory(client, "default", "web")
+	model, err := history.InitComponent(&client)
+	if err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	tableModel := model.(*AutoRefreshModel).inner.(*components.TableModel)
+	runLoad(tableModel, tableModel.Init())
+	if rows := tableModel.Table.Rows(); len(rows) != 2 || rows[0][1] != "2" || rows[0][6] != "*" {
+		t.Fatalf("Expected the current revision first, got %v", rows)
+	}
+
+	tableModel.Table.SetCursor(1)
+	msg := history.createDiffAction(tableModel)()()
+	nav, ok := msg.(components.NavigateMsg)
+	if !ok || nav.Error != nil {
+		t.Fatalf("Expected a diff screen, got %+v", msg)
+	}
+	viewer := nav.NewScreen.(*components.YAMLViewer)
+	if content := viewer.GetOriginalContent(); !strings.Contains(content, "-        - image: web:1") || !strings.Contains(content, "+        - image: web:2") {
+		t.Errorf("Expected the selected revision to be compared with the current one, got:\n%s", content)
+	}
+}
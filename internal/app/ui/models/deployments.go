@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -48,32 +49,20 @@ func NewDeployments(k resources.Client, namespace string) (*deploymentsModel, er
 func (d *deploymentsModel) InitComponent(k *resources.Client) (tea.Model, error) {
 	d.k8sClient = k
 
-	if err := d.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
-		deployment := resources.NewDeployment(selected, d.namespace, *k)
-		err := deployment.Fetch()
-		if err != nil {
-			return components.NavigateMsg{
-				Error:   fmt.Errorf("failed to fetch deployment: %v", err),
-				Cluster: *k,
+		pods := NewPodsMatching(*k, d.namespace, func(ctx context.Context) (string, error) {
+			deployment := resources.NewDeployment(selected, d.namespace, *k)
+			if err := deployment.Fetch(ctx); err != nil {
+				return "", fmt.Errorf("failed to fetch deployment: %v", err)
 			}
-		}
-		selector, err := deployment.GetLabelSelector()
-		if err != nil {
-			logger.Debug(fmt.Sprintf("Failed to get label selector for deployment %s: %v, using fallback", deployment.Name, err))
-			selector = fmt.Sprintf("app=%s", deployment.Name)
-		}
-		logger.Debug(fmt.Sprintf("Using selector for deployment %s: %s", deployment.Name, selector))
-		pods, err := NewPods(*k, d.namespace, selector)
-		if err != nil {
-			return components.NavigateMsg{
-				Error:   err,
-				Cluster: *k,
+			selector, err := deployment.GetLabelSelector()
+			if err != nil {
+				logger.Debug(fmt.Sprintf("Failed to get label selector for deployment %s: %v, using fallback", deployment.Name, err))
+				selector = fmt.Sprintf("app=%s", deployment.Name)
 			}
-		}
+			logger.Debug(fmt.Sprintf("Using selector for deployment %s: %s", deployment.Name, selector))
+			return selector, nil
+		})
 
 		podsComponent, err := pods.InitComponent(k)
 		if err != nil {
@@ -89,62 +78,73 @@ func (d *deploymentsModel) InitComponent(k *resources.Client) (tea.Model, error)
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := d.fetchData(); err != nil {
-			return nil, err
-		}
-		return d.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(d.config.Columns, d.config.ColumnWidths, d.dataToRows(), d.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := d.newTable(onSelect, d.fetchData, d.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": d.createDeleteAction(tableModel),
+		"e": d.createEditAction(tableModel),
+		"L": d.createEditMetadataAction(tableModel),
+		"i": d.createSetImagesAction(tableModel),
+		"l": d.createWorkloadLogsAction(tableModel),
+		"s": d.createScaleAction(tableModel),
+		"R": d.createRestartAction(tableModel),
+		"h": d.createHistoryAction(tableModel),
+		"p": d.createRolloutAction(tableModel, "pause", func(ctx context.Context, client resources.Client, namespace, name string) error {
+			return resources.SetDeploymentPaused(ctx, client, namespace, name, true)
+		}),
+		"P": d.createRolloutAction(tableModel, "resume", func(ctx context.Context, client resources.Client, namespace, name string) error {
+			return resources.SetDeploymentPaused(ctx, client, namespace, name, false)
+		}),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, d.refreshInterval, d.k8sClient, "Deployments"), nil
+	return NewAutoRefreshModel(tableModel, d.refreshInterval, d.k8sClient, "Deployments").Watch(resources.ResourceTypeDeployment, d.namespace).CloseWith(d), nil
 }
 
-func (d *deploymentsModel) fetchData() error {
+func (d *deploymentsModel) fetchData(ctx context.Context) (func(), error) {
 	var deploymentInfo []resources.DeploymentInfo
 	var err error
 
-	deploymentInfo, err = d.pluginAPI.GetDeployments(d.namespace)
+	deploymentInfo, err = d.pluginAPI.GetDeployments(ctx, d.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch deployments: %v", err)
+		return nil, fmt.Errorf("failed to fetch deployments: %v", err)
 	}
-	d.deploymentsInfo = deploymentInfo
 
-	d.resourceData = make([]types.ResourceData, len(deploymentInfo))
-	for i, deployment := range deploymentInfo {
-		d.resourceData[i] = DeploymentData{&deployment}
-	}
+	return func() {
+		d.deploymentsInfo = deploymentInfo
 
-	return nil
+		d.resourceData = make([]types.ResourceData, len(deploymentInfo))
+		for i, deployment := range deploymentInfo {
+			d.resourceData[i] = DeploymentData{&deployment}
+		}
+	}, nil
 }
 
-func (d *deploymentsModel) createRolloutAction(tableModel *ui.TableModel) func() tea.Cmd {
+func (d *deploymentsModel) createHistoryAction(tableModel *ui.TableModel) func() tea.Cmd {
 	return func() tea.Cmd {
 		if tableModel == nil {
 			return nil
 		}
 
 		selected := tableModel.Table.Cursor()
-		if selected < 0 || selected >= len(d.deploymentsInfo) {
+		if selected < 0 || selected >= len(d.resourceData) {
 			return nil
 		}
 
-		deployment := d.deploymentsInfo[selected]
+		deployment := d.resourceData[selected]
 
 		return func() tea.Msg {
+			history, err := NewDeploymentHistory(*d.k8sClient, deployment.GetNamespace(), deployment.GetName()).InitComponent(d.k8sClient)
+			if err != nil {
+				return components.NavigateMsg{
+					Error:   err,
+					Cluster: *d.k8sClient,
+				}
+			}
 			return components.NavigateMsg{
-				NewScreen: components.NewYAMLViewer(
-					"Rollout Triggered",
-					fmt.Sprintf("Rollout triggered for deployment: %s/%s\n\nStatus: Rollout initiated successfully", deployment.Namespace, deployment.Name),
-				),
-				Cluster: *d.k8sClient,
+				NewScreen:  history,
+				Breadcrumb: "History",
 			}
 		}
 	}
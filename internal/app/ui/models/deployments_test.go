@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -157,7 +158,7 @@ func TestDeploymentSelectionWithPodFiltering(t *testing.T) {
 		t.Errorf("Expected selector %q, got %q", expectedSelector, selector)
 	}
 
-	pods, err := deployment.GetPods()
+	pods, err := deployment.GetPods(context.Background())
 	if err != nil {
 		t.Fatalf("GetPods failed: %v", err)
 	}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"context"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type describeView struct {
+	screenLoader
+	subject    string
+	describe   func(ctx context.Context) (string, error)
+	build      func(desc string) *components.YAMLViewer
+	yamlViewer *components.YAMLViewer
+}
+
+func (d *describeView) setup(subject string, describe func(ctx context.Context) (string, error), build func(desc string) *components.YAMLViewer) {
+	d.subject = subject
+	d.describe = describe
+	d.build = build
+}
+
+func (d *describeView) reload() tea.Cmd {
+	return load(&d.screenLoader, d.describe)
+}
+
+func (d *describeView) Init() tea.Cmd {
+	if d.yamlViewer == nil {
+		return d.reload()
+	}
+	return d.yamlViewer.Init()
+}
+
+func (d *describeView) update(msg tea.Msg) (tea.Cmd, bool) {
+	switch msg := msg.(type) {
+	case loadedMsg[string]:
+		if !loaded(&d.screenLoader, msg) || msg.err != nil {
+			return nil, true
+		}
+		d.yamlViewer = d.build(msg.value)
+		return d.yamlViewer.Init(), true
+
+	case tea.KeyMsg:
+		if d.loadErr != nil && !d.loading && msg.String() == "r" {
+			return d.reload(), true
+		}
+	}
+	return d.tick(msg)
+}
+
+func (d *describeView) forward(msg tea.Msg) tea.Cmd {
+	if d.yamlViewer == nil {
+		return nil
+	}
+	updatedModel, cmd := d.yamlViewer.Update(msg)
+	if viewer, ok := updatedModel.(*components.YAMLViewer); ok {
+		d.yamlViewer = viewer
+	}
+	return cmd
+}
+
+func (d *describeView) CapturingInput() bool {
+	return d.yamlViewer != nil && d.yamlViewer.CapturingInput()
+}
+
+func (d *describeView) View() string {
+	if d.loadErr != nil {
+		return d.errorView("describe "+d.subject, "q: Back")
+	}
+	if d.yamlViewer == nil {
+		return d.loadingView("Loading " + d.subject + "...")
+	}
+	return d.yamlViewer.View()
+}
@@ -189,6 +189,7 @@ func (m HeaderModel) getClusterInfo() map[string]string {
 		return info
 	}
 
+	info["context"] = m.kubeconfig.ContextName()
 	info["namespace"] = m.kubeconfig.Namespace
 	if info["namespace"] == "" {
 		info["namespace"] = "default"
@@ -221,6 +222,10 @@ func (m HeaderModel) buildClusterSection(info map[string]string) string {
 
 	content := []string{
 		titleStyle.Background(lipgloss.Color(customstyles.BackgroundColor)).Render("Cluster Info"),
+		lipgloss.JoinHorizontal(lipgloss.Left,
+			labelStyle.Background(lipgloss.Color(customstyles.BackgroundColor)).Render("Context:"),
+			labelStyle.Background(lipgloss.Color(customstyles.BackgroundColor)).Render(" "),
+			valueStyle.Background(lipgloss.Color(customstyles.BackgroundColor)).Render(info["context"])),
 		lipgloss.JoinHorizontal(lipgloss.Left,
 			labelStyle.Background(lipgloss.Color(customstyles.BackgroundColor)).Render("Namespace:"),
 			labelStyle.Background(lipgloss.Color(customstyles.BackgroundColor)).Render(" "),
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
@@ -9,35 +10,39 @@ import (
 )
 
 type ingressDetailsModel struct {
+	describeView
 	ingress   *k8s.IngressInfo
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
 }
 
 func NewIngressDetails(k k8s.Client, namespace, ingressName string) *ingressDetailsModel {
 	return &ingressDetailsModel{
 		ingress:   k8s.NewIngress(ingressName, namespace, k),
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
 	}
 }
 
 func (i *ingressDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	i.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeIngress(i.ingress.Namespace, i.ingress.Name)
 
-	if err != nil {
-		return nil, err
-	}
+	i.setup("ingress", func(ctx context.Context) (string, error) {
+		return api.DescribeIngress(ctx, i.ingress.Namespace, i.ingress.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewer("Ingress: "+i.ingress.Name, desc)
+		viewer.SetExportName(k.ContextName(), i.ingress.Namespace, "ingress", i.ingress.Name)
+		viewer.SetEditTarget("ingress", i.ingress.Namespace, i.ingress.Name)
+		return viewer
+	})
+	return i, nil
+}
 
-	return components.NewYAMLViewer("Ingress: "+i.ingress.Name, desc), nil
+func (i *ingressDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := i.update(msg); ok {
+		return i, cmd
+	}
+	return i, i.forward(msg)
 }
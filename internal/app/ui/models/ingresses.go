@@ -1,10 +1,10 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
-	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
 	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
 	"time"
@@ -47,10 +47,6 @@ func NewIngresses(k k8s.Client, namespace string) (*ingressesModel, error) {
 func (i *ingressesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	i.k8sClient = k
 
-	if err := i.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		ingressDetails, err := NewIngressDetails(*k, i.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -64,38 +60,34 @@ func (i *ingressesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := i.fetchData(); err != nil {
-			return nil, err
-		}
-		return i.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(i.config.Columns, i.config.ColumnWidths, i.dataToRows(), i.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := i.newTable(onSelect, i.fetchData, i.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": i.createDeleteAction(tableModel),
+		"e": i.createEditAction(tableModel),
+		"L": i.createEditMetadataAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, i.refreshInterval, i.k8sClient, "Ingresses"), nil
+	return NewAutoRefreshModel(tableModel, i.refreshInterval, i.k8sClient, "Ingresses").Watch(k8s.ResourceTypeIngress, i.namespace).CloseWith(i), nil
 }
 
-func (i *ingressesModel) fetchData() error {
+func (i *ingressesModel) fetchData(ctx context.Context) (func(), error) {
 	var ingressInfo []k8s.IngressInfo
 	var err error
 
-	ingressInfo, err = i.pluginAPI.GetIngresses(i.namespace)
+	ingressInfo, err = i.pluginAPI.GetIngresses(ctx, i.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch ingresses: %v", err)
+		return nil, fmt.Errorf("failed to fetch ingresses: %v", err)
 	}
-	i.ingressesInfo = ingressInfo
 
-	i.resourceData = make([]types.ResourceData, len(ingressInfo))
-	for idx, ingress := range ingressInfo {
-		i.resourceData[idx] = IngressData{&ingress}
-	}
+	return func() {
+		i.ingressesInfo = ingressInfo
 
-	return nil
+		i.resourceData = make([]types.ResourceData, len(ingressInfo))
+		for idx, ingress := range ingressInfo {
+			i.resourceData[idx] = IngressData{&ingress}
+		}
+	}, nil
 }
@@ -1,43 +1,48 @@
 package models
 
 import (
-	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type jobDetailsModel struct {
+	describeView
 	job       *k8s.JobInfo
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
 }
 
 func NewJobDetails(k k8s.Client, namespace, jobName string) *jobDetailsModel {
 	return &jobDetailsModel{
 		job:       k8s.NewJob(jobName, namespace, k),
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
 	}
 }
 
 func (j *jobDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	j.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeJob(j.job.Namespace, j.job.Name)
 
-	if err != nil {
-		return nil, err
-	}
+	j.setup("job", func(ctx context.Context) (string, error) {
+		return api.DescribeJob(ctx, j.job.Namespace, j.job.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewer("Job: "+j.job.Name, desc)
+		viewer.SetExportName(k.ContextName(), j.job.Namespace, "job", j.job.Name)
+		viewer.SetEditTarget("job", j.job.Namespace, j.job.Name)
+		return viewer
+	})
+	return j, nil
+}
 
-	return components.NewYAMLViewer("Job: "+j.job.Name, desc), nil
+func (j *jobDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := j.update(msg); ok {
+		return j, cmd
+	}
+	return j, j.forward(msg)
 }
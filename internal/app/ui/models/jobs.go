@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
@@ -45,10 +46,6 @@ func NewJobs(k k8s.Client, namespace string) (*jobsModel, error) {
 func (j *jobsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	j.k8sClient = k
 
-	if err := j.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		jobDetails, err := NewJobDetails(*k, j.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -62,38 +59,80 @@ func (j *jobsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := j.fetchData(); err != nil {
-			return nil, err
-		}
-		return j.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(j.config.Columns, j.config.ColumnWidths, j.dataToRows(), j.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := j.newTable(onSelect, j.fetchData, j.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": j.createDeleteAction(tableModel),
+		"e": j.createEditAction(tableModel),
+		"L": j.createEditMetadataAction(tableModel),
+		"l": j.createWorkloadLogsAction(tableModel),
+		"R": j.createRerunAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, j.refreshInterval, j.k8sClient, "Jobs"), nil
+	return NewAutoRefreshModel(tableModel, j.refreshInterval, j.k8sClient, "Jobs").Watch(k8s.ResourceTypeJob, j.namespace).CloseWith(j), nil
+}
+
+func (j *jobsModel) createRerunAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(j.resourceData) {
+			return nil
+		}
+		resource := j.resourceData[selected]
+		return rerunJobCmd(j.requestContext(), *j.k8sClient, resource.GetNamespace(), resource.GetName())
+	}
+}
+
+func rerunJobCmd(ctx context.Context, k k8s.Client, namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		created, err := k8s.NewJob(name, namespace, k).Rerun(ctx)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+
+		job := k8s.NewJob(created.Name, namespace, k)
+		job.Raw = created
+		pods, err := NewPods(k, namespace, job.PodSelector())
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		podsComponent, err := pods.InitComponent(&k)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		return components.NavigateMsg{
+			NewScreen:  podsComponent,
+			Breadcrumb: created.Name,
+		}
+	}
 }
 
-func (j *jobsModel) fetchData() error {
+func (j *jobsModel) fetchData(ctx context.Context) (func(), error) {
 	var jobInfo []k8s.JobInfo
 	var err error
 
-	jobInfo, err = j.pluginAPI.GetJobs(j.namespace)
+	jobInfo, err = j.pluginAPI.GetJobs(ctx, j.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch jobs: %v", err)
+		return nil, fmt.Errorf("failed to fetch jobs: %v", err)
 	}
-	j.jobsInfo = jobInfo
 
-	j.resourceData = make([]types.ResourceData, len(jobInfo))
-	for idx, job := range jobInfo {
-		j.resourceData[idx] = JobData{&job}
-	}
+	return func() {
+		j.jobsInfo = jobInfo
 
-	return nil
+		j.resourceData = make([]types.ResourceData, len(jobInfo))
+		for idx, job := range jobInfo {
+			j.resourceData[idx] = JobData{&job}
+		}
+	}, nil
 }
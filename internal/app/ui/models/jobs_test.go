@@ -6,6 +6,18 @@ import (
 	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
 	"testing"
 	"time"
+	"context"
+	"strings"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestNewJobs(t *testing.T) {
@@ -91,3 +103,44 @@ func TestJobsModelConfig(t *testing.T) {
 		t.Error("Expected refresh interval to be 5 seconds")
 	}
 }
+
+func TestRerunJobShowsNewPods(t *testing.T) {
+	plugins.SetGlobalPluginManager(plugins.NewPluginManager(t.TempDir()))
+	defer plugins.SetGlobalPluginManager(nil)
+
+	original := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{batchv1.ControllerUidLabel: "old-uid"}},
+		},
+	}
+	pod := func(name, job string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "default",
+			Labels: map[string]string{batchv1.JobNameLabel: job},
+		}}
+	}
+	clientset := fake.NewSimpleClientset(original, pod("migrate-abc", "migrate"))
+	client := k8s.Client{Clientset: clientset, Namespace: "default"}
+
+	clientset.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		job := action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+		clientset.Tracker().Add(pod(job.Name+"-xyz", job.Name))
+		return false, nil, nil
+	})
+
+	msg := rerunJobCmd(context.Background(), client, "default", "migrate")()
+	nav, ok := msg.(components.NavigateMsg)
+	if !ok || nav.Error != nil {
+		t.Fatalf("Expected the pods of the new job, got %+v", msg)
+	}
+	if !strings.HasPrefix(nav.Breadcrumb, "migrate-rerun-") {
+		t.Errorf("Expected the new job in the breadcrumb, got %s", nav.Breadcrumb)
+	}
+	tableModel := nav.NewScreen.(*AutoRefreshModel).inner.(*components.TableModel)
+	runLoad(tableModel, tableModel.Init())
+	rows := tableModel.Table.Rows()
+	if len(rows) != 1 || !strings.HasPrefix(rows[0][2], "migrate-rerun-") {
+		t.Errorf("Expected only the new job's pod, got %v", rows)
+	}
+}
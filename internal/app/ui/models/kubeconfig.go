@@ -6,80 +6,69 @@ import (
 	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/logger"
-	"os"
-	"path/filepath"
 
+	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type kubeconfigModel struct {
-	configs    []string
-	k8sClient  *k8s.Client
-	kubeconfig string
-	loading    bool
-	err        error
+	options k8s.KubeconfigOptions
 }
 
-func NewKubeconfigModel() *kubeconfigModel {
+func NewKubeconfigModel(options k8s.KubeconfigOptions) *kubeconfigModel {
 	return &kubeconfigModel{
-		configs:    styles.GetKubeconfigsLocations(),
-		k8sClient:  nil,
-		kubeconfig: "",
-		loading:    true,
-		err:        nil,
+		options: options,
 	}
 }
 
-func (k kubeconfigModel) InitComponent(_ *k8s.Client) (tea.Model, error) {
+func (k *kubeconfigModel) InitComponent(_ *k8s.Client) (tea.Model, error) {
 	styles.IsHeaderActive = false
-	var items []string
-	for _, configs := range styles.GetKubeconfigsLocations() {
-		kubeconfigs, err := os.ReadDir(configs)
-		if err != nil {
-			logger.Warn(fmt.Sprintf("Warning: %v", err))
-			continue
-		}
-		for _, file := range kubeconfigs {
-			if !file.IsDir() {
-				fullPath := filepath.Join(configs, file.Name())
-				items = append(items, fullPath)
-			}
-		}
+
+	contexts, err := k8s.ListContexts(k.options.Path)
+	if err != nil {
+		return nil, err
+	}
+	if len(contexts) == 0 {
+		return nil, fmt.Errorf("no contexts found in kubeconfig")
 	}
 
-	onSelect := func(selected string) tea.Msg {
-		k.kubeconfig = selected
-		os.Setenv("KUBECONFIG", selected)
-		os.Setenv("KUBERNETES_MASTER", selected)
-		c, err := k8s.NewClient(selected, "")
-		k.k8sClient = c
-		if err != nil {
-			logger.Error(fmt.Sprintf("Error creating clientset: %v", err))
-			return components.NavigateMsg{
-				Error: err,
-			}
-		}
+	columns := []table.Column{
+		{Title: "Current", Width: 8},
+		{Title: "Context", Width: 30},
+		{Title: "Cluster", Width: 25},
+		{Title: "User", Width: 25},
+		{Title: "Namespace", Width: 20},
+	}
 
-		namespaces, err := NewNamespaces(*k.k8sClient)
-		if err != nil {
-			return components.NavigateMsg{
-				Error:   err,
-				Cluster: *k.k8sClient,
-			}
+	var rows []table.Row
+	for _, context := range contexts {
+		current := ""
+		if context.Current {
+			current = "*"
 		}
+		rows = append(rows, table.Row{current, context.Name, context.Cluster, context.User, context.Namespace})
+	}
 
-		nm, err := namespaces.InitComponent(k.k8sClient)
-		if err != nil {
-			return components.NavigateMsg{
-				Error:   err,
-				Cluster: *k.k8sClient,
-			}
-		}
+	return components.NewTable(columns, []float64{0.08, 0.3, 0.22, 0.22, 0.18}, rows, "Contexts", k.connect, 1, nil, nil), nil
+}
+
+func (k *kubeconfigModel) connect(contextName string) tea.Msg {
+	options := k.options
+	options.Context = contextName
+	c, err := k8s.NewClient(options, "")
+	if err != nil {
+		logger.Error(fmt.Sprintf("Error creating clientset: %v", err))
 		return components.NavigateMsg{
-			NewScreen: nm,
-			Cluster:   *k.k8sClient,
+			Error: err,
 		}
 	}
 
-	return components.NewList(items, "Kubeconfigs", onSelect), nil
+	msg := components.NavigateMsg{
+		Cluster: *c,
+	}
+	if c.Namespace == "" {
+		msg.NewScreen, _ = NewNamespaces(*c).InitComponent(c)
+		msg.Breadcrumb = "Namespaces"
+	}
+	return msg
 }
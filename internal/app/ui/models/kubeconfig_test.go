@@ -0,0 +1,75 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const pickerKubeconfig = `apiVersion: v1
+kind: Config
+current-context: staging
+clusters:
+- name: cluster-a
+  cluster:
+    server: https://a.example.com
+users:
+- name: dev
+  user:
+    token: dev-token
+contexts:
+- name: prod
+  context:
+    cluster: cluster-a
+    user: dev
+    namespace: web
+- name: staging
+  context:
+    cluster: cluster-a
+    user: dev
+`
+
+func TestKubeconfigPickerListsContexts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	os.WriteFile(path, []byte(pickerKubeconfig), 0600)
+
+	model, err := NewKubeconfigModel(k8s.KubeconfigOptions{Path: path}).InitComponent(nil)
+	if err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	picker := model.(*components.TableModel)
+	rows := picker.Table.Rows()
+	if len(rows) != 2 || rows[0][2] != "prod" || rows[0][5] != "web" || rows[1][1] != "*" {
+		t.Fatalf("Expected both contexts with the current one marked, got %v", rows)
+	}
+
+	_, cmd := picker.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	nav := cmd().(components.NavigateMsg)
+	if nav.Error != nil || nav.Cluster.ContextName() != "prod" || nav.Cluster.Namespace != "web" {
+		t.Fatalf("Expected to connect to prod in its namespace, got %+v", nav)
+	}
+	if nav.NewScreen != nil {
+		t.Error("Expected a context with a namespace to skip the namespace picker")
+	}
+
+	picker.Table.SetCursor(1)
+	_, cmd = picker.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	nav = cmd().(components.NavigateMsg)
+	if _, ok := nav.NewScreen.(*namespacesModel); !ok || nav.Cluster.ContextName() != "staging" {
+		t.Errorf("Expected the namespace picker for staging, got %+v", nav)
+	}
+}
+
+func TestKubeconfigPickerWithoutContexts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	os.WriteFile(path, []byte("apiVersion: v1\nkind: Config\n"), 0600)
+
+	if _, err := NewKubeconfigModel(k8s.KubeconfigOptions{Path: path}).InitComponent(nil); err == nil {
+		t.Error("Expected an error when the kubeconfig has no contexts")
+	}
+}
@@ -20,11 +20,7 @@ func NewMainModel(k k8s.Client, namespace string) MainModel {
 
 func (m MainModel) InitComponent(k k8s.Client) (tea.Model, error) {
 	if k.Namespace == "" {
-		namespaces, err := NewNamespaces(k)
-		if err != nil {
-			return nil, err
-		}
-		namespacesComponent, err := namespaces.InitComponent(&k)
+		namespacesComponent, err := NewNamespaces(k).InitComponent(&k)
 		if err != nil {
 			return nil, err
 		}
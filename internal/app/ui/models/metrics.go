@@ -26,6 +26,7 @@ type Metrics struct {
 }
 
 type MetricsManager struct {
+	client   k8s.Client
 	loader   *k8s.MetricsLoader
 	metrics  Metrics
 	lastLoad time.Time
@@ -38,8 +39,13 @@ func (m Metrics) GetMetrics() Metrics {
 }
 
 func NewMetricsManager(k k8s.Client) *MetricsManager {
+	if metricsManager != nil && metricsManager.client.Clientset != k.Clientset {
+		metricsManager.Stop()
+		metricsManager = nil
+	}
 	if metricsManager == nil {
 		metricsManager = &MetricsManager{
+			client: k,
 			loader: k8s.NewMetricsLoader(k),
 		}
 		metricsManager.loader.Start()
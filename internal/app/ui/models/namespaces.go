@@ -1,7 +1,7 @@
 package models
 
 import (
-	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"context"
 	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
@@ -11,35 +11,73 @@ import (
 )
 
 type namespacesModel struct {
+	screenLoader
 	list      []string
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
+	picker    *ui.ListModel
 }
 
-func NewNamespaces(k k8s.Client) (*namespacesModel, error) {
-	namespaces, err := k8s.FetchNamespaces(k)
-	if err != nil {
-		return nil, err
-	}
-
+func NewNamespaces(k k8s.Client) *namespacesModel {
 	return &namespacesModel{
-		list:      namespaces,
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
-	}, nil
+	}
 }
 
 func (n *namespacesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	n.k8sClient = k
-	namespaces, err := k8s.FetchNamespaces(*k)
-	if err != nil {
-		return nil, err
+	return n, nil
+}
+
+func (n *namespacesModel) fetch() tea.Cmd {
+	client := *n.k8sClient
+	return load(&n.screenLoader, func(ctx context.Context) ([]string, error) {
+		return k8s.FetchNamespaces(ctx, client)
+	})
+}
+
+func (n *namespacesModel) Init() tea.Cmd {
+	if n.picker == nil {
+		return n.fetch()
 	}
+	return n.picker.Init()
+}
 
+func (n *namespacesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(loadedMsg[[]string]); ok {
+		if !loaded(&n.screenLoader, msg) || msg.err != nil {
+			return n, nil
+		}
+		n.list = msg.value
+		n.picker = n.newPicker()
+		return n, n.picker.Init()
+	}
+	if n.picker == nil {
+		if cmd, ok := n.tick(msg); ok {
+			return n, cmd
+		}
+		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "r" && n.loadErr != nil && !n.loading {
+			return n, n.fetch()
+		}
+		return n, nil
+	}
+
+	_, cmd := n.picker.Update(msg)
+	return n, cmd
+}
+
+func (n *namespacesModel) View() string {
+	if n.picker == nil {
+		if n.loadErr != nil {
+			return n.errorView("load namespaces", "q: Back")
+		}
+		return n.loadingView("Loading namespaces...")
+	}
+	return n.picker.View()
+}
+
+func (n *namespacesModel) newPicker() *ui.ListModel {
 	var listItems []ui.ListItem
-	for _, namespace := range namespaces {
+	for _, namespace := range n.list {
 		listItems = append(listItems, ui.NewItem(customstyles.ResourceIcons["Namespaces"]+" "+namespace, ""))
 	}
 
@@ -49,10 +87,8 @@ func (n *namespacesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 			namespace = strings.TrimPrefix(selected, icon+" ")
 		}
 
-		return components.NavigateMsg{
-			NewScreen: NewResource(*k, namespace).InitComponent(*k),
-		}
+		return SwitchNamespaceMsg{Namespace: namespace}
 	}
 
-	return ui.NewListWithItems(listItems, customstyles.ResourceIcons["Namespaces"]+" Namespaces", onSelect), nil
+	return ui.NewListWithItems(listItems, customstyles.ResourceIcons["Namespaces"]+" Namespaces", onSelect)
 }
@@ -11,8 +11,6 @@ func TestNewNamespaces(t *testing.T) {
 	model := &namespacesModel{
 		list:      []string{"default", "kube-system", "kube-public"},
 		k8sClient: &client,
-		loading:   false,
-		err:       nil,
 	}
 
 	if model == nil {
@@ -27,7 +25,7 @@ func TestNewNamespaces(t *testing.T) {
 	if model.loading {
 		t.Error("Expected loading to be false")
 	}
-	if model.err != nil {
+	if model.loadErr != nil {
 		t.Error("Expected no error")
 	}
 }
@@ -38,8 +36,6 @@ func TestNamespacesModelWithEmptyList(t *testing.T) {
 	model := &namespacesModel{
 		list:      []string{},
 		k8sClient: &client,
-		loading:   false,
-		err:       nil,
 	}
 
 	if len(model.list) != 0 {
@@ -53,8 +49,6 @@ func TestNamespacesModelWithSingleNamespace(t *testing.T) {
 	model := &namespacesModel{
 		list:      []string{"production"},
 		k8sClient: &client,
-		loading:   false,
-		err:       nil,
 	}
 
 	if len(model.list) != 1 {
@@ -80,8 +74,6 @@ func TestNamespacesModelWithSystemNamespaces(t *testing.T) {
 	model := &namespacesModel{
 		list:      systemNamespaces,
 		k8sClient: &client,
-		loading:   false,
-		err:       nil,
 	}
 
 	if len(model.list) != 6 {
@@ -110,9 +102,8 @@ func TestNamespacesModelWithLoadingState(t *testing.T) {
 	model := &namespacesModel{
 		list:      []string{},
 		k8sClient: &client,
-		loading:   true,
-		err:       nil,
 	}
+	model.loading = true
 
 	if !model.loading {
 		t.Error("Expected loading to be true")
@@ -130,14 +121,13 @@ func TestNamespacesModelWithError(t *testing.T) {
 	model := &namespacesModel{
 		list:      []string{},
 		k8sClient: &client,
-		loading:   false,
-		err:       testErr,
 	}
+	model.loadErr = testErr
 
-	if model.err == nil {
+	if model.loadErr == nil {
 		t.Error("Expected error to be set")
 	}
-	if model.err.Error() != "connection failed" {
+	if model.loadErr.Error() != "connection failed" {
 		t.Error("Expected specific error message")
 	}
 	if model.loading {
@@ -159,8 +149,6 @@ func TestNamespacesModelClientAssignment(t *testing.T) {
 	model := &namespacesModel{
 		list:      []string{"default"},
 		k8sClient: &client,
-		loading:   false,
-		err:       nil,
 	}
 
 	if model.k8sClient == nil {
@@ -195,8 +183,6 @@ func TestNamespacesModelNamespaceDiversity(t *testing.T) {
 	model := &namespacesModel{
 		list:      diverseNamespaces,
 		k8sClient: &client,
-		loading:   false,
-		err:       nil,
 	}
 
 	if len(model.list) != 15 {
@@ -1,43 +1,78 @@
 package models
 
 import (
-	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type nodeDetailsModel struct {
+	describeView
 	node      *k8s.NodeInfo
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
 }
 
 func NewNodeDetails(k k8s.Client, nodeName string) *nodeDetailsModel {
 	return &nodeDetailsModel{
 		node:      k8s.NewNode(nodeName, k),
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
 	}
 }
 
 func (n *nodeDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	n.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeNode(n.node.Name)
 
-	if err != nil {
-		return nil, err
+	n.setup("node", func(ctx context.Context) (string, error) {
+		return api.DescribeNode(ctx, n.node.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewerWithHelp(
+			"Node: "+n.node.Name,
+			desc,
+			"↑/↓: Scroll • /: Search • e: Edit taints/labels • ctrl+s: Export • q: Quit",
+		)
+		viewer.SetExportName(k.ContextName(), "", "node", n.node.Name)
+		return viewer
+	})
+	return n, nil
+}
+
+func (n *nodeDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := n.update(msg); ok {
+		return n, cmd
 	}
 
-	return components.NewYAMLViewer("Node: "+n.node.Name, desc), nil
+	switch msg := msg.(type) {
+	case nodeUpdatedMsg:
+		if msg.name != n.node.Name {
+			return n, nil
+		}
+		return n, n.reload()
+	case tea.KeyMsg:
+		if !n.CapturingInput() && msg.String() == "e" {
+			return n, n.openEditor
+		}
+	}
+
+	return n, n.forward(msg)
+}
+
+func (n *nodeDetailsModel) openEditor() tea.Msg {
+	editor, err := NewNodeEdit(*n.k8sClient, n.node.Name).InitComponent(n.k8sClient)
+	if err != nil {
+		return components.NavigateMsg{
+			Error:   err,
+			Cluster: *n.k8sClient,
+		}
+	}
+	return components.NavigateMsg{
+		NewScreen:  editor,
+		Breadcrumb: "Edit",
+	}
 }
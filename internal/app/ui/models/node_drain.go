@@ -0,0 +1,289 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/config"
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const nodeDrainTick = 200 * time.Millisecond
+
+type nodeDrainTickMsg struct {
+	model *nodeDrainModel
+}
+
+func (msg nodeDrainTickMsg) Target() any { return msg.model }
+
+type nodeDrainDoneMsg struct {
+	model *nodeDrainModel
+	err   error
+}
+
+func (msg nodeDrainDoneMsg) Target() any { return msg.model }
+
+type nodeDrainModel struct {
+	requestScope
+	k8sClient  *k8s.Client
+	node       *k8s.NodeInfo
+	opts       k8s.DrainOptions
+	started    time.Time
+	finishedAt time.Time
+	running    bool
+	err        error
+	done       chan error
+
+	mu        sync.Mutex
+	order     []string
+	evictions map[string]k8s.PodEviction
+}
+
+func NewNodeDrain(k k8s.Client, name string, opts k8s.DrainOptions) *nodeDrainModel {
+	return &nodeDrainModel{
+		k8sClient: &k,
+		node:      k8s.NewNode(name, k),
+		opts:      opts,
+		evictions: make(map[string]k8s.PodEviction),
+	}
+}
+
+func nodeDrainPrompt(k k8s.Client, name string) tea.Msg {
+	appConfig, err := config.LoadAppConfig()
+	if err != nil {
+		appConfig = config.DefaultAppConfig()
+	}
+
+	grace := "default"
+	if appConfig.DrainGracePeriod > 0 {
+		grace = (time.Duration(appConfig.DrainGracePeriod) * time.Second).String()
+	}
+	timeout := time.Duration(appConfig.DrainTimeout) * time.Second
+
+	prompt := components.NewPrompt(
+		"Drain node "+name,
+		"Cordons the node and evicts its pods. DaemonSet and mirror pods are skipped.\ngrace: duration or \"default\" for each pod's own grace period • timeout: how long to wait\nforce: also delete pods without a controller • delete-emptydir-data: also delete pods using emptyDir volumes",
+		fmt.Sprintf("grace=%s timeout=%s force=false delete-emptydir-data=false", grace, timeout),
+		func(value string) tea.Cmd {
+			return func() tea.Msg {
+				opts, err := parseDrainOptions(value)
+				if err != nil {
+					return components.NavigateMsg{Error: err, Cluster: k}
+				}
+				return components.NavigateMsg{
+					NewScreen:  NewNodeDrain(k, name, opts),
+					Breadcrumb: "Drain",
+				}
+			}
+		},
+	)
+	prompt.SetValidator(func(value string) error {
+		_, err := parseDrainOptions(value)
+		return err
+	})
+	return components.NavigateMsg{
+		NewScreen:  prompt,
+		Breadcrumb: "Drain",
+	}
+}
+
+func parseDrainOptions(value string) (k8s.DrainOptions, error) {
+	var opts k8s.DrainOptions
+	for _, field := range strings.Fields(value) {
+		key, raw, ok := strings.Cut(field, "=")
+		if !ok {
+			return opts, fmt.Errorf("expected key=value, got %q", field)
+		}
+		switch key {
+		case "grace":
+			if raw == "default" {
+				opts.GracePeriod = nil
+				continue
+			}
+			grace, err := parseSeconds(raw)
+			if err != nil {
+				return opts, fmt.Errorf("invalid grace period %q", raw)
+			}
+			opts.GracePeriod = &grace
+		case "timeout":
+			timeout, err := parseSeconds(raw)
+			if err != nil || timeout == 0 {
+				return opts, fmt.Errorf("invalid timeout %q", raw)
+			}
+			opts.Timeout = timeout
+		case "force":
+			force, err := strconv.ParseBool(raw)
+			if err != nil {
+				return opts, fmt.Errorf("invalid force %q, expected true or false", raw)
+			}
+			opts.Force = force
+		case "delete-emptydir-data":
+			deleteData, err := strconv.ParseBool(raw)
+			if err != nil {
+				return opts, fmt.Errorf("invalid delete-emptydir-data %q, expected true or false", raw)
+			}
+			opts.DeleteEmptyDirData = deleteData
+		default:
+			return opts, fmt.Errorf("unknown option %q, expected grace, timeout, force or delete-emptydir-data", key)
+		}
+	}
+	return opts, nil
+}
+
+func parseSeconds(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return d, nil
+}
+
+func (d *nodeDrainModel) Init() tea.Cmd {
+	if d.running || !d.started.IsZero() {
+		return nil
+	}
+
+	ctx := d.requestContext()
+	d.done = make(chan error, 1)
+	d.started = time.Now()
+	d.running = true
+	go func() {
+		d.done <- d.node.Drain(ctx, d.opts, d.progress)
+	}()
+	return tea.Batch(d.wait, d.tick())
+}
+
+func (d *nodeDrainModel) progress(eviction k8s.PodEviction) {
+	key := eviction.Namespace + "/" + eviction.Name
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.evictions[key]; !ok {
+		d.order = append(d.order, key)
+	}
+	d.evictions[key] = eviction
+}
+
+func (d *nodeDrainModel) wait() tea.Msg {
+	return nodeDrainDoneMsg{model: d, err: <-d.done}
+}
+
+func (d *nodeDrainModel) tick() tea.Cmd {
+	return tea.Tick(nodeDrainTick, func(time.Time) tea.Msg {
+		return nodeDrainTickMsg{model: d}
+	})
+}
+
+func (d *nodeDrainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case nodeDrainTickMsg:
+		if msg.model == d && d.running {
+			return d, d.tick()
+		}
+	case nodeDrainDoneMsg:
+		if msg.model != d {
+			return d, nil
+		}
+		d.running = false
+		d.finishedAt = time.Now()
+		d.err = msg.err
+	}
+	return d, nil
+}
+
+func (d *nodeDrainModel) snapshot() []k8s.PodEviction {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	evictions := make([]k8s.PodEviction, 0, len(d.order))
+	for _, key := range d.order {
+		evictions = append(evictions, d.evictions[key])
+	}
+	return evictions
+}
+
+func (d *nodeDrainModel) View() string {
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+	stateColors := map[k8s.EvictionState]string{
+		k8s.EvictionBlocked: customstyles.WarningColor,
+		k8s.EvictionFailed:  customstyles.ErrorColor,
+		k8s.EvictionDeleted: customstyles.AccentColor,
+		k8s.EvictionSkipped: customstyles.HelpTextColor,
+	}
+
+	evictions := d.snapshot()
+	counts := map[k8s.EvictionState]int{}
+	for _, eviction := range evictions {
+		counts[eviction.State]++
+	}
+
+	lines := []string{
+		customstyles.TitleStyle().Render("Drain node " + d.node.Name),
+		"",
+		textStyle.Render(fmt.Sprintf("%d pods • %d evicted • %d blocked • %d failed • %d skipped",
+			len(evictions), counts[k8s.EvictionDeleted], counts[k8s.EvictionBlocked], counts[k8s.EvictionFailed], counts[k8s.EvictionSkipped])),
+		"",
+	}
+	if len(evictions) == 0 && d.running {
+		lines = append(lines, textStyle.Render("Cordoning node and listing pods..."))
+	}
+	for _, eviction := range evictions {
+		color, ok := stateColors[eviction.State]
+		if !ok {
+			color = string(customstyles.TextColor)
+		}
+		line := lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Background(background).Render(fmt.Sprintf("%-10s", eviction.State)) +
+			textStyle.Render(" "+eviction.Namespace+"/"+eviction.Name)
+		if eviction.Message != "" {
+			line += helpStyle.Render(" - " + eviction.Message)
+		}
+		lines = append(lines, line)
+	}
+
+	if d.err != nil {
+		lines = append(lines, "", lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.ErrorColor)).
+			Background(background).
+			Render(d.err.Error()))
+	}
+
+	state := "Draining"
+	elapsed := time.Since(d.started)
+	help := "q: Cancel"
+	if !d.running && !d.finishedAt.IsZero() {
+		state = "Drained"
+		if d.err != nil {
+			state = "Drain failed"
+		}
+		elapsed = d.finishedAt.Sub(d.started)
+		help = "q: Back"
+	}
+	lines = append(lines, "", helpStyle.Render(strings.Join([]string{
+		state,
+		elapsed.Round(time.Second).String() + " elapsed",
+		help,
+	}, " • ")))
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseDrainOptions(t *testing.T) {
+	opts, err := parseDrainOptions("grace=30 timeout=2m")
+	if err != nil {
+		t.Fatalf("parseDrainOptions failed: %v", err)
+	}
+	if opts.GracePeriod == nil || *opts.GracePeriod != 30*time.Second || opts.Timeout != 2*time.Minute {
+		t.Errorf("Unexpected options %+v", opts)
+	}
+
+	opts, err = parseDrainOptions("grace=default timeout=5m0s")
+	if err != nil || opts.GracePeriod != nil {
+		t.Errorf("Expected the pod grace period to be kept, got %+v (%v)", opts, err)
+	}
+
+	opts, err = parseDrainOptions("timeout=1m force=true delete-emptydir-data=true")
+	if err != nil || !opts.Force || !opts.DeleteEmptyDirData {
+		t.Errorf("Expected force and delete-emptydir-data to be enabled, got %+v (%v)", opts, err)
+	}
+
+	for _, value := range []string{"grace=soon", "timeout=0", "force=maybe", "delete-emptydir-data=1x", "evict=true", "grace"} {
+		if _, err := parseDrainOptions(value); err == nil {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestNodeDrainModelTracksProgress(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	client := k8s.Client{Clientset: clientset}
+	model := NewNodeDrain(client, "node-1", k8s.DrainOptions{Timeout: time.Second})
+
+	if cmd := model.Init(); cmd == nil {
+		t.Fatal("Expected the drain to start")
+	}
+	if cmd := model.Init(); cmd != nil {
+		t.Error("Expected a single drain")
+	}
+
+	other := NewNodeDrain(client, "node-1", k8s.DrainOptions{Timeout: time.Second})
+	model.Update(nodeDrainDoneMsg{model: other})
+	if _, cmd := model.Update(nodeDrainTickMsg{model: other}); !model.running || cmd != nil {
+		t.Error("Expected messages from another drain screen to be ignored")
+	}
+
+	msg := model.wait()
+	if done, ok := msg.(nodeDrainDoneMsg); !ok || done.Target() != any(model) {
+		t.Fatalf("Expected the result to target its screen, got %#v", msg)
+	}
+	model.Update(msg)
+	if model.running || model.err != nil {
+		t.Errorf("Expected an empty node to drain cleanly, got %v", model.err)
+	}
+	if _, cmd := model.Update(nodeDrainTickMsg{model: model}); cmd != nil {
+		t.Error("Expected ticking to stop once the drain finished")
+	}
+
+	model.progress(k8s.PodEviction{Namespace: "default", Name: "web", State: k8s.EvictionBlocked})
+	model.progress(k8s.PodEviction{Namespace: "default", Name: "web", State: k8s.EvictionDeleted})
+	if evictions := model.snapshot(); len(evictions) != 1 || evictions[0].State != k8s.EvictionDeleted {
+		t.Errorf("Expected the latest state per pod, got %+v", evictions)
+	}
+}
+
+func TestNodeDrainCloseCancelsDrain(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	model := NewNodeDrain(k8s.Client{Clientset: clientset}, "node-1", k8s.DrainOptions{Timeout: time.Second})
+
+	ctx := model.requestContext()
+	model.Close()
+	if ctx.Err() == nil {
+		t.Error("Expected closing the screen to cancel the drain")
+	}
+}
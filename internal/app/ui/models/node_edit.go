@@ -0,0 +1,386 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type nodeEditResultMsg struct {
+	err error
+}
+
+type nodeUpdatedMsg struct {
+	name string
+}
+
+type nodeEditRow struct {
+	taint    bool
+	key      textinput.Model
+	value    textinput.Model
+	effect   int
+	original *corev1.Taint
+}
+
+func (r *nodeEditRow) columns() int {
+	if r.taint {
+		return 3
+	}
+	return 2
+}
+
+func (r *nodeEditRow) blank() bool {
+	return strings.TrimSpace(r.key.Value()) == "" && strings.TrimSpace(r.value.Value()) == ""
+}
+
+type nodeEditModel struct {
+	screenLoader
+	k8sClient *k8s.Client
+	node      *k8s.NodeInfo
+	rows      []*nodeEditRow
+	row       int
+	col       int
+	applying  bool
+	err       error
+}
+
+func NewNodeEdit(k k8s.Client, name string) *nodeEditModel {
+	return &nodeEditModel{
+		k8sClient: &k,
+		node:      k8s.NewNode(name, k),
+	}
+}
+
+func (e *nodeEditModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	e.k8sClient = k
+	e.node.Client = *k
+	return e, nil
+}
+
+func (e *nodeEditModel) fetch() tea.Cmd {
+	name, client := e.node.Name, *e.k8sClient
+	return load(&e.screenLoader, func(ctx context.Context) (*k8s.NodeInfo, error) {
+		node := k8s.NewNode(name, client)
+		return node, node.Fetch(ctx)
+	})
+}
+
+func (e *nodeEditModel) reset() {
+	e.rows = nil
+	for i := range e.node.Raw.Spec.Taints {
+		taint := e.node.Raw.Spec.Taints[i]
+		row := e.newRow(true, taint.Key, taint.Value)
+		row.effect = effectIndex(taint.Effect)
+		row.original = &taint
+		e.rows = append(e.rows, row)
+	}
+	for _, key := range sortedKeys(e.node.Raw.Labels) {
+		e.rows = append(e.rows, e.newRow(false, key, e.node.Raw.Labels[key]))
+	}
+	e.row, e.col = 0, 0
+}
+
+func (e *nodeEditModel) newRow(taint bool, key, value string) *nodeEditRow {
+	newInput := func(v string) textinput.Model {
+		input := textinput.New()
+		input.Prompt = ""
+		input.SetValue(v)
+		input.CursorEnd()
+		return input
+	}
+	return &nodeEditRow{taint: taint, key: newInput(key), value: newInput(value)}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func effectIndex(effect corev1.TaintEffect) int {
+	for i, candidate := range k8s.TaintEffects {
+		if candidate == effect {
+			return i
+		}
+	}
+	return 0
+}
+
+func (e *nodeEditModel) CapturingInput() bool {
+	return true
+}
+
+func (e *nodeEditModel) Init() tea.Cmd {
+	if e.node.Raw == nil {
+		return e.fetch()
+	}
+	return e.setFocus(e.row, e.col)
+}
+
+func (e *nodeEditModel) setFocus(row, col int) tea.Cmd {
+	for _, r := range e.rows {
+		r.key.Blur()
+		r.value.Blur()
+	}
+	if len(e.rows) == 0 {
+		e.row, e.col = 0, 0
+		return nil
+	}
+	e.row = (row + len(e.rows)) % len(e.rows)
+	e.col = min(max(col, 0), e.rows[e.row].columns()-1)
+	switch e.col {
+	case 0:
+		return e.rows[e.row].key.Focus()
+	case 1:
+		return e.rows[e.row].value.Focus()
+	}
+	return nil
+}
+
+func (e *nodeEditModel) moveField(delta int) tea.Cmd {
+	if len(e.rows) == 0 {
+		return nil
+	}
+	row, col := e.row, e.col+delta
+	if col >= e.rows[row].columns() {
+		row, col = row+1, 0
+	} else if col < 0 {
+		row = (row - 1 + len(e.rows)) % len(e.rows)
+		col = e.rows[row].columns() - 1
+	}
+	return e.setFocus(row, col)
+}
+
+func (e *nodeEditModel) addRow(taint bool) tea.Cmd {
+	row := e.newRow(taint, "", "")
+	idx := len(e.rows)
+	if taint {
+		idx = 0
+		for idx < len(e.rows) && e.rows[idx].taint {
+			idx++
+		}
+	}
+	e.rows = append(e.rows[:idx], append([]*nodeEditRow{row}, e.rows[idx:]...)...)
+	return e.setFocus(idx, 0)
+}
+
+func (e *nodeEditModel) deleteRow() tea.Cmd {
+	if len(e.rows) == 0 {
+		return nil
+	}
+	e.rows = append(e.rows[:e.row], e.rows[e.row+1:]...)
+	return e.setFocus(min(e.row, len(e.rows)-1), e.col)
+}
+
+func (e *nodeEditModel) values() ([]corev1.Taint, map[string]string, error) {
+	var taints []corev1.Taint
+	labels := map[string]string{}
+	for _, row := range e.rows {
+		if row.blank() {
+			continue
+		}
+		key := strings.TrimSpace(row.key.Value())
+		value := strings.TrimSpace(row.value.Value())
+		if key == "" {
+			return nil, nil, fmt.Errorf("key must not be empty")
+		}
+		if !row.taint {
+			if _, ok := labels[key]; ok {
+				return nil, nil, fmt.Errorf("duplicate label %s", key)
+			}
+			labels[key] = value
+			continue
+		}
+		taint := corev1.Taint{Key: key, Value: value, Effect: k8s.TaintEffects[row.effect]}
+		if row.original != nil && row.original.MatchTaint(&taint) && row.original.Value == value {
+			taint = *row.original
+		}
+		taints = append(taints, taint)
+	}
+	if err := k8s.ValidateTaints(taints); err != nil {
+		return nil, nil, err
+	}
+	if err := k8s.ValidateLabels(labels); err != nil {
+		return nil, nil, err
+	}
+	return taints, labels, nil
+}
+
+func (e *nodeEditModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(loadedMsg[*k8s.NodeInfo]); ok {
+		if !loaded(&e.screenLoader, msg) || msg.err != nil {
+			return e, nil
+		}
+		e.node = msg.value
+		e.reset()
+		return e, e.setFocus(0, 0)
+	}
+	if e.node.Raw == nil {
+		return e, e.updatePending(msg, e.fetch)
+	}
+
+	switch msg := msg.(type) {
+	case nodeEditResultMsg:
+		e.applying = false
+		if msg.err != nil {
+			e.err = msg.err
+			return e, nil
+		}
+		name := e.node.Name
+		return e, tea.Sequence(
+			func() tea.Msg { return components.BackMsg{} },
+			func() tea.Msg { return nodeUpdatedMsg{name: name} },
+		)
+
+	case tea.KeyMsg:
+		if e.applying {
+			return e, nil
+		}
+		switch msg.String() {
+		case "esc", "ctrl+c":
+			return e, func() tea.Msg { return components.BackMsg{} }
+		case "up":
+			return e, e.setFocus(e.row-1, e.col)
+		case "down":
+			return e, e.setFocus(e.row+1, e.col)
+		case "tab":
+			return e, e.moveField(1)
+		case "shift+tab":
+			return e, e.moveField(-1)
+		case "ctrl+t":
+			return e, e.addRow(true)
+		case "ctrl+l":
+			return e, e.addRow(false)
+		case "ctrl+d":
+			return e, e.deleteRow()
+		case "ctrl+r":
+			e.reset()
+			e.err = nil
+			return e, e.setFocus(0, 0)
+		case "enter":
+			return e, e.apply()
+		}
+		if len(e.rows) > 0 && e.col == 2 {
+			row := e.rows[e.row]
+			switch msg.String() {
+			case "left":
+				row.effect = (row.effect - 1 + len(k8s.TaintEffects)) % len(k8s.TaintEffects)
+			case "right", " ":
+				row.effect = (row.effect + 1) % len(k8s.TaintEffects)
+			}
+			return e, nil
+		}
+	}
+
+	if len(e.rows) == 0 {
+		return e, nil
+	}
+	var cmd tea.Cmd
+	row := e.rows[e.row]
+	if e.col == 0 {
+		row.key, cmd = row.key.Update(msg)
+	} else {
+		row.value, cmd = row.value.Update(msg)
+	}
+	return e, cmd
+}
+
+func (e *nodeEditModel) apply() tea.Cmd {
+	taints, labels, err := e.values()
+	if err != nil {
+		e.err = err
+		return nil
+	}
+
+	e.applying = true
+	e.err = nil
+	ctx := e.requestContext()
+	node := e.node
+	return func() tea.Msg {
+		return nodeEditResultMsg{err: node.UpdateTaintsAndLabels(ctx, taints, labels)}
+	}
+}
+
+func (e *nodeEditModel) View() string {
+	if e.node.Raw == nil {
+		return e.pendingView("node " + e.node.Name)
+	}
+
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.YAMLKeyColor)).
+		Background(background)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.SelectionForeground)).
+		Background(lipgloss.Color(customstyles.SelectionBackground))
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+
+	lines := []string{customstyles.TitleStyle().Render("Edit node " + e.node.Name)}
+	section := func(title string, taint bool) {
+		lines = append(lines, "", keyStyle.Render(title))
+		empty := true
+		for i, row := range e.rows {
+			if row.taint != taint {
+				continue
+			}
+			empty = false
+			marker := "  "
+			if i == e.row {
+				marker = "> "
+			}
+			line := textStyle.Render(marker) + textStyle.Render(row.key.View()) + textStyle.Render(" = ") + textStyle.Render(row.value.View())
+			if row.taint {
+				effect := "[" + string(k8s.TaintEffects[row.effect]) + "]"
+				if i == e.row && e.col == 2 {
+					effect = selectedStyle.Render(effect)
+				} else {
+					effect = textStyle.Render(effect)
+				}
+				line += textStyle.Render(" : ") + effect
+			}
+			lines = append(lines, line)
+		}
+		if empty {
+			lines = append(lines, helpStyle.Render("  <none>"))
+		}
+	}
+	section("Taints (key = value : effect)", true)
+	section("Labels (key = value)", false)
+
+	if e.applying {
+		lines = append(lines, "", textStyle.Render("Applying..."))
+	}
+	if e.err != nil {
+		lines = append(lines, "", lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.ErrorColor)).
+			Background(background).
+			Render(e.err.Error()))
+	}
+	lines = append(lines, "", helpStyle.Render("↑/↓: Row • tab: Field • ←/→: Effect • ctrl+t: Add taint • ctrl+l: Add label • ctrl+d: Delete • ctrl+r: Reset • enter: Apply • esc: Cancel"))
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
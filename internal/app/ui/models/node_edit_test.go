@@ -0,0 +1,61 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodeEditAppliesTaintsAndLabels(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"tier": "web"}},
+	})
+	client := k8s.Client{Clientset: clientset}
+
+	model := NewNodeEdit(client, "node-1")
+	if _, err := model.InitComponent(&client); err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	runLoad(model, model.Init())
+
+	typeText := func(text string) {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	typeText("dedicated")
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText("gpu")
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	typeText("bad key")
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.err == nil {
+		t.Fatal("Expected an invalid label key to be rejected")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatalf("Expected an apply command, got error %v", model.err)
+	}
+	if _, cmd = model.Update(cmd()); cmd == nil || model.err != nil {
+		t.Fatalf("Expected navigation after applying, got error %v", model.err)
+	}
+
+	node, _ := clientset.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+	if len(node.Spec.Taints) != 1 || node.Spec.Taints[0].ToString() != "dedicated=gpu:NoExecute" {
+		t.Errorf("Expected the new taint, got %+v", node.Spec.Taints)
+	}
+	if len(node.Labels) != 1 || node.Labels["tier"] != "web" {
+		t.Errorf("Expected labels to be unchanged, got %v", node.Labels)
+	}
+}
@@ -1,12 +1,14 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
 	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/table"
@@ -48,10 +50,6 @@ func NewNodes(k k8s.Client, namespace string) (*nodesModel, error) {
 func (n *nodesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	n.k8sClient = k
 
-	if err := n.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		nodeDetails, err := NewNodeDetails(*k, selected).InitComponent(k)
 		if err != nil {
@@ -65,38 +63,83 @@ func (n *nodesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := n.fetchData(); err != nil {
-			return nil, err
-		}
-		return n.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(n.config.Columns, n.config.ColumnWidths, n.dataToRows(), n.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := n.newTable(onSelect, n.fetchData, n.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": n.createDeleteAction(tableModel),
+		"e": n.createEditAction(tableModel),
+		"L": n.createEditMetadataAction(tableModel),
+		"c": n.createCordonAction(tableModel, true),
+		"u": n.createCordonAction(tableModel, false),
+		"D": n.createDrainAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, n.refreshInterval, n.k8sClient, "Nodes"), nil
+	return NewAutoRefreshModel(tableModel, n.refreshInterval, n.k8sClient, "Nodes").Watch(k8s.ResourceTypeNode, n.namespace).CloseWith(n), nil
 }
 
-func (n *nodesModel) fetchData() error {
+func (n *nodesModel) createCordonAction(tableModel *ui.TableModel, unschedulable bool) func() tea.Cmd {
+	return func() tea.Cmd {
+		checked := tableModel.GetCheckedItems()
+		if len(checked) == 0 {
+			return nil
+		}
+
+		var failures []string
+		for _, idx := range checked {
+			if idx >= 0 && idx < len(n.resourceData) {
+				if err := k8s.SetNodeUnschedulable(n.requestContext(), *n.k8sClient, n.resourceData[idx].GetName(), unschedulable); err != nil {
+					failures = append(failures, err.Error())
+				}
+			}
+		}
+		tableModel.ClearCheckedItems()
+		_, refresh := tableModel.Refresh()
+
+		if len(failures) > 0 {
+			client := *n.k8sClient
+			return tea.Batch(refresh, func() tea.Msg {
+				return components.NavigateMsg{
+					Error:   fmt.Errorf("%s", strings.Join(failures, "\n")),
+					Cluster: client,
+				}
+			})
+		}
+		return refresh
+	}
+}
+
+func (n *nodesModel) createDrainAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(n.resourceData) {
+			return nil
+		}
+
+		client := *n.k8sClient
+		name := n.resourceData[selected].GetName()
+		return func() tea.Msg {
+			return nodeDrainPrompt(client, name)
+		}
+	}
+}
+
+func (n *nodesModel) fetchData(ctx context.Context) (func(), error) {
 	var nodeInfo []k8s.NodeInfo
 	var err error
 
-	nodeInfo, err = n.pluginAPI.GetNodes()
+	nodeInfo, err = n.pluginAPI.GetNodes(ctx)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch nodes: %v", err)
+		return nil, fmt.Errorf("failed to fetch nodes: %v", err)
 	}
-	n.nodesInfo = nodeInfo
 
-	n.resourceData = make([]types.ResourceData, len(nodeInfo))
-	for idx, node := range nodeInfo {
-		n.resourceData[idx] = NodeData{&node}
-	}
+	return func() {
+		n.nodesInfo = nodeInfo
 
-	return nil
+		n.resourceData = make([]types.ResourceData, len(nodeInfo))
+		for idx, node := range nodeInfo {
+			n.resourceData[idx] = NodeData{&node}
+		}
+	}, nil
 }
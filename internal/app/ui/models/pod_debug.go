@@ -0,0 +1,153 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/config"
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+type podDebugImageMsg struct {
+	image string
+}
+
+type podDebugTargetMsg struct {
+	container string
+}
+
+type podDebugReadyMsg struct {
+	container string
+	err       error
+}
+
+type podDebugModel struct {
+	requestScope
+	pod        *k8s.Pod
+	k8sClient  *k8s.Client
+	containers []string
+	image      string
+	target     string
+	status     string
+	picker     *components.ListModel
+}
+
+func NewPodDebug(k k8s.Client, namespace, podName string, containers []string) *podDebugModel {
+	appConfig, _ := config.LoadAppConfig()
+	images := appConfig.DebugImageChoices()
+	if len(images) == 0 {
+		images = config.DefaultAppConfig().DebugImageChoices()
+	}
+
+	model := &podDebugModel{
+		pod:        k8s.NewPod(podName, namespace, k),
+		k8sClient:  &k,
+		containers: containers,
+	}
+	model.picker = components.NewList(images, "Debug image: "+podName, func(selected string) tea.Msg {
+		return podDebugImageMsg{image: selected}
+	})
+	model.picker.SetFooterText("enter: Select image • q: Back")
+	return model
+}
+
+func (d *podDebugModel) Init() tea.Cmd {
+	if d.picker != nil {
+		return d.picker.Init()
+	}
+	return nil
+}
+
+func (d *podDebugModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case podDebugImageMsg:
+		d.image = msg.image
+		if len(d.containers) == 1 {
+			return d, d.start(d.containers[0])
+		}
+		d.picker = components.NewList(d.containers, "Target container: "+d.pod.Name, func(selected string) tea.Msg {
+			return podDebugTargetMsg{container: selected}
+		})
+		d.picker.SetFooterText("enter: Select target container • q: Back")
+		return d, d.picker.Init()
+
+	case podDebugTargetMsg:
+		return d, d.start(msg.container)
+
+	case podDebugReadyMsg:
+		if msg.err != nil {
+			return d, d.exit(msg.err)
+		}
+		return d, podDebugAttachCmd(d.pod, msg.container, d.target, func(err error) tea.Msg {
+			return podShellExitMsg{err: err}
+		})
+
+	case podShellExitMsg:
+		return d, d.exit(msg.err)
+	}
+
+	if d.picker != nil {
+		_, cmd := d.picker.Update(msg)
+		return d, cmd
+	}
+	return d, nil
+}
+
+func (d *podDebugModel) start(target string) tea.Cmd {
+	d.picker = nil
+	d.target = target
+	d.status = fmt.Sprintf("Starting debug container with image %s targeting %s...", d.image, target)
+
+	pod := d.pod
+	image := d.image
+	ctx := d.requestContext()
+	return func() tea.Msg {
+		name, err := pod.AddDebugContainer(ctx, image, target)
+		if err == nil {
+			err = pod.WaitForContainerRunning(ctx, name)
+		}
+		return podDebugReadyMsg{container: name, err: err}
+	}
+}
+
+func (d *podDebugModel) exit(err error) tea.Cmd {
+	back := func() tea.Msg { return components.BackMsg{} }
+	if err == nil {
+		return back
+	}
+	client := *d.k8sClient
+	return tea.Sequence(back, func() tea.Msg {
+		return components.NavigateMsg{Error: err, Cluster: client}
+	})
+}
+
+func (d *podDebugModel) View() string {
+	if d.picker != nil {
+		return d.picker.View()
+	}
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(lipgloss.Color(customstyles.BackgroundColor)).
+		Render(d.status)
+}
+
+func podDebugAttachCmd(pod *k8s.Pod, container, target string, done func(err error) tea.Msg) tea.Cmd {
+	return tea.Exec(&terminalCommand{
+		banner: fmt.Sprintf("Attached to %s/%s (debug container %s, target %s).\r\nIf you don't see a command prompt, try pressing enter.\r\n", pod.Namespace, pod.Name, container, target),
+		run: func(ctx context.Context, stdin io.Reader, stdout io.Writer, sizes remotecommand.TerminalSizeQueue) error {
+			return pod.AttachWithTTY(ctx, container, stdin, stdout, sizes)
+		},
+	}, done)
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPodDebugPicksTargetContainer(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	client := k8s.Client{Clientset: fake.NewSimpleClientset(), Namespace: "default"}
+	model := NewPodDebug(client, "default", "web", []string{"app", "sidecar"})
+
+	if item := model.picker.List.Items()[0].(components.ListItem); item.Title() != "busybox:latest" {
+		t.Errorf("Expected the configured default image first, got %s", item.Title())
+	}
+
+	model.Update(podDebugImageMsg{image: "busybox:latest"})
+	if model.image != "busybox:latest" || model.picker == nil {
+		t.Fatal("Expected a target container picker after choosing an image")
+	}
+	if count := len(model.picker.List.Items()); count != 2 {
+		t.Errorf("Expected 2 target containers, got %d", count)
+	}
+
+	_, cmd := model.Update(podDebugReadyMsg{err: errors.New("ephemeral containers are disabled")})
+	if cmd == nil {
+		t.Error("Expected a command reporting the debug error")
+	}
+}
+
+func TestPodDebugCloseStopsWaitingForContainer(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+	}
+	client := k8s.Client{Clientset: fake.NewSimpleClientset(pod), Namespace: "default"}
+	model := NewPodDebug(client, "default", "web", []string{"app"})
+
+	_, cmd := model.Update(podDebugImageMsg{image: "busybox:latest"})
+	result := make(chan tea.Msg, 1)
+	go func() { result <- cmd() }()
+	time.Sleep(50 * time.Millisecond)
+	model.Close()
+
+	select {
+	case msg := <-result:
+		if ready, ok := msg.(podDebugReadyMsg); !ok || ready.err == nil {
+			t.Errorf("Expected the debug container wait to fail once closed, got %#v", msg)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected closing the screen to stop waiting for the debug container")
+	}
+}
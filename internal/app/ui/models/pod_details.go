@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
@@ -9,35 +10,64 @@ import (
 )
 
 type podDetailsModel struct {
+	describeView
 	pod       *k8s.Pod
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
 }
 
 func NewPodDetails(k k8s.Client, namespace, podName string) *podDetailsModel {
 	return &podDetailsModel{
 		pod:       k8s.NewPod(podName, namespace, k),
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
 	}
 }
 
 func (p *podDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	p.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribePod(p.pod.Namespace, p.pod.Name)
 
-	if err != nil {
-		return nil, err
+	p.setup("pod", func(ctx context.Context) (string, error) {
+		return api.DescribePod(ctx, p.pod.Namespace, p.pod.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewerWithHelp(
+			"Pod: "+p.pod.Name,
+			desc,
+			"↑/↓: Scroll • /: Search • c: Copy files • e: Edit • ctrl+s: Export • q: Quit",
+		)
+		viewer.SetExportName(k.ContextName(), p.pod.Namespace, "pod", p.pod.Name)
+		viewer.SetEditTarget("pod", p.pod.Namespace, p.pod.Name)
+		return viewer
+	})
+	return p, nil
+}
+
+func (p *podDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := p.update(msg); ok {
+		return p, cmd
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok && !p.CapturingInput() {
+		switch msg.String() {
+		case "c":
+			return p, p.openFiles
+		}
 	}
 
-	return components.NewYAMLViewer("Pod: "+p.pod.Name, desc), nil
+	return p, p.forward(msg)
+}
+
+func (p *podDetailsModel) openFiles() tea.Msg {
+	files, err := NewPodFiles(*p.k8sClient, p.pod.Namespace, p.pod.Name).InitComponent(p.k8sClient)
+	if err != nil {
+		return components.NavigateMsg{
+			Error:   err,
+			Cluster: *p.k8sClient,
+		}
+	}
+	return components.NavigateMsg{
+		NewScreen:  files,
+		Breadcrumb: "Files",
+	}
 }
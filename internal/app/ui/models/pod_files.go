@@ -0,0 +1,474 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"github.com/otavioCosta2110/k8s-tui/pkg/format"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const fileTransferTick = 200 * time.Millisecond
+
+type podFilesLoadedMsg struct {
+	session int
+	dir     string
+	entries []k8s.FileEntry
+	err     error
+}
+
+type podFilesContainerMsg struct {
+	container string
+}
+
+type podFileTransferRequestMsg struct {
+	download bool
+	source   string
+	dest     string
+}
+
+type podFileTransferTickMsg struct {
+	model    *podFilesModel
+	transfer *fileTransfer
+}
+
+func (msg podFileTransferTickMsg) Target() any {
+	return msg.model
+}
+
+type podFileTransferDoneMsg struct {
+	model    *podFilesModel
+	transfer *fileTransfer
+	result   string
+	err      error
+}
+
+func (msg podFileTransferDoneMsg) Target() any {
+	return msg.model
+}
+
+type fileTransfer struct {
+	label string
+	total int64
+	bytes atomic.Int64
+	files atomic.Int64
+}
+
+func (t *fileTransfer) progress(bytes int64, files int) {
+	t.bytes.Store(bytes)
+	t.files.Store(int64(files))
+}
+
+func (t *fileTransfer) String() string {
+	done := formatFileSize(t.bytes.Load())
+	if t.total > 0 {
+		done += " / " + formatFileSize(t.total)
+	}
+	return fmt.Sprintf("%s: %s (%d files)", t.label, done, t.files.Load())
+}
+
+type podFilesModel struct {
+	requestScope
+	pod        *k8s.Pod
+	k8sClient  *k8s.Client
+	containers []string
+	container  string
+	dir        string
+	entries    []k8s.FileEntry
+	cursor     int
+	offset     int
+	loading    bool
+	session    int
+	err        error
+	notice     string
+	transfer   *fileTransfer
+	picker     *components.ListModel
+}
+
+func NewPodFiles(k k8s.Client, namespace, podName string) *podFilesModel {
+	return &podFilesModel{
+		pod:       k8s.NewPod(podName, namespace, k),
+		k8sClient: &k,
+		dir:       "/",
+	}
+}
+
+func (p *podFilesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	p.k8sClient = k
+
+	return p, nil
+}
+
+func (p *podFilesModel) Init() tea.Cmd {
+	if p.entries != nil || p.loading {
+		return nil
+	}
+	if p.containers == nil {
+		p.loading = true
+		p.session++
+		return loadPodContainers(p.requestContext(), p.pod, p)
+	}
+	return p.load(p.dir)
+}
+
+func (p *podFilesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case podContainersMsg:
+		if msg.owner != p || !p.loading || p.containers != nil {
+			return p, nil
+		}
+		if msg.err != nil {
+			p.loading = false
+			p.err = msg.err
+			return p, nil
+		}
+		p.containers = msg.containers
+		p.container = msg.containers[0]
+		return p, p.load(p.dir)
+
+	case podFilesLoadedMsg:
+		if msg.session != p.session {
+			return p, nil
+		}
+		p.loading = false
+		if msg.err != nil {
+			p.err = msg.err
+			return p, nil
+		}
+		p.err = nil
+		p.dir = msg.dir
+		p.entries = msg.entries
+		p.cursor = 0
+		p.offset = 0
+		return p, nil
+
+	case podFilesContainerMsg:
+		p.picker = nil
+		if msg.container == p.container {
+			return p, nil
+		}
+		p.container = msg.container
+		return p, p.load(p.dir)
+
+	case podFileTransferRequestMsg:
+		return p, p.startTransfer(msg)
+
+	case podFileTransferTickMsg:
+		if msg.model != p || msg.transfer != p.transfer || p.transfer == nil {
+			return p, nil
+		}
+		return p, p.transferTick()
+
+	case podFileTransferDoneMsg:
+		if msg.model != p || msg.transfer != p.transfer || p.transfer == nil {
+			return p, nil
+		}
+		label := p.transfer.label
+		p.transfer = nil
+		if msg.err != nil {
+			p.err = msg.err
+			p.notice = ""
+			return p, nil
+		}
+		p.err = nil
+		p.notice = label + " complete: " + msg.result
+		if strings.HasPrefix(label, "Uploading") {
+			return p, p.load(p.dir)
+		}
+		return p, nil
+
+	case tea.WindowSizeMsg:
+		if p.picker != nil {
+			p.picker.Update(msg)
+		}
+
+	case tea.KeyMsg:
+		if p.picker != nil {
+			if msg.String() == "c" {
+				p.picker = nil
+				return p, nil
+			}
+			_, cmd := p.picker.Update(msg)
+			return p, cmd
+		}
+		return p, p.handleKey(msg)
+	}
+
+	return p, nil
+}
+
+func (p *podFilesModel) handleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "up", "k":
+		p.moveCursor(-1)
+	case "down", "j":
+		p.moveCursor(1)
+	case "pgup":
+		p.moveCursor(-p.listHeight())
+	case "pgdown":
+		p.moveCursor(p.listHeight())
+	case "home":
+		p.moveCursor(-len(p.entries))
+	case "end":
+		p.moveCursor(len(p.entries))
+	case "enter":
+		if entry, ok := p.selected(); ok && (entry.Dir || entry.Link != "") {
+			return p.load(path.Join(p.dir, entry.Name))
+		}
+	case "backspace":
+		if p.dir != "/" {
+			return p.load(path.Dir(p.dir))
+		}
+	case "r":
+		if p.containers == nil {
+			return p.Init()
+		}
+		return p.load(p.dir)
+	case "c":
+		if len(p.containers) > 1 {
+			p.picker = components.NewList(p.containers, "Containers", func(selected string) tea.Msg {
+				return podFilesContainerMsg{container: selected}
+			})
+			p.picker.SetFooterText("enter: Select container • c: Cancel")
+			return p.picker.Init()
+		}
+	case "d":
+		entry, ok := p.selected()
+		if !ok || p.transfer != nil {
+			return nil
+		}
+		source := path.Join(p.dir, entry.Name)
+		return p.transferPrompt("Download "+source, "Local directory to save into.", components.ExportDir(), func(value string) tea.Msg {
+			return podFileTransferRequestMsg{download: true, source: source, dest: value}
+		})
+	case "u":
+		if p.transfer != nil {
+			return nil
+		}
+		cwd, _ := os.Getwd()
+		dir := p.dir
+		return p.transferPrompt("Upload to "+p.container+":"+dir, "Local file or directory to upload.", cwd, func(value string) tea.Msg {
+			return podFileTransferRequestMsg{source: value, dest: dir}
+		})
+	}
+	return nil
+}
+
+func (p *podFilesModel) transferPrompt(title, description, value string, request func(value string) tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		prompt := components.NewPrompt(title, description, value, func(value string) tea.Cmd {
+			return func() tea.Msg { return request(value) }
+		})
+		prompt.SetValidator(func(value string) error {
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("a path is required")
+			}
+			return nil
+		})
+		return components.NavigateMsg{
+			NewScreen:  prompt,
+			Breadcrumb: "Copy",
+		}
+	}
+}
+
+func (p *podFilesModel) startTransfer(req podFileTransferRequestMsg) tea.Cmd {
+	if p.transfer != nil {
+		return nil
+	}
+
+	pod := p.pod
+	container := p.container
+	ctx := p.requestContext()
+	transfer := &fileTransfer{}
+	var run func() (string, error)
+	if req.download {
+		transfer.label = "Downloading " + req.source
+		for _, entry := range p.entries {
+			if !entry.Dir && path.Join(p.dir, entry.Name) == req.source {
+				transfer.total = entry.Size
+			}
+		}
+		dest := filepath.Clean(expandPath(req.dest))
+		run = func() (string, error) {
+			return pod.CopyFrom(ctx, container, req.source, dest, transfer.progress)
+		}
+	} else {
+		source := filepath.Clean(expandPath(req.source))
+		transfer.label = "Uploading " + filepath.Base(source)
+		transfer.total = localSize(source)
+		run = func() (string, error) {
+			return pod.CopyTo(ctx, container, source, req.dest, transfer.progress)
+		}
+	}
+
+	p.transfer = transfer
+	p.err = nil
+	p.notice = ""
+	return tea.Batch(
+		func() tea.Msg {
+			result, err := run()
+			return podFileTransferDoneMsg{model: p, transfer: transfer, result: result, err: err}
+		},
+		p.transferTick(),
+	)
+}
+
+func (p *podFilesModel) transferTick() tea.Cmd {
+	transfer := p.transfer
+	return tea.Tick(fileTransferTick, func(time.Time) tea.Msg {
+		return podFileTransferTickMsg{model: p, transfer: transfer}
+	})
+}
+
+func (p *podFilesModel) load(dir string) tea.Cmd {
+	p.session++
+	p.loading = true
+	session := p.session
+	pod := p.pod
+	container := p.container
+	ctx := p.requestContext()
+	return func() tea.Msg {
+		entries, err := pod.ListFiles(ctx, container, dir)
+		if entries == nil {
+			entries = []k8s.FileEntry{}
+		}
+		return podFilesLoadedMsg{session: session, dir: dir, entries: entries, err: err}
+	}
+}
+
+func (p *podFilesModel) selected() (k8s.FileEntry, bool) {
+	if p.cursor < 0 || p.cursor >= len(p.entries) {
+		return k8s.FileEntry{}, false
+	}
+	return p.entries[p.cursor], true
+}
+
+func (p *podFilesModel) moveCursor(delta int) {
+	p.cursor = max(0, min(p.cursor+delta, len(p.entries)-1))
+	height := p.listHeight()
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	} else if p.cursor >= p.offset+height {
+		p.offset = p.cursor - height + 1
+	}
+}
+
+func (p *podFilesModel) listHeight() int {
+	return max(styles.ScreenHeight-3, 1)
+}
+
+func (p *podFilesModel) View() string {
+	if p.picker != nil {
+		return p.picker.View()
+	}
+
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	lineStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	dirStyle := lineStyle.Bold(true).Foreground(lipgloss.Color(customstyles.AccentColor))
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.SelectionForeground)).
+		Background(lipgloss.Color(customstyles.SelectionBackground))
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+	place := func(s string) string {
+		return lipgloss.PlaceHorizontal(styles.ScreenWidth, lipgloss.Left, s, lipgloss.WithWhitespaceBackground(background))
+	}
+
+	title := customstyles.TitleStyle().Render(fmt.Sprintf("Files: %s/%s:%s", p.pod.Name, p.container, p.dir))
+	lines := []string{place(title)}
+
+	height := p.listHeight()
+	switch {
+	case p.loading && p.entries == nil:
+		lines = append(lines, place(helpStyle.Render("Loading...")))
+	case len(p.entries) == 0:
+		lines = append(lines, place(helpStyle.Render("(empty)")))
+	}
+	for i := p.offset; i < len(p.entries) && i < p.offset+height; i++ {
+		entry := p.entries[i]
+		text := fileEntryLine(entry, styles.ScreenWidth)
+		switch {
+		case i == p.cursor:
+			lines = append(lines, selectedStyle.Width(styles.ScreenWidth).Render(text))
+		case entry.Dir:
+			lines = append(lines, place(dirStyle.Render(text)))
+		default:
+			lines = append(lines, place(lineStyle.Render(text)))
+		}
+	}
+	for len(lines) < height+1 {
+		lines = append(lines, place(""))
+	}
+
+	status := ""
+	switch {
+	case p.transfer != nil:
+		status = lipgloss.NewStyle().Foreground(lipgloss.Color(customstyles.AccentColor)).Background(background).Render(p.transfer.String())
+	case p.err != nil:
+		status = lipgloss.NewStyle().Foreground(lipgloss.Color(customstyles.ErrorColor)).Background(background).Render(p.err.Error())
+	case p.notice != "":
+		status = helpStyle.Render(p.notice)
+	}
+	lines = append(lines, place(status))
+	lines = append(lines, place(helpStyle.Render("↑/↓: Move • enter: Open • backspace: Up • d: Download • u: Upload • c: Container • r: Reload • q: Back")))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func fileEntryLine(entry k8s.FileEntry, width int) string {
+	name := entry.Name
+	if entry.Dir {
+		name += "/"
+	}
+	if entry.Link != "" {
+		name += " -> " + entry.Link
+	}
+	size := ""
+	if !entry.Dir {
+		size = formatFileSize(entry.Size)
+	}
+	meta := fmt.Sprintf("%-11s %10s  %s", entry.Mode, size, entry.Modified)
+	padding := max(width-lipgloss.Width(meta)-lipgloss.Width(name)-2, 1)
+	return name + strings.Repeat(" ", padding) + meta
+}
+
+func formatFileSize(size int64) string {
+	return format.FormatBytesBinary(strconv.FormatInt(size, 10))
+}
+
+func expandPath(p string) string {
+	p = strings.TrimSpace(p)
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(p, "~"))
+		}
+	}
+	return p
+}
+
+func localSize(root string) int64 {
+	var total int64
+	filepath.Walk(root, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			total += info.Size()
+		}
+		return nil
+	})
+	return total
+}
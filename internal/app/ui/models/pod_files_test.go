@@ -0,0 +1,80 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestPodFiles() *podFilesModel {
+	client := k8s.Client{Clientset: fake.NewSimpleClientset(), Namespace: "default"}
+	files := NewPodFiles(client, "default", "web")
+	files.containers = []string{"app"}
+	files.container = "app"
+	return files
+}
+
+func TestPodFilesNavigation(t *testing.T) {
+	files := newTestPodFiles()
+	files.load("/")
+	files.Update(podFilesLoadedMsg{session: files.session, dir: "/", entries: []k8s.FileEntry{
+		{Name: "etc", Dir: true},
+		{Name: "lib", Link: "usr/lib"},
+		{Name: "README", Size: 10},
+	}})
+
+	if cmd := files.handleKey(tea.KeyMsg{Type: tea.KeyBackspace}); cmd != nil {
+		t.Error("Expected no parent navigation from the root")
+	}
+
+	files.Update(tea.KeyMsg{Type: tea.KeyDown})
+	files.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if files.session != 2 || !files.loading {
+		t.Fatal("Expected enter on a symlink to load its directory")
+	}
+
+	files.Update(podFilesLoadedMsg{session: 1, dir: "/stale", err: errors.New("stale")})
+	if files.err != nil || files.dir != "/" {
+		t.Error("Expected stale listings to be ignored")
+	}
+
+	files.Update(podFilesLoadedMsg{session: files.session, dir: "/lib", entries: []k8s.FileEntry{}})
+	if files.dir != "/lib" || files.loading {
+		t.Errorf("Expected to be in /lib, got %s", files.dir)
+	}
+	files.handleKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	files.Update(podFilesLoadedMsg{session: files.session, dir: "/", entries: []k8s.FileEntry{}})
+	if files.dir != "/" {
+		t.Errorf("Expected backspace to return to /, got %s", files.dir)
+	}
+}
+
+func TestPodFilesTransferDone(t *testing.T) {
+	files := newTestPodFiles()
+	files.transfer = &fileTransfer{label: "Downloading /etc/hosts", total: 2048}
+	files.transfer.progress(1024, 0)
+	if got := files.transfer.String(); got != "Downloading /etc/hosts: 1.0KiB / 2.0KiB (0 files)" {
+		t.Errorf("Unexpected progress text %q", got)
+	}
+
+	transfer := files.transfer
+	if target := (podFileTransferDoneMsg{model: files}).Target(); target != files {
+		t.Error("Expected transfer results to be routed to their screen")
+	}
+	files.Update(podFileTransferDoneMsg{model: newTestPodFiles(), transfer: transfer, err: errors.New("other screen")})
+	if files.transfer == nil {
+		t.Error("Expected another screen's transfer result to be ignored")
+	}
+
+	files.Update(podFileTransferDoneMsg{model: files, transfer: transfer, err: k8s.ErrTarNotFound})
+	if files.transfer != nil || !errors.Is(files.err, k8s.ErrTarNotFound) {
+		t.Errorf("Expected the transfer error to be shown, got %v", files.err)
+	}
+	if _, cmd := files.Update(podFileTransferTickMsg{model: files, transfer: transfer}); cmd != nil {
+		t.Error("Expected ticks to stop once the transfer is done")
+	}
+}
@@ -0,0 +1,328 @@
+package models
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const logBatchSize = 500
+
+type logPreset struct {
+	label string
+	value int64
+}
+
+var logSincePresets = []logPreset{
+	{"all", 0},
+	{"5m", 300},
+	{"15m", 900},
+	{"1h", 3600},
+	{"6h", 21600},
+	{"24h", 86400},
+}
+
+var logTailPresets = []logPreset{
+	{"100", 100},
+	{"500", 500},
+	{"1000", 1000},
+	{"5000", 5000},
+	{"all", 0},
+}
+
+type podLogLinesMsg struct {
+	model   *podLogsModel
+	session int
+	lines   []string
+}
+
+func (msg podLogLinesMsg) Target() any {
+	return msg.model
+}
+
+type podLogStreamEndMsg struct {
+	model   *podLogsModel
+	session int
+	err     error
+}
+
+func (msg podLogStreamEndMsg) Target() any {
+	return msg.model
+}
+
+type podLogContainerMsg struct {
+	container string
+}
+
+type logStream struct {
+	lines chan string
+	errc  chan error
+}
+
+func newLogStream() *logStream {
+	return &logStream{
+		lines: make(chan string, logBatchSize),
+		errc:  make(chan error, 1),
+	}
+}
+
+func (s *logStream) wait(model *podLogsModel, session int) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-s.lines
+		if !ok {
+			return podLogStreamEndMsg{model: model, session: session, err: <-s.errc}
+		}
+
+		lines := []string{line}
+		for len(lines) < logBatchSize {
+			select {
+			case line, ok := <-s.lines:
+				if !ok {
+					return podLogLinesMsg{model: model, session: session, lines: lines}
+				}
+				lines = append(lines, line)
+			default:
+				return podLogLinesMsg{model: model, session: session, lines: lines}
+			}
+		}
+		return podLogLinesMsg{model: model, session: session, lines: lines}
+	}
+}
+
+type podLogsModel struct {
+	requestScope
+	pod        *k8s.Pod
+	k8sClient  *k8s.Client
+	containers []string
+	options    k8s.LogOptions
+	sinceIndex int
+	tailIndex  int
+	viewer     *components.LogViewer
+	picker     *components.ListModel
+	stream     *logStream
+	session    int
+	cancel     context.CancelFunc
+	streaming  bool
+	loading    bool
+	err        error
+}
+
+func NewPodLogs(k k8s.Client, namespace, podName string) *podLogsModel {
+	return &podLogsModel{
+		pod:       k8s.NewPod(podName, namespace, k),
+		k8sClient: &k,
+		options: k8s.LogOptions{
+			Follow:    true,
+			TailLines: logTailPresets[1].value,
+		},
+		tailIndex: 1,
+	}
+}
+
+func (p *podLogsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	p.k8sClient = k
+
+	p.viewer = components.NewLogViewer("Logs: " + p.pod.Name)
+	p.viewer.SetCustomHelp("↑/↓: Scroll • space: Pause • G: Bottom • f: Follow • c: Container • p: Previous • s: Since • l: Tail • t: Timestamps • /: Search • &: Filter • !: Exclude • ctrl+s: Export • q: Back")
+	p.updateStatus()
+
+	return p, nil
+}
+
+func (p *podLogsModel) Init() tea.Cmd {
+	if p.streaming || p.loading {
+		return p.viewer.Init()
+	}
+	if p.containers == nil {
+		p.loading = true
+		p.updateStatus()
+		return tea.Batch(p.viewer.Init(), loadPodContainers(p.requestContext(), p.pod, p))
+	}
+	return tea.Batch(p.viewer.Init(), p.restart())
+}
+
+func (p *podLogsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case podContainersMsg:
+		if msg.owner != p || !p.loading {
+			return p, nil
+		}
+		p.loading = false
+		if msg.err != nil {
+			p.err = msg.err
+			p.viewer.AppendLines("--- " + msg.err.Error() + " ---")
+			p.updateStatus()
+			return p, nil
+		}
+		p.containers = msg.containers
+		p.options.Container = msg.containers[0]
+		return p, p.restart()
+
+	case podLogLinesMsg:
+		if msg.model != p || msg.session != p.session {
+			return p, nil
+		}
+		p.viewer.AppendLines(msg.lines...)
+		return p, p.stream.wait(p, msg.session)
+
+	case podLogStreamEndMsg:
+		if msg.model != p || msg.session != p.session {
+			return p, nil
+		}
+		p.streaming = false
+		p.err = msg.err
+		if msg.err != nil {
+			p.viewer.AppendLines("--- " + msg.err.Error() + " ---")
+		} else {
+			p.viewer.AppendLines("--- end of log stream ---")
+		}
+		p.updateStatus()
+		return p, nil
+
+	case podLogContainerMsg:
+		p.picker = nil
+		if msg.container == p.options.Container {
+			return p, nil
+		}
+		p.options.Container = msg.container
+		return p, p.restart()
+
+	case tea.WindowSizeMsg:
+		if p.picker != nil {
+			p.picker.Update(msg)
+		}
+
+	case tea.KeyMsg:
+		if p.picker != nil {
+			if msg.String() == "c" {
+				p.picker = nil
+				return p, nil
+			}
+			_, cmd := p.picker.Update(msg)
+			return p, cmd
+		}
+		if p.viewer.CapturingInput() {
+			break
+		}
+
+		switch msg.String() {
+		case "c":
+			if len(p.containers) > 1 {
+				p.picker = p.newContainerPicker()
+				return p, p.picker.Init()
+			}
+			return p, nil
+		case "f":
+			p.options.Follow = !p.options.Follow
+			return p, p.restart()
+		case "p":
+			p.options.Previous = !p.options.Previous
+			return p, p.restart()
+		case "s":
+			p.sinceIndex = (p.sinceIndex + 1) % len(logSincePresets)
+			p.options.SinceSeconds = logSincePresets[p.sinceIndex].value
+			return p, p.restart()
+		case "l":
+			p.tailIndex = (p.tailIndex + 1) % len(logTailPresets)
+			p.options.TailLines = logTailPresets[p.tailIndex].value
+			return p, p.restart()
+		case "t":
+			p.options.Timestamps = !p.options.Timestamps
+			return p, p.restart()
+		}
+	}
+
+	updated, cmd := p.viewer.Update(msg)
+	if viewer, ok := updated.(*components.LogViewer); ok {
+		p.viewer = viewer
+	}
+	p.updateStatus()
+	return p, cmd
+}
+
+func (p *podLogsModel) View() string {
+	if p.picker != nil {
+		return p.picker.View()
+	}
+	return p.viewer.View()
+}
+
+func (p *podLogsModel) CapturingInput() bool {
+	return p.picker == nil && p.viewer.CapturingInput()
+}
+
+func (p *podLogsModel) Close() {
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+	p.streaming = false
+	p.loading = false
+	p.session++
+	p.requestScope.Close()
+}
+
+func (p *podLogsModel) restart() tea.Cmd {
+	p.Close()
+	p.err = nil
+	p.viewer.Clear()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.streaming = true
+	p.stream = newLogStream()
+
+	pod := p.pod
+	opts := p.options
+	stream := p.stream
+	go func() {
+		stream.errc <- pod.FollowLogs(ctx, opts, stream.lines)
+		close(stream.lines)
+	}()
+
+	p.viewer.SetExportName(p.k8sClient.ContextName(), pod.Namespace, pod.Name, opts.Container)
+	p.viewer.SetExporter(func(w io.Writer) error {
+		return pod.WriteLogs(context.Background(), opts, w)
+	})
+
+	p.updateStatus()
+	return stream.wait(p, p.session)
+}
+
+func (p *podLogsModel) newContainerPicker() *components.ListModel {
+	picker := components.NewList(p.containers, "Containers", func(selected string) tea.Msg {
+		return podLogContainerMsg{container: selected}
+	})
+	picker.SetFooterText("enter: Select container • c: Cancel")
+	return picker
+}
+
+func (p *podLogsModel) updateStatus() {
+	status := []string{"container=" + p.options.Container}
+	if p.options.Follow {
+		status = append(status, "follow")
+	}
+	if p.options.Previous {
+		status = append(status, "previous")
+	}
+	status = append(status, "since="+logSincePresets[p.sinceIndex].label)
+	status = append(status, "tail="+logTailPresets[p.tailIndex].label)
+	if p.options.Timestamps {
+		status = append(status, "timestamps")
+	}
+	if p.loading {
+		status = append(status, "loading")
+	} else if p.streaming {
+		status = append(status, "streaming")
+	} else if p.err != nil {
+		status = append(status, "error")
+	} else {
+		status = append(status, "stopped")
+	}
+	p.viewer.SetStatus(strings.Join(status, " "))
+}
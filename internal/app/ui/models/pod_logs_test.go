@@ -0,0 +1,123 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestPodLogs() *podLogsModel {
+	model := NewPodLogs(k8s.Client{Clientset: fake.NewSimpleClientset(), Namespace: "default"}, "default", "test-pod")
+	model.containers = []string{"app", "sidecar"}
+	model.options.Container = "app"
+	model.viewer = components.NewLogViewer("Logs: test-pod")
+	return model
+}
+
+func TestNewPodLogs(t *testing.T) {
+	model := NewPodLogs(k8s.Client{Namespace: "default"}, "default", "test-pod")
+
+	if model.pod.Name != "test-pod" {
+		t.Errorf("Expected pod name 'test-pod', got '%s'", model.pod.Name)
+	}
+	if !model.options.Follow {
+		t.Error("Expected follow to be enabled by default")
+	}
+	if model.options.TailLines != logTailPresets[model.tailIndex].value {
+		t.Error("Expected tail lines to match the selected preset")
+	}
+}
+
+func TestPodLogsIgnoresStaleSessions(t *testing.T) {
+	model := newTestPodLogs()
+	model.session = 2
+
+	model.Update(podLogLinesMsg{model: model, session: 1, lines: []string{"stale"}})
+	if len(model.viewer.Lines()) != 0 {
+		t.Errorf("Expected stale lines to be ignored, got %v", model.viewer.Lines())
+	}
+
+	model.stream = newLogStream()
+	model.Update(podLogLinesMsg{model: model, session: 2, lines: []string{"fresh"}})
+	if lines := model.viewer.Lines(); len(lines) != 1 || lines[0] != "fresh" {
+		t.Errorf("Expected current session lines to be shown, got %v", lines)
+	}
+}
+
+func TestPodLogsIgnoresOtherScreens(t *testing.T) {
+	model := newTestPodLogs()
+	other := newTestPodLogs()
+	model.session, other.session = 1, 1
+	model.stream = newLogStream()
+
+	msg := podLogLinesMsg{model: other, session: 1, lines: []string{"other pod"}}
+	if msg.Target() != other {
+		t.Error("Expected log lines to be routed to the screen that streamed them")
+	}
+	if _, cmd := model.Update(msg); cmd != nil || len(model.viewer.Lines()) != 0 {
+		t.Errorf("Expected another screen's lines to be ignored, got %v", model.viewer.Lines())
+	}
+	model.streaming = true
+	model.Update(podLogStreamEndMsg{model: other, session: 1})
+	if !model.streaming {
+		t.Error("Expected another screen's stream end to be ignored")
+	}
+}
+
+func TestPodLogsClose(t *testing.T) {
+	model := newTestPodLogs()
+	cancelled := false
+	model.cancel = func() { cancelled = true }
+	model.streaming = true
+	session := model.session
+
+	var closable ClosableModel = model
+	closable.Close()
+
+	if !cancelled {
+		t.Error("Expected Close to cancel the log stream")
+	}
+	if model.streaming {
+		t.Error("Expected streaming to be false after Close")
+	}
+	if model.session == session {
+		t.Error("Expected Close to invalidate the current session")
+	}
+}
+
+func TestPodLogsContainerSelection(t *testing.T) {
+	model := newTestPodLogs()
+	model.picker = model.newContainerPicker()
+
+	_, cmd := model.Update(podLogContainerMsg{container: "sidecar"})
+	defer model.Close()
+
+	if model.picker != nil {
+		t.Error("Expected picker to close after selection")
+	}
+	if model.options.Container != "sidecar" {
+		t.Errorf("Expected container 'sidecar', got '%s'", model.options.Container)
+	}
+	if cmd == nil {
+		t.Error("Expected selecting a new container to restart the stream")
+	}
+}
+
+func TestPodLogsKeysIgnoredWhileSearching(t *testing.T) {
+	model := newTestPodLogs()
+	follow := model.options.Follow
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	if !model.CapturingInput() {
+		t.Fatal("Expected search prompt to capture input")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if model.options.Follow != follow {
+		t.Error("Expected typing in the search prompt not to toggle follow")
+	}
+}
@@ -0,0 +1,177 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/term"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const terminalResizeInterval = 250 * time.Millisecond
+
+type podShellContainerMsg struct {
+	container string
+}
+
+type podShellExitMsg struct {
+	err error
+}
+
+type terminalCommand struct {
+	banner string
+	run    func(ctx context.Context, stdin io.Reader, stdout io.Writer, sizes remotecommand.TerminalSizeQueue) error
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+func (c *terminalCommand) SetStdin(r io.Reader) {
+	c.stdin = r
+}
+
+func (c *terminalCommand) SetStdout(w io.Writer) {
+	c.stdout = w
+}
+
+func (c *terminalCommand) SetStderr(io.Writer) {}
+
+func (c *terminalCommand) Run() error {
+	if file, ok := c.stdin.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		state, err := term.MakeRaw(int(file.Fd()))
+		if err != nil {
+			return fmt.Errorf("failed to set terminal raw mode: %v", err)
+		}
+		defer term.Restore(int(file.Fd()), state)
+	}
+
+	sizeFd := int(os.Stdout.Fd())
+	if file, ok := c.stdout.(*os.File); ok {
+		sizeFd = int(file.Fd())
+	}
+	sizes := newTerminalSizeQueue(func() (int, int, error) {
+		return term.GetSize(sizeFd)
+	}, terminalResizeInterval)
+	defer sizes.stop()
+
+	fmt.Fprint(c.stdout, c.banner)
+	return c.run(context.Background(), c.stdin, c.stdout, sizes)
+}
+
+func podShellCmd(pod *k8s.Pod, container string, done func(err error) tea.Msg) tea.Cmd {
+	return tea.Exec(&terminalCommand{
+		banner: fmt.Sprintf("Connecting to %s/%s (container %s)...\r\n", pod.Namespace, pod.Name, container),
+		run: func(ctx context.Context, stdin io.Reader, stdout io.Writer, sizes remotecommand.TerminalSizeQueue) error {
+			return pod.Shell(ctx, container, stdin, stdout, sizes)
+		},
+	}, done)
+}
+
+type terminalSizeQueue struct {
+	sizes chan remotecommand.TerminalSize
+	done  chan struct{}
+	once  sync.Once
+}
+
+func newTerminalSizeQueue(getSize func() (int, int, error), interval time.Duration) *terminalSizeQueue {
+	q := &terminalSizeQueue{
+		sizes: make(chan remotecommand.TerminalSize, 1),
+		done:  make(chan struct{}),
+	}
+
+	go func() {
+		defer close(q.sizes)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var last remotecommand.TerminalSize
+		for {
+			if width, height, err := getSize(); err == nil {
+				size := remotecommand.TerminalSize{Width: uint16(width), Height: uint16(height)}
+				if size != last {
+					select {
+					case q.sizes <- size:
+						last = size
+					case <-q.done:
+						return
+					}
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-q.done:
+				return
+			}
+		}
+	}()
+
+	return q
+}
+
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q.sizes
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+func (q *terminalSizeQueue) stop() {
+	q.once.Do(func() { close(q.done) })
+}
+
+type podShellModel struct {
+	pod       *k8s.Pod
+	k8sClient *k8s.Client
+	picker    *components.ListModel
+}
+
+func NewPodShell(k k8s.Client, namespace, podName string, containers []string) *podShellModel {
+	model := &podShellModel{
+		pod:       k8s.NewPod(podName, namespace, k),
+		k8sClient: &k,
+	}
+	model.picker = components.NewList(containers, "Shell: "+podName, func(selected string) tea.Msg {
+		return podShellContainerMsg{container: selected}
+	})
+	model.picker.SetFooterText("enter: Open shell • q: Back")
+	return model
+}
+
+func (s *podShellModel) Init() tea.Cmd {
+	return s.picker.Init()
+}
+
+func (s *podShellModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case podShellContainerMsg:
+		return s, podShellCmd(s.pod, msg.container, func(err error) tea.Msg {
+			return podShellExitMsg{err: err}
+		})
+
+	case podShellExitMsg:
+		back := func() tea.Msg { return components.BackMsg{} }
+		if msg.err == nil {
+			return s, back
+		}
+		client := *s.k8sClient
+		err := msg.err
+		return s, tea.Sequence(back, func() tea.Msg {
+			return components.NavigateMsg{Error: err, Cluster: client}
+		})
+	}
+
+	_, cmd := s.picker.Update(msg)
+	return s, cmd
+}
+
+func (s *podShellModel) View() string {
+	return s.picker.View()
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTerminalSizeQueue(t *testing.T) {
+	var mu sync.Mutex
+	sizes := [][2]int{{80, 24}, {80, 24}, {120, 40}}
+	calls := 0
+	queue := newTerminalSizeQueue(func() (int, int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		size := sizes[min(calls, len(sizes)-1)]
+		calls++
+		return size[0], size[1], nil
+	}, time.Millisecond)
+
+	first := queue.Next()
+	if first == nil || first.Width != 80 || first.Height != 24 {
+		t.Fatalf("Expected initial size 80x24, got %+v", first)
+	}
+	second := queue.Next()
+	if second == nil || second.Width != 120 || second.Height != 40 {
+		t.Fatalf("Expected resized 120x40 without duplicates, got %+v", second)
+	}
+
+	queue.stop()
+	queue.stop()
+	if size := queue.Next(); size != nil {
+		t.Errorf("Expected nil after stop, got %+v", size)
+	}
+}
+
+func TestTerminalSizeQueueSkipsErrors(t *testing.T) {
+	queue := newTerminalSizeQueue(func() (int, int, error) {
+		return 0, 0, errors.New("not a terminal")
+	}, time.Millisecond)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.stop()
+	}()
+	if size := queue.Next(); size != nil {
+		t.Errorf("Expected no sizes when the terminal size is unavailable, got %+v", size)
+	}
+}
+
+func TestPodShellExitNavigatesBack(t *testing.T) {
+	client := k8s.Client{Clientset: fake.NewSimpleClientset(), Namespace: "default"}
+	model := NewPodShell(client, "default", "test-pod", []string{"app", "sidecar"})
+
+	_, cmd := model.Update(podShellExitMsg{})
+	if cmd == nil {
+		t.Fatal("Expected a command after the shell exits")
+	}
+	if _, ok := cmd().(components.BackMsg); !ok {
+		t.Error("Expected the shell screen to navigate back on exit")
+	}
+
+	_, cmd = model.Update(podShellExitMsg{err: errors.New("no shell found")})
+	if cmd == nil {
+		t.Error("Expected a command reporting the shell error")
+	}
+}
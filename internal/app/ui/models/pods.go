@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -17,7 +18,8 @@ import (
 
 type podsModel struct {
 	*GenericResourceModel
-	selector string
+	selector        string
+	resolveSelector func(ctx context.Context) (string, error)
 }
 
 func NewPods(k k8s.Client, namespace string, selector ...string) (*podsModel, error) {
@@ -52,13 +54,15 @@ func NewPods(k k8s.Client, namespace string, selector ...string) (*podsModel, er
 	return model, nil
 }
 
+func NewPodsMatching(k k8s.Client, namespace string, resolveSelector func(ctx context.Context) (string, error)) *podsModel {
+	model, _ := NewPods(k, namespace)
+	model.resolveSelector = resolveSelector
+	return model
+}
+
 func (p *podsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	p.k8sClient = k
 
-	if err := p.fetchData(p.selector); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		podDetails, err := NewPodDetails(*k, p.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -73,38 +77,210 @@ func (p *podsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := p.fetchData(p.selector); err != nil {
-			return nil, err
+	fetchFunc := func(ctx context.Context) (func(), error) {
+		selector := p.selector
+		if p.resolveSelector != nil {
+			resolved, err := p.resolveSelector(ctx)
+			if err != nil {
+				return nil, err
+			}
+			selector = resolved
 		}
-		return p.dataToRows(), nil
+		return p.fetchData(ctx, selector)
 	}
 
-	tableModel := ui.NewTable(p.config.Columns, p.config.ColumnWidths, p.dataToRows(), p.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := p.newTable(onSelect, fetchFunc, p.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": p.createDeleteAction(tableModel),
+		"e": p.createEditAction(tableModel),
+		"L": p.createEditMetadataAction(tableModel),
+		"l": p.createLogsAction(tableModel),
+		"s": p.createShellAction(tableModel),
+		"f": p.createPortForwardAction(tableModel),
+		"b": p.createDebugAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, p.refreshInterval, p.k8sClient, "Pods"), nil
+	return NewAutoRefreshModel(tableModel, p.refreshInterval, p.k8sClient, "Pods").Watch(k8s.ResourceTypePod, p.namespace).CloseWith(p), nil
 }
 
-func (p *podsModel) fetchData(selector string) error {
+func (p *podsModel) fetchData(ctx context.Context, selector string) (func(), error) {
 	var podsInfo []k8s.PodInfo
 	var err error
 
 	logger.Debug(fmt.Sprintf("Pods fetchData: namespace=%s, selector=%s", p.namespace, selector))
-	podsInfo, err = p.pluginAPI.GetPods(p.namespace, selector)
+	podsInfo, err = p.pluginAPI.GetPods(ctx, p.namespace, selector)
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return func() {
+		p.resourceData = make([]types.ResourceData, len(podsInfo))
+		for i, pod := range podsInfo {
+			p.resourceData[i] = PodData{&pod}
+		}
+	}, nil
+}
+
+func (p *podsModel) createLogsAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(p.resourceData) {
+			return nil
+		}
+
+		pod := p.resourceData[selected]
+
+		return func() tea.Msg {
+			logs, err := NewPodLogs(*p.k8sClient, pod.GetNamespace(), pod.GetName()).InitComponent(p.k8sClient)
+			if err != nil {
+				return components.NavigateMsg{
+					Error:   err,
+					Cluster: *p.k8sClient,
+				}
+			}
+			return components.NavigateMsg{
+				NewScreen:  logs,
+				Breadcrumb: "Logs",
+			}
+		}
+	}
+}
+
+func (p *podsModel) createShellAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(p.resourceData) {
+			return nil
+		}
+
+		pod := k8s.NewPod(p.resourceData[selected].GetName(), p.resourceData[selected].GetNamespace(), *p.k8sClient)
+
+		ctx := p.requestContext()
+		return func() tea.Msg {
+			containers, err := pod.GetContainers(ctx)
+			if err == nil && len(containers) == 0 {
+				err = fmt.Errorf("pod %s has no containers", pod.Name)
+			}
+			if err != nil {
+				return components.NavigateMsg{
+					Error:   err,
+					Cluster: *p.k8sClient,
+				}
+			}
+
+			if len(containers) == 1 {
+				client := *p.k8sClient
+				return podShellCmd(pod, containers[0], func(err error) tea.Msg {
+					if err == nil {
+						return nil
+					}
+					return components.NavigateMsg{
+						Error:   err,
+						Cluster: client,
+					}
+				})()
+			}
+
+			return components.NavigateMsg{
+				NewScreen:  NewPodShell(*p.k8sClient, pod.Namespace, pod.Name, containers),
+				Breadcrumb: "Shell",
+			}
+		}
 	}
+}
+
+func (p *podsModel) createDebugAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(p.resourceData) {
+			return nil
+		}
 
-	p.resourceData = make([]types.ResourceData, len(podsInfo))
-	for i, pod := range podsInfo {
-		p.resourceData[i] = PodData{&pod}
+		pod := k8s.NewPod(p.resourceData[selected].GetName(), p.resourceData[selected].GetNamespace(), *p.k8sClient)
+
+		ctx := p.requestContext()
+		return func() tea.Msg {
+			containers, err := pod.GetContainers(ctx)
+			if err == nil && len(containers) == 0 {
+				err = fmt.Errorf("pod %s has no containers", pod.Name)
+			}
+			if err != nil {
+				return components.NavigateMsg{
+					Error:   err,
+					Cluster: *p.k8sClient,
+				}
+			}
+
+			return components.NavigateMsg{
+				NewScreen:  NewPodDebug(*p.k8sClient, pod.Namespace, pod.Name, containers),
+				Breadcrumb: "Debug",
+			}
+		}
 	}
+}
 
-	return nil
+func (p *podsModel) createPortForwardAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(p.resourceData) {
+			return nil
+		}
+
+		pod := k8s.NewPod(p.resourceData[selected].GetName(), p.resourceData[selected].GetNamespace(), *p.k8sClient)
+
+		ctx := p.requestContext()
+		return func() tea.Msg {
+			defaultPorts := ""
+			if err := pod.Fetch(ctx); err == nil {
+				for _, container := range pod.Raw.Spec.Containers {
+					if len(container.Ports) > 0 {
+						port := container.Ports[0].ContainerPort
+						defaultPorts = fmt.Sprintf("%d:%d", port, port)
+						break
+					}
+				}
+			}
+			return portForwardPrompt(*p.k8sClient, k8s.ResourceTypePod, pod.Namespace, pod.Name, defaultPorts)
+		}
+	}
+}
+
+type podContainersMsg struct {
+	owner      any
+	containers []string
+	err        error
+}
+
+func (msg podContainersMsg) Target() any {
+	return msg.owner
+}
+
+func loadPodContainers(ctx context.Context, pod *k8s.Pod, owner any) tea.Cmd {
+	pod = &k8s.Pod{Name: pod.Name, Namespace: pod.Namespace, Client: pod.Client, Config: pod.Config}
+	return func() tea.Msg {
+		containers, err := pod.GetContainers(ctx)
+		if err == nil && len(containers) == 0 {
+			err = fmt.Errorf("pod %s has no containers", pod.Name)
+		}
+		return podContainersMsg{owner: owner, containers: containers, err: err}
+	}
 }
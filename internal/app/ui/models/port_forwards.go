@@ -0,0 +1,142 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"github.com/otavioCosta2110/k8s-tui/pkg/format"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type portForwardsModel struct {
+	manager   *k8s.PortForwardManager
+	k8sClient *k8s.Client
+	forwards  []*k8s.PortForward
+}
+
+func NewPortForwards(k k8s.Client) *portForwardsModel {
+	return &portForwardsModel{
+		manager:   k8s.PortForwards,
+		k8sClient: &k,
+	}
+}
+
+func (p *portForwardsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	p.k8sClient = k
+
+	columns := []table.Column{
+		components.NewColumn("ID", 0),
+		components.NewColumn("TARGET", 0),
+		components.NewColumn("NAMESPACE", 0),
+		components.NewColumn("POD", 0),
+		components.NewColumn("LOCAL", 0),
+		components.NewColumn("REMOTE", 0),
+		components.NewColumn("STATUS", 0),
+		components.NewColumn("AGE", 0),
+	}
+	fetchFunc := func() (func() []table.Row, error) {
+		return p.rows, nil
+	}
+
+	title := customstyles.ResourceIcons["PortForwards"] + " Port Forwards"
+	tableModel := ui.NewTable(columns, []float64{0.3, 1.5, 1, 1.5, 0.5, 0.5, 1.5, 0.5}, p.rows(), title, nil, 1, fetchFunc, nil)
+	tableModel.SetUpdateActions(map[string]func() tea.Cmd{
+		"d": p.createStopAction(tableModel),
+	})
+
+	return NewAutoRefreshModel(tableModel, time.Second, p.k8sClient, "Port Forwards"), nil
+}
+
+func (p *portForwardsModel) rows() []table.Row {
+	p.forwards = p.manager.List()
+	rows := make([]table.Row, len(p.forwards))
+	for i, forward := range p.forwards {
+		status, err := forward.Status()
+		statusText := string(status)
+		if err != nil {
+			statusText += ": " + err.Error()
+		}
+		rows[i] = table.Row{
+			strconv.Itoa(forward.ID),
+			forward.Target(),
+			forward.Namespace,
+			forward.Pod,
+			strconv.Itoa(forward.LocalPort),
+			strconv.Itoa(forward.RemotePort),
+			statusText,
+			format.FormatAge(forward.StartedAt),
+		}
+	}
+	return rows
+}
+
+func (p *portForwardsModel) createStopAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		for _, idx := range tableModel.GetCheckedItems() {
+			if idx >= 0 && idx < len(p.forwards) {
+				p.manager.Stop(p.forwards[idx].ID)
+			}
+		}
+		tableModel.ClearCheckedItems()
+		_, cmd := tableModel.Refresh()
+		return cmd
+	}
+}
+
+func portForwardPrompt(k k8s.Client, kind k8s.ResourceType, namespace, name, defaultPorts string) tea.Msg {
+	prompt := components.NewPrompt(
+		fmt.Sprintf("Port forward %s/%s", kind, name),
+		"Ports as LOCAL:REMOTE, REMOTE, or :REMOTE for a random local port.",
+		defaultPorts,
+		func(value string) tea.Cmd {
+			return startPortForwardCmd(k, kind, namespace, name, value)
+		},
+	)
+	prompt.SetValidator(func(value string) error {
+		_, _, err := k8s.ParsePortPair(value)
+		return err
+	})
+	return components.NavigateMsg{
+		NewScreen:  prompt,
+		Breadcrumb: "Port Forward",
+	}
+}
+
+func startPortForwardCmd(k k8s.Client, kind k8s.ResourceType, namespace, name, ports string) tea.Cmd {
+	return func() tea.Msg {
+		localPort, remotePort, err := k8s.ParsePortPair(ports)
+		if err == nil {
+			if kind == k8s.ResourceTypeService {
+				_, err = k8s.PortForwards.StartService(context.Background(), k, namespace, name, localPort, remotePort)
+			} else {
+				_, err = k8s.PortForwards.StartPod(k, namespace, name, localPort, remotePort)
+			}
+		}
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+
+		forwards, err := NewPortForwards(k).InitComponent(&k)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		return components.NavigateMsg{
+			NewScreen:  forwards,
+			Breadcrumb: "Port Forwards",
+		}
+	}
+}
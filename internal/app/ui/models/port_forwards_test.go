@@ -0,0 +1,35 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+)
+
+func TestPortForwardsEmpty(t *testing.T) {
+	model := NewPortForwards(k8s.Client{Namespace: "default"})
+	model.manager = k8s.NewPortForwardManager()
+
+	if _, err := model.InitComponent(model.k8sClient); err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	if rows := model.rows(); len(rows) != 0 {
+		t.Errorf("Expected no rows without forwards, got %v", rows)
+	}
+}
+
+func TestPortForwardPromptValidatesPorts(t *testing.T) {
+	msg := portForwardPrompt(k8s.Client{Namespace: "default"}, k8s.ResourceTypeService, "default", "web", "80:80")
+	navigate, ok := msg.(components.NavigateMsg)
+	if !ok {
+		t.Fatalf("Expected a NavigateMsg, got %T", msg)
+	}
+	prompt, ok := navigate.NewScreen.(*components.PromptModel)
+	if !ok {
+		t.Fatalf("Expected a prompt screen, got %T", navigate.NewScreen)
+	}
+	if prompt.Value() != "80:80" {
+		t.Errorf("Expected default ports 80:80, got %q", prompt.Value())
+	}
+}
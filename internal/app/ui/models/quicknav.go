@@ -57,6 +57,7 @@ func (m QuickNavModel) getResourceTypeFromKey(key string) string {
 	mappings := resourceFactory.GetQuickNavMappings()
 
 	mappings["l"] = "ResourceList"
+	mappings["f"] = "PortForwards"
 
 	if resourceType, exists := mappings[key]; exists {
 		return resourceType
@@ -66,25 +67,15 @@ func (m QuickNavModel) getResourceTypeFromKey(key string) string {
 
 func (m QuickNavModel) navigateToResource(resourceType string) tea.Cmd {
 	return func() tea.Msg {
-		if resourceType == "ResourceList" {
-			resourceScreen := NewResource(m.kube, m.namespace)
-			resourceComponent := resourceScreen.InitComponent(m.kube)
-			return components.NavigateMsg{
-				NewScreen:  resourceComponent,
-				Breadcrumb: "Resource List",
-			}
-		}
-
-		resourceList, err := NewResourceList(m.kube, m.namespace, resourceType).InitComponent(m.kube)
+		screen, breadcrumb, err := resourceScreen(m.kube, m.namespace, resourceType)
 		if err != nil {
 			return components.NavigateMsg{
 				Error: err,
 			}
 		}
-
 		return components.NavigateMsg{
-			NewScreen:  resourceList,
-			Breadcrumb: resourceType,
+			NewScreen:  screen,
+			Breadcrumb: breadcrumb,
 		}
 	}
 }
@@ -128,6 +119,22 @@ func (m QuickNavModel) View() string {
 		group string
 	}{"l", resourceListDesc, "Navigation"})
 
+	portForwardsDesc := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(lipgloss.Color(customstyles.BackgroundColor)).
+		Render(customstyles.ResourceIcons["PortForwards"]) +
+		resourceListSpace +
+		lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.TextColor)).
+			Background(lipgloss.Color(customstyles.BackgroundColor)).
+			Render("Port Forwards")
+
+	mappings = append(mappings, struct {
+		key   string
+		desc  string
+		group string
+	}{"f", portForwardsDesc, "Navigation"})
+
 	resourceIcons := customstyles.ResourceIcons
 
 	for _, mapping := range sortedMappings {
@@ -1,9 +1,9 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
-	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
 	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
@@ -46,30 +46,18 @@ func NewReplicaSets(k k8s.Client, namespace string) (*replicasetsModel, error) {
 func (r *replicasetsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	r.k8sClient = k
 
-	if err := r.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
-		replicaset := k8s.NewReplicaSet(selected, r.namespace, *k)
-		err := replicaset.Fetch()
-		if err != nil {
-			return components.NavigateMsg{
-				Error:   fmt.Errorf("failed to fetch replicaset: %v", err),
-				Cluster: *k,
+		pods := NewPodsMatching(*k, r.namespace, func(ctx context.Context) (string, error) {
+			replicaset := k8s.NewReplicaSet(selected, r.namespace, *k)
+			if err := replicaset.Fetch(ctx); err != nil {
+				return "", fmt.Errorf("failed to fetch replicaset: %v", err)
 			}
-		}
-		selector, err := replicaset.GetLabelSelector()
-		if err != nil {
-			selector = fmt.Sprintf("app=%s", replicaset.Name)
-		}
-		pods, err := NewPods(*k, r.namespace, selector)
-		if err != nil {
-			return components.NavigateMsg{
-				Error:   err,
-				Cluster: *k,
+			selector, err := replicaset.GetLabelSelector()
+			if err != nil {
+				selector = fmt.Sprintf("app=%s", replicaset.Name)
 			}
-		}
+			return selector, nil
+		})
 
 		podsComponent, err := pods.InitComponent(k)
 		if err != nil {
@@ -85,38 +73,36 @@ func (r *replicasetsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := r.fetchData(); err != nil {
-			return nil, err
-		}
-		return r.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(r.config.Columns, r.config.ColumnWidths, r.dataToRows(), r.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := r.newTable(onSelect, r.fetchData, r.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": r.createDeleteAction(tableModel),
+		"e": r.createEditAction(tableModel),
+		"L": r.createEditMetadataAction(tableModel),
+		"l": r.createWorkloadLogsAction(tableModel),
+		"s": r.createScaleAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, r.refreshInterval, r.k8sClient, "ReplicaSets"), nil
+	return NewAutoRefreshModel(tableModel, r.refreshInterval, r.k8sClient, "ReplicaSets").Watch(k8s.ResourceTypeReplicaSet, r.namespace).CloseWith(r), nil
 }
 
-func (r *replicasetsModel) fetchData() error {
+func (r *replicasetsModel) fetchData(ctx context.Context) (func(), error) {
 	var replicasetInfo []k8s.ReplicaSetInfo
 	var err error
 
-	replicasetInfo, err = r.pluginAPI.GetReplicaSets(r.namespace)
+	replicasetInfo, err = r.pluginAPI.GetReplicaSets(ctx, r.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch replicasets: %v", err)
+		return nil, fmt.Errorf("failed to fetch replicasets: %v", err)
 	}
-	r.replicasetsInfo = replicasetInfo
 
-	r.resourceData = make([]types.ResourceData, len(replicasetInfo))
-	for i, replicaset := range replicasetInfo {
-		r.resourceData[i] = ReplicaSetData{&replicaset}
-	}
+	return func() {
+		r.replicasetsInfo = replicasetInfo
 
-	return nil
+		r.resourceData = make([]types.ResourceData, len(replicasetInfo))
+		for i, replicaset := range replicasetInfo {
+			r.resourceData[i] = ReplicaSetData{&replicaset}
+		}
+	}, nil
 }
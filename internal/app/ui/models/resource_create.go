@@ -0,0 +1,309 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/config"
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const createFromFileItem = "Open local file..."
+
+type createSourceMsg struct {
+	title   string
+	content string
+}
+
+type createResultMsg struct {
+	results []k8s.CreateResult
+	err     error
+}
+
+type resourceCreateModel struct {
+	requestScope
+	k8sClient *k8s.Client
+	namespace string
+	sources   map[string]string
+	picker    *components.ListModel
+	editor    *components.YAMLEditor
+	title     string
+	content   string
+	status    string
+	results   []k8s.CreateResult
+	err       error
+}
+
+func NewResourceCreate(k k8s.Client, namespace string) *resourceCreateModel {
+	if namespace == "" {
+		namespace = "default"
+	}
+	return &resourceCreateModel{
+		k8sClient: &k,
+		namespace: namespace,
+	}
+}
+
+func CreateResourceCmd(k k8s.Client, namespace string) tea.Cmd {
+	return func() tea.Msg {
+		creator, err := NewResourceCreate(k, namespace).InitComponent(&k)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		return components.NavigateMsg{
+			NewScreen:  creator,
+			Breadcrumb: "Create",
+		}
+	}
+}
+
+func (c *resourceCreateModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	c.k8sClient = k
+
+	userTemplates, err := k8s.LoadUserTemplates(config.TemplatesDir(), c.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []string{}
+	c.sources = map[string]string{}
+	for _, template := range append(k8s.BuiltinTemplates(c.namespace), userTemplates...) {
+		title := template.Name
+		if !template.Builtin {
+			title = "Template: " + template.Name
+		}
+		items = append(items, title)
+		c.sources[title] = template.Content
+	}
+	items = append(items, createFromFileItem)
+
+	c.picker = components.NewList(items, "Create in "+c.namespace, func(selected string) tea.Msg {
+		if selected == createFromFileItem {
+			return c.filePrompt()
+		}
+		return createSourceMsg{title: selected, content: c.sources[selected]}
+	})
+	c.picker.SetFooterText("enter: Select • esc: Cancel")
+	return c, nil
+}
+
+func (c *resourceCreateModel) filePrompt() tea.Msg {
+	prompt := components.NewPrompt(
+		"Create from file",
+		"Path to a YAML or JSON manifest. Files may contain several documents separated by ---.",
+		"",
+		func(value string) tea.Cmd {
+			path := config.ExpandHome(strings.TrimSpace(value))
+			return func() tea.Msg {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return createResultMsg{err: err}
+				}
+				return createSourceMsg{title: path, content: string(data)}
+			}
+		},
+	)
+	prompt.SetValidator(func(value string) error {
+		info, err := os.Stat(config.ExpandHome(strings.TrimSpace(value)))
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return fmt.Errorf("%s is a directory", value)
+		}
+		return nil
+	})
+	return components.NavigateMsg{
+		NewScreen:  prompt,
+		Breadcrumb: "File",
+	}
+}
+
+func (c *resourceCreateModel) CapturingInput() bool {
+	return true
+}
+
+func (c *resourceCreateModel) Init() tea.Cmd {
+	if c.picker != nil {
+		return c.picker.Init()
+	}
+	return nil
+}
+
+func (c *resourceCreateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case createSourceMsg:
+		c.title = msg.title
+		c.content = msg.content
+		c.results = nil
+		c.err = nil
+		return c, c.openEditor()
+
+	case createResultMsg:
+		c.status = ""
+		c.results = msg.results
+		c.err = msg.err
+		return c, nil
+
+	case components.SaveMsg:
+		c.editor = nil
+		c.content = msg.Content
+		return c, c.create()
+
+	case components.CancelMsg:
+		c.editor = nil
+		return c, nil
+
+	case tea.KeyMsg:
+		if c.editor != nil {
+			break
+		}
+		if c.status != "" {
+			return c, nil
+		}
+		if c.results == nil && c.err == nil {
+			switch msg.String() {
+			case "esc", "q":
+				return c, func() tea.Msg { return components.BackMsg{} }
+			}
+			break
+		}
+		switch msg.String() {
+		case "e":
+			if content := c.retryContent(); content != "" {
+				c.content = content
+				c.results = nil
+				c.err = nil
+				return c, c.openEditor()
+			}
+		case "esc", "q", "enter":
+			return c, func() tea.Msg { return components.BackMsg{} }
+		}
+		return c, nil
+	}
+
+	if c.editor != nil {
+		updatedModel, cmd := c.editor.Update(msg)
+		if editor, ok := updatedModel.(*components.YAMLEditor); ok {
+			c.editor = editor
+		}
+		return c, cmd
+	}
+	if c.picker != nil && c.status == "" && c.results == nil && c.err == nil {
+		_, cmd := c.picker.Update(msg)
+		return c, cmd
+	}
+	return c, nil
+}
+
+func (c *resourceCreateModel) openEditor() tea.Cmd {
+	c.editor = components.NewYAMLEditor("Create: "+c.title, c.content)
+	return c.editor.Init()
+}
+
+func (c *resourceCreateModel) create() tea.Cmd {
+	c.status = "Creating..."
+	c.results = nil
+	c.err = nil
+	ctx := c.requestContext()
+	client := *c.k8sClient
+	namespace, content := c.namespace, c.content
+	return func() tea.Msg {
+		results, err := k8s.CreateManifests(ctx, client, namespace, content)
+		return createResultMsg{results: results, err: err}
+	}
+}
+
+func (c *resourceCreateModel) failed() []k8s.CreateResult {
+	failed := []k8s.CreateResult{}
+	for _, result := range c.results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+func (c *resourceCreateModel) retryContent() string {
+	if c.err != nil {
+		return c.content
+	}
+	objects := []*unstructured.Unstructured{}
+	for _, result := range c.failed() {
+		objects = append(objects, result.Object)
+	}
+	if len(objects) == 0 {
+		return ""
+	}
+	content, err := k8s.ManifestsYAML(objects)
+	if err != nil {
+		c.err = err
+		return ""
+	}
+	return content
+}
+
+func (c *resourceCreateModel) View() string {
+	if c.editor != nil {
+		return c.editor.View()
+	}
+	if c.status == "" && c.results == nil && c.err == nil && c.picker != nil {
+		return c.picker.View()
+	}
+
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.ErrorColor)).
+		Background(background)
+
+	lines := []string{customstyles.TitleStyle().Render("Create: " + c.title), ""}
+	switch {
+	case c.status != "":
+		lines = append(lines, textStyle.Render(c.status))
+	case c.err != nil:
+		help := "esc: Close"
+		if c.content != "" {
+			help = "e: Keep editing • " + help
+		}
+		lines = append(lines, errorStyle.Render(c.err.Error()), "", helpStyle.Render(help))
+	default:
+		failed := len(c.failed())
+		lines = append(lines, textStyle.Render(fmt.Sprintf("Created %d of %d objects:", len(c.results)-failed, len(c.results))), "")
+		for _, result := range c.results {
+			if result.Err != nil {
+				lines = append(lines, errorStyle.Render("  ✗ "+result.String()+": "+result.Err.Error()))
+			} else {
+				lines = append(lines, textStyle.Render("  ✓ "+result.String()))
+			}
+		}
+		help := "esc: Close"
+		if failed > 0 {
+			help = "e: Edit and retry failed objects • " + help
+		}
+		lines = append(lines, "", helpStyle.Render(help))
+	}
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
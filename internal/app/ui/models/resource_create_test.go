@@ -0,0 +1,93 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResourceCreateFromTemplates(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	templatesDir := filepath.Join(home, ".config", "k8s-tui", "templates")
+	os.MkdirAll(templatesDir, 0755)
+	os.WriteFile(filepath.Join(templatesDir, "stack.yaml"), []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: {{namespace}}
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: gadget
+`), 0644)
+
+	clientset := fake.NewSimpleClientset()
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "configmaps", Namespaced: true, Kind: "ConfigMap"}},
+	}}
+	client := k8s.Client{
+		Clientset: clientset,
+		Dynamic:   dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		Namespace: "team-a",
+	}
+
+	model, err := NewResourceCreate(client, "team-a").InitComponent(&client)
+	if err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	creator := model.(*resourceCreateModel)
+	items := creator.picker.List.Items()
+	if first := items[0].(components.ListItem).Title(); first != "Deployment" {
+		t.Errorf("Expected built-in templates first, got %s", first)
+	}
+	if last := items[len(items)-1].(components.ListItem).Title(); last != createFromFileItem {
+		t.Errorf("Expected the local file entry last, got %s", last)
+	}
+	if !strings.Contains(creator.sources["Deployment"], "namespace: team-a") {
+		t.Error("Expected built-in templates to be prefilled with the current namespace")
+	}
+
+	creator.Update(createSourceMsg{title: "Template: stack", content: creator.sources["Template: stack"]})
+	if creator.editor == nil {
+		t.Fatal("Expected the template to open in the editor")
+	}
+
+	_, cmd := creator.Update(components.SaveMsg{Content: creator.content})
+	if creator.status == "" || cmd == nil {
+		t.Fatal("Expected saving to start creating the objects")
+	}
+	creator.Update(cmd())
+
+	if len(creator.results) != 2 {
+		t.Fatalf("Expected 2 per-object results, got %d (%v)", len(creator.results), creator.err)
+	}
+	if creator.results[0].Err != nil || creator.results[1].Err == nil {
+		t.Errorf("Expected the configmap to succeed and the widget to fail, got %v", creator.results)
+	}
+	view := creator.View()
+	if !strings.Contains(view, "Created 1 of 2 objects") {
+		t.Errorf("Expected a summary of the results, got:\n%s", view)
+	}
+
+	creator.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if creator.editor == nil {
+		t.Fatal("Expected e to reopen the editor with the failed objects")
+	}
+	if strings.Contains(creator.content, "settings") || !strings.Contains(creator.content, "gadget") {
+		t.Errorf("Expected only the failed object to be retried, got:\n%s", creator.content)
+	}
+}
@@ -0,0 +1,315 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type resourceEditResultMsg struct {
+	err error
+}
+
+type resourceDryRunMsg struct {
+	diff string
+	err  error
+}
+
+type resourceEditedMsg struct {
+	resourceType k8s.ResourceType
+	namespace    string
+	name         string
+}
+
+func (m resourceEditedMsg) matches(resourceType k8s.ResourceType, namespace, name string) bool {
+	return m.resourceType == resourceType && m.namespace == namespace && m.name == name
+}
+
+type resourceEditModel struct {
+	screenLoader
+	k8sClient    *k8s.Client
+	resourceType k8s.ResourceType
+	namespace    string
+	name         string
+	editor       *components.YAMLEditor
+	preview      *components.YAMLViewer
+	loaded       string
+	content      string
+	force        bool
+	status       string
+	conflict     bool
+	err          error
+}
+
+func NewResourceEdit(k k8s.Client, resourceType k8s.ResourceType, namespace, name string) *resourceEditModel {
+	return &resourceEditModel{
+		k8sClient:    &k,
+		resourceType: resourceType,
+		namespace:    namespace,
+		name:         name,
+	}
+}
+
+func EditResourceCmd(k k8s.Client, resourceType k8s.ResourceType, namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		editor, err := NewResourceEdit(k, resourceType, namespace, name).InitComponent(&k)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		return components.NavigateMsg{
+			NewScreen:  editor,
+			Breadcrumb: "Edit",
+		}
+	}
+}
+
+func (e *resourceEditModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	e.k8sClient = k
+	return e, nil
+}
+
+func (e *resourceEditModel) reload() tea.Cmd {
+	client := *e.k8sClient
+	resourceType, namespace, name := e.resourceType, e.namespace, e.name
+	return load(&e.screenLoader, func(ctx context.Context) (string, error) {
+		return k8s.GetResourceYAML(ctx, client, resourceType, namespace, name)
+	})
+}
+
+func (e *resourceEditModel) pending() bool {
+	return e.loaded == "" && e.editor == nil && e.preview == nil
+}
+
+func (e *resourceEditModel) openEditor() {
+	e.preview = nil
+	e.force = false
+	e.editor = components.NewYAMLEditor(fmt.Sprintf("%s: %s", e.resourceType, e.name), e.content)
+}
+
+func (e *resourceEditModel) CapturingInput() bool {
+	return true
+}
+
+func (e *resourceEditModel) Init() tea.Cmd {
+	if e.editor != nil {
+		return e.editor.Init()
+	}
+	if e.pending() {
+		return e.reload()
+	}
+	return nil
+}
+
+func (e *resourceEditModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(loadedMsg[string]); ok {
+		if !loaded(&e.screenLoader, msg) {
+			return e, nil
+		}
+		e.status = ""
+		if msg.err != nil {
+			if !e.pending() {
+				e.err = msg.err
+			}
+			return e, nil
+		}
+		e.loaded = msg.value
+		e.content = msg.value
+		e.force = false
+		e.conflict = false
+		e.err = nil
+		e.openEditor()
+		return e, e.editor.Init()
+	}
+	if e.pending() {
+		return e, e.updatePending(msg, e.reload)
+	}
+	if cmd, ok := e.tick(msg); ok {
+		return e, cmd
+	}
+
+	switch msg := msg.(type) {
+	case resourceDryRunMsg:
+		e.status = ""
+		if msg.err != nil {
+			e.conflict = k8s.IsEditConflict(msg.err)
+			e.err = msg.err
+			return e, nil
+		}
+		e.preview = components.NewDiffViewer(
+			fmt.Sprintf("Dry-run %s: %s", e.resourceType, e.name),
+			msg.diff,
+			"↑/↓: Scroll • /: Search • y: Apply • e: Keep editing • esc: Cancel",
+		)
+		return e, e.preview.Init()
+
+	case resourceEditResultMsg:
+		e.status = ""
+		if msg.err == nil {
+			edited := resourceEditedMsg{resourceType: e.resourceType, namespace: e.namespace, name: e.name}
+			return e, tea.Sequence(
+				func() tea.Msg { return components.BackMsg{} },
+				func() tea.Msg { return edited },
+			)
+		}
+		e.conflict = k8s.IsEditConflict(msg.err)
+		e.err = msg.err
+		return e, nil
+
+	case components.SaveMsg:
+		e.editor = nil
+		e.content = msg.Content
+		if e.content == e.loaded {
+			return e, func() tea.Msg { return components.BackMsg{} }
+		}
+		return e, e.dryRun()
+
+	case components.CancelMsg:
+		return e, func() tea.Msg { return components.BackMsg{} }
+
+	case tea.KeyMsg:
+		if e.editor != nil {
+			break
+		}
+		if e.status != "" {
+			return e, nil
+		}
+		if e.preview != nil {
+			if e.preview.CapturingInput() {
+				break
+			}
+			switch msg.String() {
+			case "y":
+				e.preview = nil
+				return e, e.apply()
+			case "e":
+				e.openEditor()
+				return e, e.editor.Init()
+			case "esc", "q":
+				return e, func() tea.Msg { return components.BackMsg{} }
+			}
+			break
+		}
+		switch msg.String() {
+		case "esc", "q":
+			return e, func() tea.Msg { return components.BackMsg{} }
+		case "r":
+			if e.loading {
+				return e, nil
+			}
+			e.status = "Reloading..."
+			return e, e.reload()
+		case "e":
+			e.err = nil
+			e.conflict = false
+			e.openEditor()
+			return e, e.editor.Init()
+		case "f":
+			if e.conflict {
+				e.force = true
+				return e, e.dryRun()
+			}
+		}
+		return e, nil
+	}
+
+	if e.editor != nil {
+		updatedModel, cmd := e.editor.Update(msg)
+		if editor, ok := updatedModel.(*components.YAMLEditor); ok {
+			e.editor = editor
+		}
+		return e, cmd
+	}
+	if e.preview != nil {
+		updatedModel, cmd := e.preview.Update(msg)
+		if viewer, ok := updatedModel.(*components.YAMLViewer); ok {
+			e.preview = viewer
+		}
+		return e, cmd
+	}
+	return e, nil
+}
+
+func (e *resourceEditModel) dryRun() tea.Cmd {
+	e.status = "Running server-side dry-run..."
+	e.err = nil
+	e.conflict = false
+	ctx := e.requestContext()
+	client := *e.k8sClient
+	resourceType, namespace, name, content, force := e.resourceType, e.namespace, e.name, e.content, e.force
+	return func() tea.Msg {
+		diff, err := k8s.DryRunResourceYAML(ctx, client, resourceType, namespace, name, content, force)
+		return resourceDryRunMsg{diff: diff, err: err}
+	}
+}
+
+func (e *resourceEditModel) apply() tea.Cmd {
+	e.status = "Applying..."
+	e.err = nil
+	e.conflict = false
+	ctx := e.requestContext()
+	client := *e.k8sClient
+	resourceType, namespace, name, content, force := e.resourceType, e.namespace, e.name, e.content, e.force
+	return func() tea.Msg {
+		return resourceEditResultMsg{err: k8s.ApplyResourceYAML(ctx, client, resourceType, namespace, name, content, force)}
+	}
+}
+
+func (e *resourceEditModel) View() string {
+	if e.pending() {
+		return e.pendingView(fmt.Sprintf("%s %s", e.resourceType, e.name))
+	}
+	if e.editor != nil {
+		return e.editor.View()
+	}
+	if e.preview != nil {
+		return e.preview.View()
+	}
+
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.ErrorColor)).
+		Background(background)
+
+	lines := []string{customstyles.TitleStyle().Render(fmt.Sprintf("Edit %s %s", e.resourceType, e.name)), ""}
+	switch {
+	case e.status != "":
+		lines = append(lines, textStyle.Render(e.status))
+	case e.conflict:
+		lines = append(lines,
+			errorStyle.Render("Object changed: "+e.err.Error()+"."),
+			"",
+			textStyle.Render("Reload to start over from the latest version, or force to overwrite it with your changes."),
+			"",
+			helpStyle.Render("r: Reload (discards your changes) • f: Force apply • e: Keep editing • esc: Cancel"),
+		)
+	case e.err != nil:
+		lines = append(lines,
+			errorStyle.Render(e.err.Error()),
+			"",
+			helpStyle.Render("e: Keep editing • r: Reload (discards your changes) • esc: Cancel"),
+		)
+	}
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
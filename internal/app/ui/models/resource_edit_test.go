@@ -0,0 +1,112 @@
+package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestResourceEditConflictAndForce(t *testing.T) {
+	t.Setenv("EDITOR", "vi")
+	clientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default", ResourceVersion: "1"},
+		Data:       map[string]string{"mode": "fast"},
+	})
+	client := k8s.Client{Clientset: clientset}
+
+	model := NewResourceEdit(client, k8s.ResourceTypeConfigMap, "default", "settings")
+	if _, err := model.InitComponent(&client); err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	runLoad(model, model.Init())
+	if !strings.Contains(model.loaded, "mode: fast") {
+		t.Fatalf("Expected the object YAML to be loaded, got:\n%s", model.loaded)
+	}
+
+	edited := strings.Replace(model.loaded, "mode: fast", "mode: slow", 1)
+	edited = strings.Replace(edited, `resourceVersion: "1"`, `resourceVersion: "0"`, 1)
+	clientset.PrependReactor("update", "configmaps", ignoreDryRun)
+	clientset.PrependReactor("update", "configmaps", conflictOnResourceVersion("0"))
+
+	_, cmd := model.Update(components.SaveMsg{Content: edited})
+	if cmd == nil {
+		t.Fatal("Expected a dry-run command")
+	}
+	model.Update(cmd())
+	if !model.conflict {
+		t.Fatalf("Expected the dry-run to surface a conflict prompt, got error %v", model.err)
+	}
+	if view := model.View(); !strings.Contains(view, "Object changed") || !strings.Contains(view, "f: Force") {
+		t.Error("Expected the conflict prompt to offer reload or force")
+	}
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if cmd == nil {
+		t.Fatal("Expected a forced dry-run command")
+	}
+	model.Update(cmd())
+	if model.preview == nil {
+		t.Fatalf("Expected a diff preview after the dry-run, got error %v", model.err)
+	}
+	if diff := model.preview.GetOriginalContent(); !strings.Contains(diff, "-  mode: fast") || !strings.Contains(diff, "+  mode: slow") {
+		t.Errorf("Expected the preview to show the change, got:\n%s", diff)
+	}
+	cm, _ := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "settings", metav1.GetOptions{})
+	if cm.Data["mode"] != "fast" {
+		t.Fatalf("Expected nothing to be persisted before confirming, got %v", cm.Data)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	_, cmd = model.Update(components.SaveMsg{Content: edited})
+	model.Update(cmd())
+	if !model.conflict {
+		t.Fatal("Expected force to be dropped once the editor is reopened")
+	}
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	model.Update(cmd())
+	if model.preview == nil {
+		t.Fatalf("Expected a diff preview after forcing again, got error %v", model.err)
+	}
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatal("Expected an apply command after confirming")
+	}
+	if _, cmd = model.Update(cmd()); cmd == nil || model.err != nil {
+		t.Fatalf("Expected navigation after forcing, got error %v", model.err)
+	}
+	cm, _ = clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "settings", metav1.GetOptions{})
+	if cm.Data["mode"] != "slow" {
+		t.Errorf("Expected the forced edit to be applied, got %v", cm.Data)
+	}
+}
+
+func conflictOnResourceVersion(stale string) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		obj := action.(k8stesting.UpdateAction).GetObject().(metav1.Object)
+		if obj.GetResourceVersion() == stale {
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: action.GetResource().Resource}, obj.GetName(), nil)
+		}
+		return false, nil, nil
+	}
+}
+
+func ignoreDryRun(action k8stesting.Action) (bool, runtime.Object, error) {
+	update := action.(k8stesting.UpdateActionImpl)
+	if len(update.UpdateOptions.DryRun) > 0 {
+		return true, update.GetObject(), nil
+	}
+	return false, nil, nil
+}
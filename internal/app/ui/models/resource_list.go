@@ -244,3 +244,16 @@ func NewResourceList(k k8s.Client, namespace, resourceType string) ResourceList
 func (rl ResourceList) InitComponent(k k8s.Client) (tea.Model, error) {
 	return resourceFactory.CreateResource(rl.resourceType, rl.kube, rl.namespace)
 }
+
+func resourceScreen(k k8s.Client, namespace, resourceType string) (tea.Model, string, error) {
+	switch resourceType {
+	case "ResourceList":
+		return NewResource(k, namespace).InitComponent(k), "Resource List", nil
+	case "PortForwards":
+		forwards, err := NewPortForwards(k).InitComponent(&k)
+		return forwards, "Port Forwards", err
+	}
+
+	resourceList, err := NewResourceList(k, namespace, resourceType).InitComponent(k)
+	return resourceList, resourceType, err
+}
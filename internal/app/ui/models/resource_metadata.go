@@ -0,0 +1,469 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type metadataTarget struct {
+	namespace string
+	name      string
+}
+
+func (t metadataTarget) String() string {
+	if t.namespace == "" {
+		return t.name
+	}
+	return t.namespace + "/" + t.name
+}
+
+type metadataFailure struct {
+	target metadataTarget
+	err    error
+}
+
+type metadataResultMsg struct {
+	failures []metadataFailure
+}
+
+type commonMetadata struct {
+	labels      map[string]string
+	annotations map[string]string
+}
+
+type metadataRow struct {
+	annotation bool
+	key        textinput.Model
+	value      textinput.Model
+}
+
+func (r *metadataRow) blank() bool {
+	return strings.TrimSpace(r.key.Value()) == "" && strings.TrimSpace(r.value.Value()) == ""
+}
+
+type resourceMetadataModel struct {
+	screenLoader
+	k8sClient    *k8s.Client
+	resourceType k8s.ResourceType
+	targets      []metadataTarget
+	total        int
+	labels       map[string]string
+	annotations  map[string]string
+	rows         []*metadataRow
+	row          int
+	col          int
+	ready        bool
+	applying     bool
+	err          error
+	failures     []metadataFailure
+	onApplied    func()
+}
+
+func NewResourceMetadataEdit(k k8s.Client, resourceType k8s.ResourceType, resources []types.ResourceData) *resourceMetadataModel {
+	targets := make([]metadataTarget, 0, len(resources))
+	for _, resource := range resources {
+		targets = append(targets, metadataTarget{namespace: resource.GetNamespace(), name: resource.GetName()})
+	}
+	return &resourceMetadataModel{
+		k8sClient:    &k,
+		resourceType: resourceType,
+		targets:      targets,
+		total:        len(targets),
+	}
+}
+
+func editMetadataCmd(k k8s.Client, resourceType k8s.ResourceType, resources []types.ResourceData, onApplied func()) tea.Cmd {
+	return func() tea.Msg {
+		model := NewResourceMetadataEdit(k, resourceType, resources)
+		model.onApplied = onApplied
+		editor, err := model.InitComponent(&k)
+		if err != nil {
+			return components.NavigateMsg{
+				Error:   err,
+				Cluster: k,
+			}
+		}
+		return components.NavigateMsg{
+			NewScreen:  editor,
+			Breadcrumb: "Labels",
+		}
+	}
+}
+
+func (e *resourceMetadataModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	e.k8sClient = k
+	if len(e.targets) == 0 {
+		return nil, fmt.Errorf("no %s selected", e.resourceType)
+	}
+
+	e.labels, e.annotations = nil, nil
+	if k8s.IsCustomResourceType(e.resourceType) {
+		e.reset()
+		e.ready = true
+	}
+	return e, nil
+}
+
+func (e *resourceMetadataModel) fetch() tea.Cmd {
+	client := *e.k8sClient
+	resourceType := e.resourceType
+	targets := e.targets
+	return load(&e.screenLoader, func(ctx context.Context) (commonMetadata, error) {
+		var common commonMetadata
+		for i, target := range targets {
+			labels, annotations, err := k8s.GetResourceMetadata(ctx, client, resourceType, target.namespace, target.name)
+			if err != nil {
+				return common, err
+			}
+			if i == 0 {
+				common.labels, common.annotations = copyStringMap(labels), copyStringMap(annotations)
+				continue
+			}
+			intersectStringMap(common.labels, labels)
+			intersectStringMap(common.annotations, annotations)
+		}
+		return common, nil
+	})
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	copied := make(map[string]string, len(m))
+	for key, value := range m {
+		copied[key] = value
+	}
+	return copied
+}
+
+func intersectStringMap(common, other map[string]string) {
+	for key, value := range common {
+		if otherValue, ok := other[key]; !ok || otherValue != value {
+			delete(common, key)
+		}
+	}
+}
+
+func (e *resourceMetadataModel) reset() {
+	e.rows = nil
+	for _, key := range sortedKeys(e.labels) {
+		e.rows = append(e.rows, e.newRow(false, key, e.labels[key]))
+	}
+	for _, key := range sortedKeys(e.annotations) {
+		e.rows = append(e.rows, e.newRow(true, key, e.annotations[key]))
+	}
+	e.row, e.col = 0, 0
+}
+
+func (e *resourceMetadataModel) newRow(annotation bool, key, value string) *metadataRow {
+	newInput := func(v string) textinput.Model {
+		input := textinput.New()
+		input.Prompt = ""
+		input.SetValue(v)
+		input.CursorEnd()
+		return input
+	}
+	return &metadataRow{annotation: annotation, key: newInput(key), value: newInput(value)}
+}
+
+func (e *resourceMetadataModel) CapturingInput() bool {
+	return true
+}
+
+func (e *resourceMetadataModel) Init() tea.Cmd {
+	if !e.ready {
+		return e.fetch()
+	}
+	return e.setFocus(e.row, e.col)
+}
+
+func (e *resourceMetadataModel) setFocus(row, col int) tea.Cmd {
+	for _, r := range e.rows {
+		r.key.Blur()
+		r.value.Blur()
+	}
+	if len(e.rows) == 0 {
+		e.row, e.col = 0, 0
+		return nil
+	}
+	e.row = (row + len(e.rows)) % len(e.rows)
+	e.col = min(max(col, 0), 1)
+	if e.col == 0 {
+		return e.rows[e.row].key.Focus()
+	}
+	return e.rows[e.row].value.Focus()
+}
+
+func (e *resourceMetadataModel) moveField(delta int) tea.Cmd {
+	if len(e.rows) == 0 {
+		return nil
+	}
+	row, col := e.row, e.col+delta
+	if col > 1 {
+		row, col = row+1, 0
+	} else if col < 0 {
+		row, col = row-1, 1
+	}
+	return e.setFocus(row, col)
+}
+
+func (e *resourceMetadataModel) addRow(annotation bool) tea.Cmd {
+	row := e.newRow(annotation, "", "")
+	idx := len(e.rows)
+	if !annotation {
+		idx = 0
+		for idx < len(e.rows) && !e.rows[idx].annotation {
+			idx++
+		}
+	}
+	e.rows = append(e.rows[:idx], append([]*metadataRow{row}, e.rows[idx:]...)...)
+	return e.setFocus(idx, 0)
+}
+
+func (e *resourceMetadataModel) deleteRow() tea.Cmd {
+	if len(e.rows) == 0 {
+		return nil
+	}
+	e.rows = append(e.rows[:e.row], e.rows[e.row+1:]...)
+	return e.setFocus(min(e.row, len(e.rows)-1), e.col)
+}
+
+func (e *resourceMetadataModel) changes() (k8s.MetadataChanges, error) {
+	changes := k8s.MetadataChanges{Labels: map[string]*string{}, Annotations: map[string]*string{}}
+	seen := map[bool]map[string]bool{false: {}, true: {}}
+	for _, row := range e.rows {
+		if row.blank() {
+			continue
+		}
+		kind, original, target := "label", e.labels, changes.Labels
+		if row.annotation {
+			kind, original, target = "annotation", e.annotations, changes.Annotations
+		}
+
+		key := strings.TrimSpace(row.key.Value())
+		value := row.value.Value()
+		if !row.annotation {
+			value = strings.TrimSpace(value)
+		}
+		remove := strings.HasSuffix(key, "-")
+		key = strings.TrimSuffix(key, "-")
+		if key == "" {
+			return changes, fmt.Errorf("%s key must not be empty", kind)
+		}
+		if seen[row.annotation][key] {
+			return changes, fmt.Errorf("duplicate %s %s", kind, key)
+		}
+		seen[row.annotation][key] = true
+
+		if remove {
+			target[key] = nil
+			continue
+		}
+		if previous, ok := original[key]; ok && previous == value {
+			continue
+		}
+		target[key] = &value
+	}
+	for key := range e.labels {
+		if !seen[false][key] {
+			changes.Labels[key] = nil
+		}
+	}
+	for key := range e.annotations {
+		if !seen[true][key] {
+			changes.Annotations[key] = nil
+		}
+	}
+	return changes, k8s.ValidateMetadataChanges(changes)
+}
+
+func (e *resourceMetadataModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(loadedMsg[commonMetadata]); ok {
+		if !loaded(&e.screenLoader, msg) || msg.err != nil {
+			return e, nil
+		}
+		e.labels, e.annotations = msg.value.labels, msg.value.annotations
+		e.reset()
+		e.ready = true
+		return e, e.setFocus(0, 0)
+	}
+	if !e.ready {
+		return e, e.updatePending(msg, e.fetch)
+	}
+
+	switch msg := msg.(type) {
+	case metadataResultMsg:
+		e.applying = false
+		if len(msg.failures) == 0 {
+			if e.onApplied != nil {
+				e.onApplied()
+			}
+			return e, func() tea.Msg { return components.BackMsg{} }
+		}
+		e.failures = msg.failures
+		e.targets = nil
+		for _, failure := range msg.failures {
+			e.targets = append(e.targets, failure.target)
+		}
+		return e, nil
+
+	case tea.KeyMsg:
+		if e.applying {
+			return e, nil
+		}
+		switch msg.String() {
+		case "esc", "ctrl+c":
+			return e, func() tea.Msg { return components.BackMsg{} }
+		case "up":
+			return e, e.setFocus(e.row-1, e.col)
+		case "down":
+			return e, e.setFocus(e.row+1, e.col)
+		case "tab":
+			return e, e.moveField(1)
+		case "shift+tab":
+			return e, e.moveField(-1)
+		case "ctrl+l":
+			return e, e.addRow(false)
+		case "ctrl+n":
+			return e, e.addRow(true)
+		case "ctrl+d":
+			return e, e.deleteRow()
+		case "ctrl+r":
+			e.reset()
+			e.err = nil
+			return e, e.setFocus(0, 0)
+		case "enter":
+			return e, e.apply()
+		}
+	}
+
+	if len(e.rows) == 0 {
+		return e, nil
+	}
+	var cmd tea.Cmd
+	row := e.rows[e.row]
+	if e.col == 0 {
+		row.key, cmd = row.key.Update(msg)
+	} else {
+		row.value, cmd = row.value.Update(msg)
+	}
+	return e, cmd
+}
+
+func (e *resourceMetadataModel) apply() tea.Cmd {
+	changes, err := e.changes()
+	if err != nil {
+		e.err = err
+		return nil
+	}
+	if changes.Empty() {
+		e.err = fmt.Errorf("nothing to change")
+		return nil
+	}
+
+	e.applying = true
+	e.err = nil
+	ctx := e.requestContext()
+	client := *e.k8sClient
+	resourceType := e.resourceType
+	targets := e.targets
+	return func() tea.Msg {
+		var failures []metadataFailure
+		for _, target := range targets {
+			if err := k8s.PatchResourceMetadata(ctx, client, resourceType, target.namespace, target.name, changes); err != nil {
+				failures = append(failures, metadataFailure{target: target, err: err})
+			}
+		}
+		return metadataResultMsg{failures: failures}
+	}
+}
+
+func (e *resourceMetadataModel) title() string {
+	if e.total == 1 && len(e.targets) == 1 {
+		return fmt.Sprintf("Labels & annotations: %s/%s", e.resourceType, e.targets[0].name)
+	}
+	return fmt.Sprintf("Labels & annotations: %d %ss", len(e.targets), e.resourceType)
+}
+
+func (e *resourceMetadataModel) View() string {
+	if !e.ready {
+		return e.pendingView("labels and annotations")
+	}
+
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.YAMLKeyColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.ErrorColor)).
+		Background(background)
+
+	lines := []string{customstyles.TitleStyle().Render(e.title())}
+	if len(e.targets) > 1 {
+		names := make([]string, len(e.targets))
+		for i, target := range e.targets {
+			names[i] = target.String()
+		}
+		lines = append(lines, helpStyle.Render("Applies to "+strings.Join(names, ", ")+". Only values shared by all of them are shown."))
+	}
+	if k8s.IsCustomResourceType(e.resourceType) {
+		lines = append(lines, helpStyle.Render("Existing values can't be read for plugin resources. Add keys to set them, or key- to remove them."))
+	}
+
+	section := func(title string, annotation bool) {
+		lines = append(lines, "", keyStyle.Render(title))
+		empty := true
+		for i, row := range e.rows {
+			if row.annotation != annotation {
+				continue
+			}
+			empty = false
+			marker := "  "
+			if i == e.row {
+				marker = "> "
+			}
+			lines = append(lines, textStyle.Render(marker)+textStyle.Render(row.key.View())+textStyle.Render(" = ")+textStyle.Render(row.value.View()))
+		}
+		if empty {
+			lines = append(lines, helpStyle.Render("  <none>"))
+		}
+	}
+	section("Labels (key = value)", false)
+	section("Annotations (key = value)", true)
+
+	if e.applying {
+		lines = append(lines, "", textStyle.Render("Applying..."))
+	}
+	if len(e.failures) > 0 {
+		lines = append(lines, "", errorStyle.Render(fmt.Sprintf("Failed on %d of %d %ss (enter retries them):", len(e.failures), e.total, e.resourceType)))
+		for _, failure := range e.failures {
+			lines = append(lines, errorStyle.Render("  "+failure.target.String()+": "+failure.err.Error()))
+		}
+	}
+	if e.err != nil {
+		lines = append(lines, "", errorStyle.Render(e.err.Error()))
+	}
+	lines = append(lines, "", helpStyle.Render("↑/↓: Row • tab: Field • ctrl+l: Add label • ctrl+n: Add annotation • ctrl+d: Delete • key-: Remove key • ctrl+r: Reset • enter: Apply • esc: Cancel"))
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestResourceMetadataEditBulk(t *testing.T) {
+	configmap := func(name string, labels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+	}
+	clientset := fake.NewSimpleClientset(
+		configmap("a", map[string]string{"tier": "web", "extra": "1"}),
+		configmap("b", map[string]string{"tier": "web"}),
+	)
+	failB := true
+	clientset.PrependReactor("patch", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.PatchAction).GetName() == "b" && failB {
+			return true, nil, fmt.Errorf("admission denied")
+		}
+		return false, nil, nil
+	})
+	client := k8s.Client{Clientset: clientset}
+
+	targets := []types.ResourceData{
+		ConfigMapData{&k8s.Configmap{Name: "a", Namespace: "default"}},
+		ConfigMapData{&k8s.Configmap{Name: "b", Namespace: "default"}},
+	}
+	model := NewResourceMetadataEdit(client, k8s.ResourceTypeConfigMap, targets)
+	applied := 0
+	model.onApplied = func() { applied++ }
+	if _, err := model.InitComponent(&client); err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	runLoad(model, model.Init())
+	if len(model.rows) != 1 || model.rows[0].key.Value() != "tier" {
+		t.Fatalf("Expected only the shared label to be prefilled, got %d rows", len(model.rows))
+	}
+
+	typeText := func(text string) {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	typeText("env")
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText("prod!")
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.err == nil || model.applying {
+		t.Fatal("Expected an invalid label value to be rejected before sending")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatalf("Expected an apply command, got error %v", model.err)
+	}
+	model.Update(cmd())
+	if len(model.failures) != 1 || len(model.targets) != 1 || model.targets[0].name != "b" {
+		t.Fatalf("Expected b to be reported as failed, got %+v", model.failures)
+	}
+	if applied != 0 {
+		t.Error("Expected the selection to be kept while resources still fail")
+	}
+	if view := model.View(); !strings.Contains(view, "default/b") || !strings.Contains(view, "admission denied") {
+		t.Error("Expected the failed resource to be listed in the view")
+	}
+
+	a, _ := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "a", metav1.GetOptions{})
+	if len(a.Labels) != 2 || a.Labels["env"] != "prod" || a.Labels["extra"] != "1" {
+		t.Errorf("Unexpected labels on a: %v", a.Labels)
+	}
+
+	failB = false
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatalf("Expected a retry command, got error %v", model.err)
+	}
+	if _, cmd = model.Update(cmd()); cmd == nil {
+		t.Fatal("Expected navigation back after all resources were updated")
+	}
+	if _, ok := cmd().(components.BackMsg); !ok {
+		t.Error("Expected a BackMsg")
+	}
+	if applied != 1 {
+		t.Errorf("Expected the applied callback once all resources were updated, got %d calls", applied)
+	}
+	b, _ := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "b", metav1.GetOptions{})
+	if len(b.Labels) != 1 || b.Labels["env"] != "prod" {
+		t.Errorf("Unexpected labels on b: %v", b.Labels)
+	}
+}
@@ -1,7 +1,10 @@
 package models
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
@@ -22,6 +25,7 @@ type ResourceConfig struct {
 }
 
 type GenericResourceModel struct {
+	requestScope
 	namespace       string
 	k8sClient       *k8s.Client
 	pluginAPI       plugins.PluginAPI
@@ -52,6 +56,25 @@ func NewGenericResourceModel(k k8s.Client, namespace string, config ResourceConf
 	}
 }
 
+func (g *GenericResourceModel) newTable(onSelect func(selected string) tea.Msg, fetch func(ctx context.Context) (func(), error), rows func() []table.Row) *ui.TableModel {
+	refresh := func() (func() []table.Row, error) {
+		apply, err := fetch(g.requestContext())
+		if err != nil {
+			return nil, err
+		}
+		return func() []table.Row {
+			apply()
+			return rows()
+		}, nil
+	}
+
+	tableModel := ui.NewTable(g.config.Columns, g.config.ColumnWidths, rows(), g.config.Title, onSelect, 1, refresh, nil)
+	tableModel.OnLoading(func(loading bool) {
+		g.loading = loading
+	})
+	return tableModel
+}
+
 func (g *GenericResourceModel) createDeleteAction(tableModel *ui.TableModel) func() tea.Cmd {
 	return func() tea.Cmd {
 		if tableModel == nil {
@@ -76,40 +99,277 @@ func (g *GenericResourceModel) createDeleteAction(tableModel *ui.TableModel) fun
 		}
 
 		tableModel.ClearCheckedItems()
-		tableModel.Refresh()
+		_, cmd := tableModel.Refresh()
+		return cmd
+	}
+}
+
+func (g *GenericResourceModel) createScaleAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		var targets []types.ResourceData
+		for _, idx := range tableModel.GetCheckedItems() {
+			if idx >= 0 && idx < len(g.resourceData) {
+				targets = append(targets, g.resourceData[idx])
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+
+		ctx := g.requestContext()
+		return func() tea.Msg {
+			scale, err := k8s.GetResourceScale(ctx, *g.k8sClient, g.resourceType, targets[0].GetNamespace(), targets[0].GetName())
+			if err != nil {
+				return ui.NavigateMsg{
+					Error:   err,
+					Cluster: *g.k8sClient,
+				}
+			}
+
+			title := fmt.Sprintf("Scale %s/%s", g.resourceType, targets[0].GetName())
+			if len(targets) > 1 {
+				title = fmt.Sprintf("Scale %d %ss", len(targets), g.resourceType)
+			}
+			prompt := ui.NewPrompt(title, "Replica count. +/-: Adjust", strconv.Itoa(int(scale.Spec.Replicas)), func(value string) tea.Cmd {
+				tableModel.ClearCheckedItems()
+				return g.scaleCmd(targets, value)
+			})
+			prompt.SetValidator(func(value string) error {
+				_, err := parseReplicas(value)
+				return err
+			})
+			prompt.SetKeyHandler(stepReplicas)
+			return ui.NavigateMsg{
+				NewScreen:  prompt,
+				Breadcrumb: "Scale",
+			}
+		}
+	}
+}
+
+func (g *GenericResourceModel) createEditAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(g.resourceData) {
+			return nil
+		}
+		resource := g.resourceData[selected]
+		return EditResourceCmd(*g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName())
+	}
+}
+
+func (g *GenericResourceModel) createEditMetadataAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		var targets []types.ResourceData
+		for _, idx := range tableModel.GetCheckedItems() {
+			if idx >= 0 && idx < len(g.resourceData) {
+				targets = append(targets, g.resourceData[idx])
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+		return editMetadataCmd(*g.k8sClient, g.resourceType, targets, tableModel.ClearCheckedItems)
+	}
+}
+
+func (g *GenericResourceModel) scaleCmd(targets []types.ResourceData, value string) tea.Cmd {
+	ctx := g.requestContext()
+	client := *g.k8sClient
+	resourceType := g.resourceType
+	return func() tea.Msg {
+		replicas, err := parseReplicas(value)
+		if err != nil {
+			return ui.NavigateMsg{Error: err, Cluster: client}
+		}
+
+		var failures []string
+		for _, target := range targets {
+			if err := k8s.ScaleResource(ctx, client, resourceType, target.GetNamespace(), target.GetName(), replicas); err != nil {
+				failures = append(failures, err.Error())
+			}
+		}
+		if len(failures) > 0 {
+			return ui.NavigateMsg{
+				Error:   fmt.Errorf("%s", strings.Join(failures, "\n")),
+				Cluster: client,
+			}
+		}
 		return nil
 	}
 }
 
+func parseReplicas(value string) (int32, error) {
+	replicas, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+	if err != nil || replicas < 0 {
+		return 0, fmt.Errorf("replicas must be a non-negative number")
+	}
+	return int32(replicas), nil
+}
+
+func stepReplicas(key, value string) (string, bool) {
+	var delta int32
+	switch key {
+	case "+", "=":
+		delta = 1
+	case "-":
+		delta = -1
+	default:
+		return value, false
+	}
+	replicas, err := parseReplicas(value)
+	if err != nil {
+		replicas = 0
+	}
+	return strconv.Itoa(int(max(replicas+delta, 0))), true
+}
+
+func (g *GenericResourceModel) createRolloutAction(tableModel *ui.TableModel, action string, trigger func(ctx context.Context, client k8s.Client, namespace, name string) error) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(g.resourceData) {
+			return nil
+		}
+
+		resource := g.resourceData[selected]
+		ctx := g.requestContext()
+		client := *g.k8sClient
+		resourceType := g.resourceType
+
+		return func() tea.Msg {
+			if err := trigger(ctx, client, resource.GetNamespace(), resource.GetName()); err != nil {
+				return ui.NavigateMsg{
+					Error:   err,
+					Cluster: client,
+				}
+			}
+			return ui.NavigateMsg{
+				NewScreen:  NewRolloutStatus(client, resourceType, resource.GetNamespace(), resource.GetName(), action),
+				Breadcrumb: "Rollout",
+			}
+		}
+	}
+}
+
+func (g *GenericResourceModel) createRestartAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return g.createRolloutAction(tableModel, "restart", func(ctx context.Context, client k8s.Client, namespace, name string) error {
+		return k8s.RestartResource(ctx, client, g.resourceType, namespace, name)
+	})
+}
+
+func (g *GenericResourceModel) createSetImagesAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(g.resourceData) {
+			return nil
+		}
+
+		resource := g.resourceData[selected]
+
+		return func() tea.Msg {
+			images, err := NewSetImages(*g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName()).InitComponent(g.k8sClient)
+			if err != nil {
+				return ui.NavigateMsg{
+					Error:   err,
+					Cluster: *g.k8sClient,
+				}
+			}
+			return ui.NavigateMsg{
+				NewScreen:  images,
+				Breadcrumb: "Images",
+			}
+		}
+	}
+}
+
+func (g *GenericResourceModel) createWorkloadLogsAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(g.resourceData) {
+			return nil
+		}
+
+		resource := g.resourceData[selected]
+
+		ctx := g.requestContext()
+		return func() tea.Msg {
+			selector, err := k8s.GetResourceLabelSelector(ctx, *g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName())
+			if err != nil {
+				return ui.NavigateMsg{
+					Error:   err,
+					Cluster: *g.k8sClient,
+				}
+			}
+
+			logs, err := NewWorkloadLogs(*g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName(), selector).InitComponent(g.k8sClient)
+			if err != nil {
+				return ui.NavigateMsg{
+					Error:   err,
+					Cluster: *g.k8sClient,
+				}
+			}
+
+			return ui.NavigateMsg{
+				NewScreen:  logs,
+				Breadcrumb: "Logs",
+			}
+		}
+	}
+}
+
 func (g *GenericResourceModel) deleteResource(resource types.ResourceData) error {
 	var err error
 	switch g.resourceType {
 	case k8s.ResourceTypePod:
-		err = g.pluginAPI.DeletePod(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeletePod(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeService:
-		err = g.pluginAPI.DeleteService(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteService(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeDeployment:
-		err = g.pluginAPI.DeleteDeployment(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteDeployment(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeConfigMap:
-		err = g.pluginAPI.DeleteConfigMap(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteConfigMap(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeSecret:
-		err = g.pluginAPI.DeleteSecret(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteSecret(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeIngress:
-		err = g.pluginAPI.DeleteIngress(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteIngress(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeJob:
-		err = g.pluginAPI.DeleteJob(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteJob(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeCronJob:
-		err = g.pluginAPI.DeleteCronJob(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteCronJob(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeDaemonSet:
-		err = g.pluginAPI.DeleteDaemonSet(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteDaemonSet(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeStatefulSet:
-		err = g.pluginAPI.DeleteStatefulSet(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteStatefulSet(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeReplicaSet:
-		err = g.pluginAPI.DeleteReplicaSet(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteReplicaSet(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	case k8s.ResourceTypeServiceAccount:
-		err = g.pluginAPI.DeleteServiceAccount(resource.GetNamespace(), resource.GetName())
+		err = g.pluginAPI.DeleteServiceAccount(g.requestContext(), resource.GetNamespace(), resource.GetName())
 	default:
-		err = k8s.DeleteResource(*g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName())
+		err = k8s.DeleteResource(g.requestContext(), *g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName())
 	}
 	if err != nil {
 		return fmt.Errorf("failed to delete resource %s/%s: %v", resource.GetNamespace(), resource.GetName(), err)
@@ -118,7 +378,7 @@ func (g *GenericResourceModel) deleteResource(resource types.ResourceData) error
 }
 
 func (g *GenericResourceModel) deleteResourceK8s(resource types.ResourceData) error {
-	err := k8s.DeleteResource(*g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName())
+	err := k8s.DeleteResource(g.requestContext(), *g.k8sClient, g.resourceType, resource.GetNamespace(), resource.GetName())
 	if err != nil {
 		return fmt.Errorf("failed to delete resource %s/%s: %v", resource.GetNamespace(), resource.GetName(), err)
 	}
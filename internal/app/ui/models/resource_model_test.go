@@ -1,12 +1,19 @@
 package models
 
 import (
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
 	"testing"
 	"time"
 
 	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestNewGenericResourceModel(t *testing.T) {
@@ -215,3 +222,69 @@ func (m *mockResourceData) GetNamespace() string {
 func (m *mockResourceData) GetColumns() table.Row {
 	return table.Row{m.name, m.namespace}
 }
+
+func TestStepReplicas(t *testing.T) {
+	tests := []struct {
+		key, value, expected string
+		handled              bool
+	}{
+		{"+", "2", "3", true},
+		{"=", "2", "3", true},
+		{"-", "2", "1", true},
+		{"-", "0", "0", true},
+		{"+", "", "1", true},
+		{"5", "2", "2", false},
+	}
+
+	for _, tt := range tests {
+		value, handled := stepReplicas(tt.key, tt.value)
+		if value != tt.expected || handled != tt.handled {
+			t.Errorf("stepReplicas(%q, %q) = %q, %v; expected %q, %v", tt.key, tt.value, value, handled, tt.expected, tt.handled)
+		}
+	}
+
+	if _, err := parseReplicas("-1"); err == nil {
+		t.Error("Expected negative replicas to be rejected")
+	}
+	if replicas, err := parseReplicas(" 4 "); err != nil || replicas != 4 {
+		t.Errorf("Expected 4 replicas, got %d (%v)", replicas, err)
+	}
+}
+
+func TestScaleActionKeepsSelectionUntilSubmitted(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("get", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &autoscalingv1.Scale{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: 2},
+		}, nil
+	})
+	client := k8s.Client{Namespace: "default", Clientset: clientset}
+	model := NewGenericResourceModel(client, "default", ResourceConfig{ResourceType: k8s.ResourceTypeDeployment})
+	model.resourceData = []types.ResourceData{
+		&mockResourceData{name: "web", namespace: "default"},
+		&mockResourceData{name: "api", namespace: "default"},
+	}
+	tableModel := components.NewTable([]table.Column{{Title: "Name", Width: 10}}, []float64{1}, []table.Row{{"web"}, {"api"}}, "Deployments", nil, 0, nil, nil)
+	tableModel.Update(tea.KeyMsg{Type: tea.KeySpace})
+	tableModel.Table.SetCursor(1)
+	tableModel.Update(tea.KeyMsg{Type: tea.KeySpace})
+
+	msg, ok := model.createScaleAction(tableModel)()().(components.NavigateMsg)
+	if !ok || msg.NewScreen == nil {
+		t.Fatalf("Expected the replica prompt, got %#v", msg)
+	}
+	if len(tableModel.GetCheckedItems()) != 2 {
+		t.Error("Expected the selection to survive opening the prompt")
+	}
+
+	prompt := msg.NewScreen
+	prompt.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if len(tableModel.GetCheckedItems()) != 2 {
+		t.Error("Expected cancelling the prompt to keep the selection")
+	}
+	prompt.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(tableModel.GetCheckedItems()) != 1 {
+		t.Error("Expected the selection to be cleared once the scale is submitted")
+	}
+}
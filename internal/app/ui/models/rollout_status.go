@@ -0,0 +1,188 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const rolloutPollInterval = time.Second
+
+type rolloutStatusMsg struct {
+	model  *rolloutStatusModel
+	seq    int
+	status *k8s.RolloutStatus
+	err    error
+}
+
+func (msg rolloutStatusMsg) Target() any {
+	return msg.model
+}
+
+type rolloutStatusModel struct {
+	requestScope
+	k8sClient    *k8s.Client
+	resourceType k8s.ResourceType
+	namespace    string
+	name         string
+	action       string
+	started      time.Time
+	finishedAt   time.Time
+	status       *k8s.RolloutStatus
+	err          error
+	polling      bool
+	closed       bool
+	pollSeq      int
+}
+
+func NewRolloutStatus(k k8s.Client, resourceType k8s.ResourceType, namespace, name, action string) *rolloutStatusModel {
+	return &rolloutStatusModel{
+		k8sClient:    &k,
+		resourceType: resourceType,
+		namespace:    namespace,
+		name:         name,
+		action:       action,
+		started:      time.Now(),
+	}
+}
+
+func (r *rolloutStatusModel) Init() tea.Cmd {
+	if (r.polling && !r.closed) || r.finished() {
+		return nil
+	}
+	r.closed = false
+	r.polling = true
+	r.pollSeq++
+	return r.fetch(r.pollSeq)
+}
+
+func (r *rolloutStatusModel) fetch(seq int) tea.Cmd {
+	ctx := r.requestContext()
+	return func() tea.Msg {
+		status, err := k8s.GetRolloutStatus(ctx, *r.k8sClient, r.resourceType, r.namespace, r.name)
+		return rolloutStatusMsg{model: r, seq: seq, status: status, err: err}
+	}
+}
+
+func (r *rolloutStatusModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(rolloutStatusMsg); ok {
+		if msg.model != r || msg.seq != r.pollSeq {
+			return r, nil
+		}
+		r.err = msg.err
+		if msg.status != nil {
+			r.status = msg.status
+		}
+		if r.finished() && r.finishedAt.IsZero() {
+			r.finishedAt = time.Now()
+		}
+		if r.closed || r.finished() {
+			r.polling = false
+			return r, nil
+		}
+		fetch := r.fetch(msg.seq)
+		return r, tea.Tick(rolloutPollInterval, func(time.Time) tea.Msg {
+			return fetch()
+		})
+	}
+	return r, nil
+}
+
+func (r *rolloutStatusModel) finished() bool {
+	return r.status != nil && (r.status.Done || r.status.Failed)
+}
+
+func (r *rolloutStatusModel) Close() {
+	r.closed = true
+	r.requestScope.Close()
+}
+
+func (r *rolloutStatusModel) View() string {
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.YAMLKeyColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+
+	lines := []string{
+		customstyles.TitleStyle().Render(fmt.Sprintf("Rollout %s: %s/%s", r.action, r.resourceType, r.name)),
+		"",
+	}
+
+	field := func(key, value string) string {
+		return keyStyle.Render(fmt.Sprintf("%-20s", key+":")) + textStyle.Render(value)
+	}
+
+	if r.status != nil {
+		s := r.status
+		color := customstyles.WarningColor
+		switch {
+		case s.Failed:
+			color = customstyles.ErrorColor
+		case s.Done:
+			color = customstyles.AccentColor
+		}
+		lines = append(lines,
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(color)).Background(background).Render(s.Message),
+			"",
+			field("Generation", fmt.Sprintf("%d (observed %d)", s.Generation, s.ObservedGeneration)),
+			field("Desired", fmt.Sprint(s.Desired)),
+			field("Updated", fmt.Sprint(s.Updated)),
+			field("Ready", fmt.Sprint(s.Ready)),
+			field("Available", fmt.Sprint(s.Available)),
+		)
+		if s.Paused {
+			lines = append(lines, field("Paused", "true"))
+		}
+		if len(s.Conditions) > 0 {
+			lines = append(lines, "", keyStyle.Render("Conditions:"))
+			for _, c := range s.Conditions {
+				condition := fmt.Sprintf("  %-16s %-6s %s", c.Type, c.Status, c.Reason)
+				if c.Message != "" {
+					condition += " - " + c.Message
+				}
+				lines = append(lines, textStyle.Render(condition))
+			}
+		}
+	} else if r.err == nil {
+		lines = append(lines, textStyle.Render("Fetching rollout status..."))
+	}
+
+	if r.err != nil {
+		lines = append(lines, "", lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.ErrorColor)).
+			Background(background).
+			Render(r.err.Error()))
+	}
+
+	state := "Watching"
+	elapsed := time.Since(r.started)
+	if r.finished() {
+		state = "Finished"
+		elapsed = r.finishedAt.Sub(r.started)
+	}
+	lines = append(lines, "", helpStyle.Render(strings.Join([]string{
+		state,
+		elapsed.Round(time.Second).String() + " elapsed",
+		"q: Back",
+	}, " • ")))
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRolloutStatusStopsWhenFinished(t *testing.T) {
+	client := k8s.Client{Clientset: fake.NewSimpleClientset(), Namespace: "default"}
+	model := NewRolloutStatus(client, k8s.ResourceTypeDeployment, "default", "web", "restart")
+
+	if cmd := model.Init(); cmd == nil {
+		t.Fatal("Expected the status panel to start polling")
+	}
+	if cmd := model.Init(); cmd != nil {
+		t.Error("Expected a single polling loop")
+	}
+
+	_, cmd := model.Update(rolloutStatusMsg{model: model, seq: model.pollSeq, status: &k8s.RolloutStatus{Message: "Waiting for rollout to finish"}})
+	if cmd == nil {
+		t.Error("Expected polling to continue while the rollout is in progress")
+	}
+
+	_, cmd = model.Update(rolloutStatusMsg{model: model, seq: model.pollSeq, status: &k8s.RolloutStatus{Message: "successfully rolled out", Done: true}})
+	if cmd != nil {
+		t.Error("Expected polling to stop once the rollout is done")
+	}
+	if model.finishedAt.IsZero() {
+		t.Error("Expected the finish time to be recorded")
+	}
+}
+
+func TestRolloutStatusResumesAfterClose(t *testing.T) {
+	client := k8s.Client{Clientset: fake.NewSimpleClientset(), Namespace: "default"}
+	model := NewRolloutStatus(client, k8s.ResourceTypeDeployment, "default", "web", "restart")
+
+	model.Init()
+	stale := rolloutStatusMsg{model: model, seq: model.pollSeq, status: &k8s.RolloutStatus{Message: "Waiting for rollout to finish"}}
+	model.Close()
+	if _, cmd := model.Update(stale); cmd != nil {
+		t.Error("Expected a closed panel to stop polling")
+	}
+
+	if cmd := model.Init(); cmd == nil {
+		t.Fatal("Expected the panel to resume polling when shown again")
+	}
+	if _, cmd := model.Update(stale); cmd != nil {
+		t.Error("Expected a result from the previous polling loop to be dropped")
+	}
+	if target := stale.Target(); target != model {
+		t.Error("Expected status results to be routed to their panel")
+	}
+}
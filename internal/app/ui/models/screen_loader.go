@@ -0,0 +1,134 @@
+package models
+
+import (
+	"context"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type resultOwner interface {
+	owns(target any) bool
+}
+
+type loadedMsg[T any] struct {
+	loader *screenLoader
+	seq    int
+	value  T
+	err    error
+}
+
+func (msg loadedMsg[T]) Target() any {
+	return msg.loader
+}
+
+type screenLoader struct {
+	requestScope
+	loadSeq int
+	loading bool
+	loadErr error
+	spinner spinner.Model
+}
+
+func load[T any](l *screenLoader, fetch func(ctx context.Context) (T, error)) tea.Cmd {
+	if l.spinner.ID() == 0 {
+		l.spinner = components.NewSpinner()
+	}
+	l.loadSeq++
+	l.loading = true
+	l.loadErr = nil
+
+	seq := l.loadSeq
+	ctx := l.requestContext()
+	return tea.Batch(l.spinner.Tick, func() tea.Msg {
+		value, err := fetch(ctx)
+		return loadedMsg[T]{loader: l, seq: seq, value: value, err: err}
+	})
+}
+
+func loaded[T any](l *screenLoader, msg loadedMsg[T]) bool {
+	if msg.loader != l || msg.seq != l.loadSeq {
+		return false
+	}
+	l.loading = false
+	l.loadErr = msg.err
+	return true
+}
+
+func (l *screenLoader) owns(target any) bool {
+	return target == l
+}
+
+func (l *screenLoader) tick(msg tea.Msg) (tea.Cmd, bool) {
+	tick, ok := msg.(spinner.TickMsg)
+	if !ok {
+		return nil, false
+	}
+	if !l.loading {
+		return nil, true
+	}
+	var cmd tea.Cmd
+	l.spinner, cmd = l.spinner.Update(tick)
+	return cmd, true
+}
+
+func (l *screenLoader) updatePending(msg tea.Msg, retry func() tea.Cmd) tea.Cmd {
+	if cmd, ok := l.tick(msg); ok {
+		return cmd
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "esc", "ctrl+c":
+		return func() tea.Msg { return components.BackMsg{} }
+	case "r":
+		if l.loadErr != nil && !l.loading {
+			return retry()
+		}
+	}
+	return nil
+}
+
+func (l *screenLoader) pendingView(subject string) string {
+	if l.loadErr != nil {
+		return l.errorView("load "+subject, "esc: Back")
+	}
+	return l.loadingView("Loading " + subject + "...")
+}
+
+func (l *screenLoader) Close() {
+	l.loadSeq++
+	l.loading = false
+	l.requestScope.Close()
+}
+
+func (l *screenLoader) loadingView(text string) string {
+	return lipgloss.NewStyle().
+		Padding(1, 2).
+		Background(lipgloss.Color(customstyles.BackgroundColor)).
+		Render(components.LoadingView(l.spinner, text))
+}
+
+func (l *screenLoader) errorView(action, back string) string {
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.ErrorColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+	return lipgloss.NewStyle().
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left,
+			errorStyle.Render("Failed to "+action+": "+l.loadErr.Error()),
+			"",
+			helpStyle.Render("r: Retry • "+back),
+		))
+}
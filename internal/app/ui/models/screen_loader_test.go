@@ -0,0 +1,108 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func runLoad(model tea.Model, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runLoad(model, c)
+		}
+	case components.TargetedMsg:
+		model.Update(msg)
+	}
+}
+
+func TestScreenLoaderDropsStaleResults(t *testing.T) {
+	var l screenLoader
+	fetch := func(value string) func(context.Context) (string, error) {
+		return func(context.Context) (string, error) { return value, nil }
+	}
+
+	first := resultOf[string](t, load(&l, fetch("first")))
+	second := resultOf[string](t, load(&l, fetch("second")))
+
+	if loaded(&l, first) {
+		t.Error("Expected a superseded result to be dropped")
+	}
+	if !l.loading {
+		t.Error("Expected the loader to keep loading until the latest result arrives")
+	}
+	if !loaded(&l, second) || l.loading {
+		t.Error("Expected the latest result to be accepted")
+	}
+}
+
+func TestScreenLoaderDropsResultsAfterClose(t *testing.T) {
+	var l screenLoader
+	cmd := load(&l, func(context.Context) (int, error) { return 0, errors.New("late") })
+	l.Close()
+
+	if loaded(&l, resultOf[int](t, cmd)) {
+		t.Error("Expected a result arriving after Close to be dropped")
+	}
+	if l.loadErr != nil {
+		t.Errorf("Expected a dropped result not to record its error, got %v", l.loadErr)
+	}
+}
+
+func TestScreenLoaderRetriesAfterError(t *testing.T) {
+	var l screenLoader
+	calls := 0
+	fetch := func(context.Context) (int, error) {
+		calls++
+		return 0, errors.New("boom")
+	}
+	loaded(&l, resultOf[int](t, load(&l, fetch)))
+
+	if !strings.Contains(l.pendingView("pods"), "Failed to load pods: boom") {
+		t.Errorf("Expected the error view, got %q", l.pendingView("pods"))
+	}
+	retry := l.updatePending(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")}, func() tea.Cmd { return load(&l, fetch) })
+	resultOf[int](t, retry)
+	if calls != 2 {
+		t.Errorf("Expected r to retry the fetch, got %d calls", calls)
+	}
+}
+
+func TestScreenLoaderSpinnerStopsWhenIdle(t *testing.T) {
+	var l screenLoader
+	cmd := load(&l, func(context.Context) (int, error) { return 1, nil })
+	tick := spinner.TickMsg{ID: l.spinner.ID()}
+
+	if next, ok := l.tick(tick); !ok || next == nil {
+		t.Error("Expected the spinner to keep ticking while loading")
+	}
+	loaded(&l, resultOf[int](t, cmd))
+	if next, ok := l.tick(tick); !ok || next != nil {
+		t.Error("Expected the spinner to stop once loading finished")
+	}
+}
+
+func resultOf[T any](t *testing.T, cmd tea.Cmd) loadedMsg[T] {
+	t.Helper()
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok {
+		t.Fatal("Expected a batched load command")
+	}
+	for _, c := range batch {
+		if msg, ok := c().(loadedMsg[T]); ok {
+			return msg
+		}
+	}
+	t.Fatal("Expected the load command to produce a result")
+	return loadedMsg[T]{}
+}
@@ -1,29 +1,24 @@
 package models
 
 import (
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
-	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
 
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 )
 
 type secretDetailsModel struct {
+	describeView
 	secret     *k8s.SecretInfo
 	k8sClient  *k8s.Client
-	loading    bool
-	err        error
 	showValues bool
-	yamlViewer *components.YAMLViewer
 }
 
 func NewSecretDetails(k k8s.Client, namespace, secretName string) *secretDetailsModel {
 	return &secretDetailsModel{
 		secret:     k8s.NewSecret(secretName, namespace, k),
 		k8sClient:  &k,
-		loading:    false,
-		err:        nil,
 		showValues: false,
 	}
 }
@@ -31,11 +26,18 @@ func NewSecretDetails(k k8s.Client, namespace, secretName string) *secretDetails
 func (s *secretDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	s.k8sClient = k
 
-	desc, err := s.secret.DescribeWithVisibility(s.showValues)
-	if err != nil {
-		return nil, err
+	s.setup("secret", s.describeSecret(s.showValues), s.newViewer)
+	return s, nil
+}
+
+func (s *secretDetailsModel) describeSecret(showValues bool) func(ctx context.Context) (string, error) {
+	secret := s.secret
+	return func(ctx context.Context) (string, error) {
+		return secret.DescribeWithVisibility(ctx, showValues)
 	}
+}
 
+func (s *secretDetailsModel) newViewer(desc string) *components.YAMLViewer {
 	title := "Secret: " + s.secret.Name
 	if s.showValues {
 		title += " (VALUES VISIBLE)"
@@ -43,60 +45,31 @@ func (s *secretDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		title += " (VALUES HIDDEN)"
 	}
 
-	s.yamlViewer = components.NewYAMLViewerWithHelp(title, desc, "↑/↓: Scroll • v: Toggle Values • q: Quit")
-	return s, nil
-}
-
-func (s *secretDetailsModel) Init() tea.Cmd {
-	return s.yamlViewer.Init()
+	viewer := components.NewYAMLViewerWithHelp(title, desc, "↑/↓: Scroll • /: Search • v: Toggle Values • e: Edit • ctrl+s: Export • q: Quit")
+	viewer.SetExportName(s.k8sClient.ContextName(), s.secret.Namespace, "secret", s.secret.Name)
+	viewer.SetEditTarget("secret", s.secret.Namespace, s.secret.Name)
+	return viewer
 }
 
 func (s *secretDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := s.update(msg); ok {
+		return s, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if s.CapturingInput() {
+			break
+		}
 		switch msg.String() {
 		case "v", "V":
 			s.showValues = !s.showValues
-			desc, err := s.secret.DescribeWithVisibility(s.showValues)
-			if err != nil {
-				s.err = err
-				return s, nil
-			}
-
-			title := "Secret: " + s.secret.Name
-			if s.showValues {
-				title += " (VALUES VISIBLE)"
-			} else {
-				title += " (VALUES HIDDEN)"
-			}
-
-			s.yamlViewer = components.NewYAMLViewerWithHelp(title, desc, "↑/↓: Scroll • v: Toggle Values • q: Quit")
-			return s, s.yamlViewer.Init()
+			s.describe = s.describeSecret(s.showValues)
+			return s, s.reload()
 		case "q", "esc":
 			return s, tea.Quit
 		}
 	}
 
-	var cmd tea.Cmd
-	updatedModel, cmd := s.yamlViewer.Update(msg)
-	if viewer, ok := updatedModel.(*components.YAMLViewer); ok {
-		s.yamlViewer = viewer
-	}
-	return s, cmd
-}
-
-func (s *secretDetailsModel) View() string {
-	if s.err != nil {
-		return lipgloss.NewStyle().
-			Background(lipgloss.Color(customstyles.BackgroundColor)).
-			Render("Error: " + s.err.Error())
-	}
-
-	if s.yamlViewer == nil {
-		return lipgloss.NewStyle().
-			Background(lipgloss.Color(customstyles.BackgroundColor)).
-			Render("Loading...")
-	}
-
-	return s.yamlViewer.View()
+	return s, s.forward(msg)
 }
@@ -1,8 +1,8 @@
 package models
 
 import (
+	"context"
 	"fmt"
-	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
@@ -45,10 +45,6 @@ func NewSecrets(k k8s.Client, namespace string) (*secretsModel, error) {
 func (s *secretsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	s.k8sClient = k
 
-	if err := s.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		secretDetails, err := NewSecretDetails(*k, s.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -62,38 +58,34 @@ func (s *secretsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := s.fetchData(); err != nil {
-			return nil, err
-		}
-		return s.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(s.config.Columns, s.config.ColumnWidths, s.dataToRows(), s.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := s.newTable(onSelect, s.fetchData, s.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": s.createDeleteAction(tableModel),
+		"e": s.createEditAction(tableModel),
+		"L": s.createEditMetadataAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, s.refreshInterval, s.k8sClient, "Secrets"), nil
+	return NewAutoRefreshModel(tableModel, s.refreshInterval, s.k8sClient, "Secrets").Watch(k8s.ResourceTypeSecret, s.namespace).CloseWith(s), nil
 }
 
-func (s *secretsModel) fetchData() error {
+func (s *secretsModel) fetchData(ctx context.Context) (func(), error) {
 	var secretInfo []k8s.SecretInfo
 	var err error
 
-	secretInfo, err = s.pluginAPI.GetSecrets(s.namespace)
+	secretInfo, err = s.pluginAPI.GetSecrets(ctx, s.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch secrets: %v", err)
+		return nil, fmt.Errorf("failed to fetch secrets: %v", err)
 	}
-	s.secretsInfo = secretInfo
 
-	s.resourceData = make([]types.ResourceData, len(secretInfo))
-	for idx, secret := range secretInfo {
-		s.resourceData[idx] = SecretData{&secret}
-	}
+	return func() {
+		s.secretsInfo = secretInfo
 
-	return nil
+		s.resourceData = make([]types.ResourceData, len(secretInfo))
+		for idx, secret := range secretInfo {
+			s.resourceData[idx] = SecretData{&secret}
+		}
+	}, nil
 }
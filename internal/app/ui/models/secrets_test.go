@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
@@ -198,7 +199,7 @@ func TestNewSecretDetails(t *testing.T) {
 	if model.loading != false {
 		t.Error("Expected loading to be false")
 	}
-	if model.err != nil {
+	if model.loadErr != nil {
 		t.Error("Expected error to be nil")
 	}
 	if model.showValues != false {
@@ -226,6 +227,10 @@ func TestSecretDetailsModelInitComponent(t *testing.T) {
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
+	if model.yamlViewer != nil {
+		t.Error("Expected the description to load asynchronously")
+	}
+	runLoad(model, model.Init())
 
 	if model.yamlViewer == nil {
 		t.Error("Expected yamlViewer to be initialized")
@@ -252,7 +257,7 @@ func TestSecretDetailsModelView(t *testing.T) {
 		},
 	}
 
-	desc, err := model.secret.DescribeWithVisibility(false)
+	desc, err := model.secret.DescribeWithVisibility(context.Background(), false)
 	if err != nil {
 		t.Errorf("DescribeWithVisibility failed: %v", err)
 		return
@@ -264,6 +269,7 @@ func TestSecretDetailsModelView(t *testing.T) {
 		t.Errorf("Expected no error during init, got %v", err)
 		return
 	}
+	runLoad(model, model.Init())
 
 	if model.yamlViewer == nil {
 		t.Error("Expected yamlViewer to be initialized")
@@ -317,7 +323,7 @@ func TestSecretDetailsModelTitleUpdate(t *testing.T) {
 		t.Error("Expected showValues to be false initially")
 	}
 
-	desc, err := model.secret.DescribeWithVisibility(false)
+	desc, err := model.secret.DescribeWithVisibility(context.Background(), false)
 	if err != nil {
 		t.Errorf("Expected no error getting description, got %v", err)
 	}
@@ -325,7 +331,7 @@ func TestSecretDetailsModelTitleUpdate(t *testing.T) {
 		t.Error("Expected description to contain 'dataKeys' when showValues is false")
 	}
 
-	desc, err = model.secret.DescribeWithVisibility(true)
+	desc, err = model.secret.DescribeWithVisibility(context.Background(), true)
 	if err != nil {
 		t.Errorf("Expected no error getting description, got %v", err)
 	}
@@ -349,10 +355,11 @@ func TestSecretDetailsModelErrorHandling(t *testing.T) {
 	client := k8s.Client{Namespace: "default"}
 	model := NewSecretDetails(client, "default", "test-secret")
 
-	model.err = fmt.Errorf("test error")
+	model.InitComponent(&client)
+	model.loadErr = fmt.Errorf("test error")
 
 	view := model.View()
-	if !containsString(view, "Error: test error") {
+	if !containsString(view, "Failed to describe secret: test error") {
 		t.Error("Expected view to contain error message")
 	}
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
@@ -9,35 +10,39 @@ import (
 )
 
 type serviceDetailsModel struct {
+	describeView
 	service   *k8s.ServiceInfo
 	k8sClient *k8s.Client
-	loading   bool
-	err       error
 }
 
 func NewServiceDetails(k k8s.Client, namespace, serviceName string) *serviceDetailsModel {
 	return &serviceDetailsModel{
 		service:   k8s.NewService(serviceName, namespace, k),
 		k8sClient: &k,
-		loading:   false,
-		err:       nil,
 	}
 }
 
 func (s *serviceDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	s.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeService(s.service.Namespace, s.service.Name)
 
-	if err != nil {
-		return nil, err
-	}
+	s.setup("service", func(ctx context.Context) (string, error) {
+		return api.DescribeService(ctx, s.service.Namespace, s.service.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewer("Service: "+s.service.Name, desc)
+		viewer.SetExportName(k.ContextName(), s.service.Namespace, "service", s.service.Name)
+		viewer.SetEditTarget("service", s.service.Namespace, s.service.Name)
+		return viewer
+	})
+	return s, nil
+}
 
-	return components.NewYAMLViewer("Service: "+s.service.Name, desc), nil
+func (s *serviceDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := s.update(msg); ok {
+		return s, cmd
+	}
+	return s, s.forward(msg)
 }
@@ -1,86 +1,62 @@
 package models
 
 import (
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
-	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
 
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 )
 
 type serviceaccountDetailsModel struct {
+	describeView
 	serviceaccount *k8s.ServiceAccountInfo
 	k8sClient      *k8s.Client
-	loading        bool
-	err            error
-	yamlViewer     *components.YAMLViewer
 }
 
 func NewServiceAccountDetails(k k8s.Client, namespace, serviceaccountName string) *serviceaccountDetailsModel {
 	return &serviceaccountDetailsModel{
 		serviceaccount: k8s.NewServiceAccount(serviceaccountName, namespace, k),
 		k8sClient:      &k,
-		loading:        false,
-		err:            nil,
 	}
 }
 
 func (s *serviceaccountDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	s.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeServiceAccount(s.serviceaccount.Namespace, s.serviceaccount.Name)
-
-	if err != nil {
-		return nil, err
-	}
 
-	title := "ServiceAccount: " + s.serviceaccount.Name
+	s.setup("serviceaccount", func(ctx context.Context) (string, error) {
+		return api.DescribeServiceAccount(ctx, s.serviceaccount.Namespace, s.serviceaccount.Name)
+	}, func(desc string) *components.YAMLViewer {
+		title := "ServiceAccount: " + s.serviceaccount.Name
 
-	s.yamlViewer = components.NewYAMLViewerWithHelp(title, desc, "↑/↓: Scroll • q: Quit")
+		viewer := components.NewYAMLViewerWithHelp(title, desc, "↑/↓: Scroll • /: Search • e: Edit • ctrl+s: Export • q: Quit")
+		viewer.SetExportName(k.ContextName(), s.serviceaccount.Namespace, "serviceaccount", s.serviceaccount.Name)
+		viewer.SetEditTarget("serviceaccount", s.serviceaccount.Namespace, s.serviceaccount.Name)
+		return viewer
+	})
 	return s, nil
 }
 
-func (s *serviceaccountDetailsModel) Init() tea.Cmd {
-	return s.yamlViewer.Init()
-}
-
 func (s *serviceaccountDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := s.update(msg); ok {
+		return s, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if s.CapturingInput() {
+			break
+		}
 		switch msg.String() {
 		case "q", "esc":
 			return s, tea.Quit
 		}
 	}
 
-	var cmd tea.Cmd
-	updatedModel, cmd := s.yamlViewer.Update(msg)
-	if viewer, ok := updatedModel.(*components.YAMLViewer); ok {
-		s.yamlViewer = viewer
-	}
-	return s, cmd
-}
-
-func (s *serviceaccountDetailsModel) View() string {
-	if s.err != nil {
-		return lipgloss.NewStyle().
-			Background(lipgloss.Color(customstyles.BackgroundColor)).
-			Render("Error: " + s.err.Error())
-	}
-
-	if s.yamlViewer == nil {
-		return lipgloss.NewStyle().
-			Background(lipgloss.Color(customstyles.BackgroundColor)).
-			Render("Loading...")
-	}
-
-	return s.yamlViewer.View()
+	return s, s.forward(msg)
 }
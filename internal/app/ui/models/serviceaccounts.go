@@ -1,8 +1,8 @@
 package models
 
 import (
+	"context"
 	"fmt"
-	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
@@ -44,10 +44,6 @@ func NewServiceAccounts(k k8s.Client, namespace string) (*serviceaccountsModel,
 func (s *serviceaccountsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	s.k8sClient = k
 
-	if err := s.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		serviceaccountDetails, err := NewServiceAccountDetails(*k, s.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -61,40 +57,36 @@ func (s *serviceaccountsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := s.fetchData(); err != nil {
-			return nil, err
-		}
-		return s.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(s.config.Columns, s.config.ColumnWidths, s.dataToRows(), s.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := s.newTable(onSelect, s.fetchData, s.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": s.createDeleteAction(tableModel),
+		"e": s.createEditAction(tableModel),
+		"L": s.createEditMetadataAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, s.refreshInterval, s.k8sClient, "ServiceAccounts"), nil
+	return NewAutoRefreshModel(tableModel, s.refreshInterval, s.k8sClient, "ServiceAccounts").Watch(k8s.ResourceTypeServiceAccount, s.namespace).CloseWith(s), nil
 }
 
-func (s *serviceaccountsModel) fetchData() error {
+func (s *serviceaccountsModel) fetchData(ctx context.Context) (func(), error) {
 	var serviceaccountInfo []k8s.ServiceAccountInfo
 	var err error
 
-	serviceaccountInfo, err = s.pluginAPI.GetServiceAccounts(s.namespace)
+	serviceaccountInfo, err = s.pluginAPI.GetServiceAccounts(ctx, s.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch serviceaccounts: %v", err)
+		return nil, fmt.Errorf("failed to fetch serviceaccounts: %v", err)
 	}
-	s.serviceaccountsInfo = serviceaccountInfo
 
-	s.resourceData = make([]types.ResourceData, len(serviceaccountInfo))
-	for idx, serviceaccount := range serviceaccountInfo {
-		s.resourceData[idx] = ServiceAccountData{&serviceaccount}
-	}
+	return func() {
+		s.serviceaccountsInfo = serviceaccountInfo
 
-	return nil
+		s.resourceData = make([]types.ResourceData, len(serviceaccountInfo))
+		for idx, serviceaccount := range serviceaccountInfo {
+			s.resourceData[idx] = ServiceAccountData{&serviceaccount}
+		}
+	}, nil
 }
 
 func (s *serviceaccountsModel) dataToRows() []table.Row {
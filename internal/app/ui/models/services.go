@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
@@ -47,10 +48,6 @@ func NewServices(k k8s.Client, namespace string) (*servicesModel, error) {
 func (s *servicesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	s.k8sClient = k
 
-	if err := s.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		serviceDetails, err := NewServiceDetails(*k, s.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -64,38 +61,59 @@ func (s *servicesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := s.fetchData(); err != nil {
-			return nil, err
-		}
-		return s.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(s.config.Columns, s.config.ColumnWidths, s.dataToRows(), s.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := s.newTable(onSelect, s.fetchData, s.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": s.createDeleteAction(tableModel),
+		"e": s.createEditAction(tableModel),
+		"L": s.createEditMetadataAction(tableModel),
+		"f": s.createPortForwardAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, s.refreshInterval, s.k8sClient, "Services"), nil
+	return NewAutoRefreshModel(tableModel, s.refreshInterval, s.k8sClient, "Services").Watch(k8s.ResourceTypeService, s.namespace).CloseWith(s), nil
 }
 
-func (s *servicesModel) fetchData() error {
+func (s *servicesModel) fetchData(ctx context.Context) (func(), error) {
 	var serviceInfo []k8s.ServiceInfo
 	var err error
 
-	serviceInfo, err = s.pluginAPI.GetServices(s.namespace)
+	serviceInfo, err = s.pluginAPI.GetServices(ctx, s.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch services: %v", err)
+		return nil, fmt.Errorf("failed to fetch services: %v", err)
 	}
-	s.servicesInfo = serviceInfo
 
-	s.resourceData = make([]types.ResourceData, len(serviceInfo))
-	for idx, service := range serviceInfo {
-		s.resourceData[idx] = ServiceData{&service}
-	}
+	return func() {
+		s.servicesInfo = serviceInfo
+
+		s.resourceData = make([]types.ResourceData, len(serviceInfo))
+		for idx, service := range serviceInfo {
+			s.resourceData[idx] = ServiceData{&service}
+		}
+	}, nil
+}
 
-	return nil
+func (s *servicesModel) createPortForwardAction(tableModel *ui.TableModel) func() tea.Cmd {
+	return func() tea.Cmd {
+		if tableModel == nil {
+			return nil
+		}
+
+		selected := tableModel.Table.Cursor()
+		if selected < 0 || selected >= len(s.servicesInfo) {
+			return nil
+		}
+
+		service := s.servicesInfo[selected]
+		defaultPorts := ""
+		if service.Raw != nil && len(service.Raw.Spec.Ports) > 0 {
+			port := service.Raw.Spec.Ports[0].Port
+			defaultPorts = fmt.Sprintf("%d:%d", port, port)
+		}
+
+		return func() tea.Msg {
+			return portForwardPrompt(*s.k8sClient, k8s.ResourceTypeService, service.Namespace, service.Name, defaultPorts)
+		}
+	}
 }
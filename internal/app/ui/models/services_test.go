@@ -210,7 +210,7 @@ func TestNewServiceDetails(t *testing.T) {
 	if model.loading != false {
 		t.Error("Expected loading to be false")
 	}
-	if model.err != nil {
+	if model.loadErr != nil {
 		t.Error("Expected error to be nil")
 	}
 }
@@ -0,0 +1,252 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type setImagesResultMsg struct {
+	err error
+}
+
+type setImagesModel struct {
+	screenLoader
+	k8sClient    *k8s.Client
+	resourceType k8s.ResourceType
+	namespace    string
+	name         string
+	containers   []k8s.ContainerImage
+	inputs       []textinput.Model
+	focus        int
+	applying     bool
+	err          error
+}
+
+func NewSetImages(k k8s.Client, resourceType k8s.ResourceType, namespace, name string) *setImagesModel {
+	return &setImagesModel{
+		k8sClient:    &k,
+		resourceType: resourceType,
+		namespace:    namespace,
+		name:         name,
+	}
+}
+
+func (s *setImagesModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	s.k8sClient = k
+	return s, nil
+}
+
+func (s *setImagesModel) fetch() tea.Cmd {
+	client := *s.k8sClient
+	resourceType, namespace, name := s.resourceType, s.namespace, s.name
+	return load(&s.screenLoader, func(ctx context.Context) ([]k8s.ContainerImage, error) {
+		containers, err := k8s.GetContainerImages(ctx, client, resourceType, namespace, name)
+		if err == nil && len(containers) == 0 {
+			err = fmt.Errorf("%s %s has no containers", resourceType, name)
+		}
+		return containers, err
+	})
+}
+
+func (s *setImagesModel) setContainers(containers []k8s.ContainerImage) {
+	s.containers = containers
+	s.inputs = make([]textinput.Model, len(containers))
+	for i, container := range containers {
+		input := textinput.New()
+		input.Prompt = ""
+		input.SetValue(container.Image)
+		input.CursorEnd()
+		s.inputs[i] = input
+	}
+}
+
+func (s *setImagesModel) CapturingInput() bool {
+	return true
+}
+
+func (s *setImagesModel) Init() tea.Cmd {
+	if s.containers == nil {
+		return s.fetch()
+	}
+	return s.setFocus(s.focus)
+}
+
+func (s *setImagesModel) setFocus(focus int) tea.Cmd {
+	s.focus = (focus + len(s.inputs)) % len(s.inputs)
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	return s.inputs[s.focus].Focus()
+}
+
+func (s *setImagesModel) changes() []k8s.ContainerImage {
+	var changes []k8s.ContainerImage
+	for i, container := range s.containers {
+		image := strings.TrimSpace(s.inputs[i].Value())
+		if image != container.Image {
+			changes = append(changes, k8s.ContainerImage{Name: container.Name, Image: image, Init: container.Init})
+		}
+	}
+	return changes
+}
+
+func (s *setImagesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(loadedMsg[[]k8s.ContainerImage]); ok {
+		if !loaded(&s.screenLoader, msg) || msg.err != nil {
+			return s, nil
+		}
+		s.setContainers(msg.value)
+		return s, s.setFocus(0)
+	}
+	if s.containers == nil {
+		return s, s.updatePending(msg, s.fetch)
+	}
+
+	switch msg := msg.(type) {
+	case setImagesResultMsg:
+		s.applying = false
+		if msg.err != nil {
+			s.err = msg.err
+			return s, nil
+		}
+		back := func() tea.Msg { return components.BackMsg{} }
+		if s.resourceType == k8s.ResourceTypeCronJob {
+			return s, back
+		}
+		client := *s.k8sClient
+		resourceType, namespace, name := s.resourceType, s.namespace, s.name
+		return s, tea.Sequence(back, func() tea.Msg {
+			return components.NavigateMsg{
+				NewScreen:  NewRolloutStatus(client, resourceType, namespace, name, "set image"),
+				Breadcrumb: "Rollout",
+			}
+		})
+
+	case tea.KeyMsg:
+		if s.applying {
+			return s, nil
+		}
+		switch msg.String() {
+		case "esc", "ctrl+c":
+			return s, func() tea.Msg { return components.BackMsg{} }
+		case "up", "shift+tab":
+			return s, s.setFocus(s.focus - 1)
+		case "down", "tab":
+			return s, s.setFocus(s.focus + 1)
+		case "ctrl+r":
+			s.inputs[s.focus].SetValue(s.containers[s.focus].Image)
+			s.inputs[s.focus].CursorEnd()
+			return s, nil
+		case "enter":
+			return s, s.apply()
+		}
+	}
+
+	if len(s.inputs) == 0 {
+		return s, nil
+	}
+	var cmd tea.Cmd
+	s.inputs[s.focus], cmd = s.inputs[s.focus].Update(msg)
+	return s, cmd
+}
+
+func (s *setImagesModel) apply() tea.Cmd {
+	changes := s.changes()
+	if len(changes) == 0 {
+		s.err = fmt.Errorf("no images changed")
+		return nil
+	}
+	for _, change := range changes {
+		if change.Image == "" {
+			s.err = fmt.Errorf("image for container %s must not be empty", change.Name)
+			return nil
+		}
+	}
+
+	s.applying = true
+	s.err = nil
+	client := *s.k8sClient
+	resourceType, namespace, name := s.resourceType, s.namespace, s.name
+	return func() tea.Msg {
+		return setImagesResultMsg{err: k8s.SetContainerImages(s.requestContext(), client, resourceType, namespace, name, changes)}
+	}
+}
+
+func (s *setImagesModel) View() string {
+	if s.containers == nil {
+		return s.pendingView(fmt.Sprintf("images for %s %s", s.resourceType, s.name))
+	}
+
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	nameStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.YAMLKeyColor)).
+		Background(background)
+	changedStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.WarningColor)).
+		Background(background)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+
+	nameWidth := 0
+	for _, container := range s.containers {
+		nameWidth = max(nameWidth, lipgloss.Width(containerLabel(container)))
+	}
+
+	lines := []string{
+		customstyles.TitleStyle().Render(fmt.Sprintf("Set images: %s/%s", s.resourceType, s.name)),
+		"",
+	}
+	for i, container := range s.containers {
+		marker := "  "
+		if i == s.focus {
+			marker = "> "
+		}
+		line := textStyle.Render(marker) +
+			nameStyle.Render(fmt.Sprintf("%-*s", nameWidth, containerLabel(container))) +
+			textStyle.Render("  ") +
+			textStyle.Render(s.inputs[i].View())
+		if strings.TrimSpace(s.inputs[i].Value()) != container.Image {
+			line += changedStyle.Render("  (was " + container.Image + ")")
+		}
+		lines = append(lines, line)
+	}
+
+	if s.applying {
+		lines = append(lines, "", textStyle.Render("Applying..."))
+	}
+	if s.err != nil {
+		lines = append(lines, "", lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.ErrorColor)).
+			Background(background).
+			Render(s.err.Error()))
+	}
+	lines = append(lines, "", helpStyle.Render("↑/↓: Move • enter: Apply • ctrl+r: Reset • esc: Cancel"))
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func containerLabel(container k8s.ContainerImage) string {
+	if container.Init {
+		return container.Name + " (init)"
+	}
+	return container.Name
+}
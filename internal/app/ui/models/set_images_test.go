@@ -0,0 +1,52 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSetImagesAppliesChangedImages(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "app:1"}, {Name: "proxy", Image: "proxy:1"}},
+		}}},
+	})
+	client := k8s.Client{Clientset: clientset, Namespace: "default"}
+
+	model := NewSetImages(client, k8s.ResourceTypeDeployment, "default", "web")
+	if _, err := model.InitComponent(&client); err != nil {
+		t.Fatalf("InitComponent failed: %v", err)
+	}
+	runLoad(model, model.Init())
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.err == nil {
+		t.Error("Expected an error when nothing changed")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected an apply command")
+	}
+	if _, cmd = model.Update(cmd()); cmd == nil || model.err != nil {
+		t.Fatalf("Expected navigation after applying, got error %v", model.err)
+	}
+
+	deployment, _ := clientset.AppsV1().Deployments("default").Get(context.Background(), "web", metav1.GetOptions{})
+	containers := deployment.Spec.Template.Spec.Containers
+	if containers[0].Image != "app:1" || containers[1].Image != "proxy:2" {
+		t.Errorf("Expected only proxy to change, got %s and %s", containers[0].Image, containers[1].Image)
+	}
+}
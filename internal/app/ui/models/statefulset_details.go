@@ -1,43 +1,48 @@
 package models
 
 import (
-	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/plugins"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type statefulsetDetailsModel struct {
+	describeView
 	statefulset *k8s.StatefulSetInfo
 	k8sClient   *k8s.Client
-	loading     bool
-	err         error
 }
 
 func NewStatefulSetDetails(k k8s.Client, namespace, statefulsetName string) *statefulsetDetailsModel {
 	return &statefulsetDetailsModel{
 		statefulset: k8s.NewStatefulSet(statefulsetName, namespace, k),
 		k8sClient:   &k,
-		loading:     false,
-		err:         nil,
 	}
 }
 
 func (ss *statefulsetDetailsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	ss.k8sClient = k
 
-	var desc string
-	var err error
-
 	pm := plugins.GetGlobalPluginManager()
 	api := pm.GetAPI()
 	api.SetClient(*k)
-	desc, err = api.DescribeStatefulSet(ss.statefulset.Namespace, ss.statefulset.Name)
 
-	if err != nil {
-		return nil, err
-	}
+	ss.setup("statefulset", func(ctx context.Context) (string, error) {
+		return api.DescribeStatefulSet(ctx, ss.statefulset.Namespace, ss.statefulset.Name)
+	}, func(desc string) *components.YAMLViewer {
+		viewer := components.NewYAMLViewer("StatefulSet: "+ss.statefulset.Name, desc)
+		viewer.SetExportName(k.ContextName(), ss.statefulset.Namespace, "statefulset", ss.statefulset.Name)
+		viewer.SetEditTarget("statefulset", ss.statefulset.Namespace, ss.statefulset.Name)
+		return viewer
+	})
+	return ss, nil
+}
 
-	return components.NewYAMLViewer("StatefulSet: "+ss.statefulset.Name, desc), nil
+func (ss *statefulsetDetailsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := ss.update(msg); ok {
+		return ss, cmd
+	}
+	return ss, ss.forward(msg)
 }
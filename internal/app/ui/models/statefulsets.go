@@ -1,8 +1,8 @@
 package models
 
 import (
+	"context"
 	"fmt"
-	ui "github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
@@ -44,10 +44,6 @@ func NewStatefulSets(k k8s.Client, namespace string) (*statefulsetsModel, error)
 func (ss *statefulsetsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 	ss.k8sClient = k
 
-	if err := ss.fetchData(); err != nil {
-		return nil, err
-	}
-
 	onSelect := func(selected string) tea.Msg {
 		statefulsetDetails, err := NewStatefulSetDetails(*k, ss.namespace, selected).InitComponent(k)
 		if err != nil {
@@ -61,38 +57,38 @@ func (ss *statefulsetsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
 		}
 	}
 
-	fetchFunc := func() ([]table.Row, error) {
-		if err := ss.fetchData(); err != nil {
-			return nil, err
-		}
-		return ss.dataToRows(), nil
-	}
-
-	tableModel := ui.NewTable(ss.config.Columns, ss.config.ColumnWidths, ss.dataToRows(), ss.config.Title, onSelect, 1, fetchFunc, nil)
+	tableModel := ss.newTable(onSelect, ss.fetchData, ss.dataToRows)
 
 	actions := map[string]func() tea.Cmd{
 		"d": ss.createDeleteAction(tableModel),
+		"e": ss.createEditAction(tableModel),
+		"L": ss.createEditMetadataAction(tableModel),
+		"i": ss.createSetImagesAction(tableModel),
+		"l": ss.createWorkloadLogsAction(tableModel),
+		"s": ss.createScaleAction(tableModel),
+		"R": ss.createRestartAction(tableModel),
 	}
 	tableModel.SetUpdateActions(actions)
 
-	return NewAutoRefreshModel(tableModel, ss.refreshInterval, ss.k8sClient, "StatefulSets"), nil
+	return NewAutoRefreshModel(tableModel, ss.refreshInterval, ss.k8sClient, "StatefulSets").Watch(k8s.ResourceTypeStatefulSet, ss.namespace).CloseWith(ss), nil
 }
 
-func (ss *statefulsetsModel) fetchData() error {
+func (ss *statefulsetsModel) fetchData(ctx context.Context) (func(), error) {
 	var statefulsetInfo []k8s.StatefulSetInfo
 	var err error
 
-	statefulsetInfo, err = ss.pluginAPI.GetStatefulSets(ss.namespace)
+	statefulsetInfo, err = ss.pluginAPI.GetStatefulSets(ctx, ss.namespace)
 
 	if err != nil {
-		return fmt.Errorf("failed to fetch statefulsets: %v", err)
+		return nil, fmt.Errorf("failed to fetch statefulsets: %v", err)
 	}
-	ss.statefulsetsInfo = statefulsetInfo
 
-	ss.resourceData = make([]types.ResourceData, len(statefulsetInfo))
-	for idx, statefulset := range statefulsetInfo {
-		ss.resourceData[idx] = StatefulSetData{&statefulset}
-	}
+	return func() {
+		ss.statefulsetsInfo = statefulsetInfo
 
-	return nil
+		ss.resourceData = make([]types.ResourceData, len(statefulsetInfo))
+		for idx, statefulset := range statefulsetInfo {
+			ss.resourceData[idx] = StatefulSetData{&statefulset}
+		}
+	}, nil
 }
@@ -0,0 +1,233 @@
+package models
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	styles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+type SwitchContextMsg struct {
+	Context string
+}
+
+type SwitchNamespaceMsg struct {
+	Namespace string
+}
+
+type CloseSwitcherMsg struct{}
+
+type switcherModel struct {
+	screenLoader
+	title   string
+	subject string
+	current string
+	recent  []string
+	items   []string
+	matches fuzzy.Matches
+	cursor  int
+	input   textinput.Model
+	fetch   func(ctx context.Context) ([]string, error)
+	choose  func(item string) tea.Msg
+}
+
+func NewContextSwitcher(k k8s.Client, recent []string) *switcherModel {
+	kubeconfigPath := k.KubeconfigPath
+	fetch := func(ctx context.Context) ([]string, error) {
+		contexts, err := k8s.ListContexts(kubeconfigPath)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(contexts))
+		for i, context := range contexts {
+			names[i] = context.Name
+		}
+		return names, nil
+	}
+	choose := func(name string) tea.Msg {
+		return SwitchContextMsg{Context: name}
+	}
+	return newSwitcher("Switch Context", "contexts", k.ContextName(), recent, fetch, choose)
+}
+
+func NewNamespaceSwitcher(k k8s.Client, recent []string) *switcherModel {
+	fetch := func(ctx context.Context) ([]string, error) {
+		return k8s.FetchNamespaces(ctx, k)
+	}
+	choose := func(namespace string) tea.Msg {
+		return SwitchNamespaceMsg{Namespace: namespace}
+	}
+	return newSwitcher("Switch Namespace", "namespaces", k.Namespace, recent, fetch, choose)
+}
+
+func newSwitcher(title, subject, current string, recent []string, fetch func(ctx context.Context) ([]string, error), choose func(item string) tea.Msg) *switcherModel {
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "type to search"
+
+	return &switcherModel{
+		title:   title,
+		subject: subject,
+		current: current,
+		recent:  recent,
+		input:   input,
+		fetch:   fetch,
+		choose:  choose,
+	}
+}
+
+func (s *switcherModel) Init() tea.Cmd {
+	return tea.Batch(s.input.Focus(), load(&s.screenLoader, s.fetch))
+}
+
+func (s *switcherModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case loadedMsg[[]string]:
+		if loaded(&s.screenLoader, msg) && msg.err == nil {
+			s.items = msg.value
+			s.filter()
+		}
+		return s, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "ctrl+c":
+			return s, func() tea.Msg { return CloseSwitcherMsg{} }
+		case "enter":
+			if s.cursor < len(s.matches) {
+				selected := s.matches[s.cursor].Str
+				return s, func() tea.Msg { return s.choose(selected) }
+			}
+			return s, nil
+		case "up", "shift+tab":
+			if s.cursor > 0 {
+				s.cursor--
+			}
+			return s, nil
+		case "down", "tab":
+			if s.cursor < len(s.matches)-1 {
+				s.cursor++
+			}
+			return s, nil
+		case "ctrl+r":
+			if s.loadErr != nil && !s.loading {
+				return s, load(&s.screenLoader, s.fetch)
+			}
+			return s, nil
+		}
+
+		var cmd tea.Cmd
+		s.input, cmd = s.input.Update(msg)
+		s.cursor = 0
+		s.filter()
+		return s, cmd
+	}
+
+	if cmd, ok := s.tick(msg); ok {
+		return s, cmd
+	}
+	var cmd tea.Cmd
+	s.input, cmd = s.input.Update(msg)
+	return s, cmd
+}
+
+func (s *switcherModel) ordered() []string {
+	var ordered []string
+	for _, item := range s.recent {
+		if slices.Contains(s.items, item) && !slices.Contains(ordered, item) {
+			ordered = append(ordered, item)
+		}
+	}
+	var rest []string
+	for _, item := range s.items {
+		if !slices.Contains(ordered, item) {
+			rest = append(rest, item)
+		}
+	}
+	sort.Strings(rest)
+	return append(ordered, rest...)
+}
+
+func (s *switcherModel) filter() {
+	ordered := s.ordered()
+	query := s.input.Value()
+	if query == "" {
+		s.matches = make(fuzzy.Matches, len(ordered))
+		for i, item := range ordered {
+			s.matches[i] = fuzzy.Match{Str: item, Index: i}
+		}
+	} else {
+		s.matches = fuzzy.Find(query, ordered)
+	}
+	s.cursor = min(s.cursor, max(len(s.matches)-1, 0))
+}
+
+func (s *switcherModel) View() string {
+	background := lipgloss.Color(customstyles.BackgroundColor)
+	textStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.TextColor)).
+		Background(background)
+	matchStyle := textStyle.
+		Foreground(lipgloss.Color(customstyles.AccentColor)).
+		Bold(true)
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(customstyles.HelpTextColor)).
+		Background(background)
+
+	lines := []string{customstyles.TitleStyle().Render(s.title), "", textStyle.Render(s.input.View()), ""}
+	switch {
+	case s.loadErr != nil:
+		lines = append(lines, s.errorView("load "+s.subject, "esc: Cancel"))
+	case s.loading:
+		lines = append(lines, s.loadingView("Loading "+s.subject+"..."))
+	case len(s.matches) == 0:
+		lines = append(lines, helpStyle.Render("No matching "+s.subject))
+	default:
+		visible := max(styles.ScreenHeight+styles.HeaderSize-10, 1)
+		start := max(s.cursor-visible+1, 0)
+		end := min(start+visible, len(s.matches))
+		for i := start; i < end; i++ {
+			lines = append(lines, s.renderMatch(s.matches[i], i == s.cursor, textStyle, matchStyle))
+		}
+	}
+	lines = append(lines, "", helpStyle.Render("↑/↓: Move • enter: Switch • esc: Cancel"))
+
+	return lipgloss.NewStyle().
+		Width(styles.ScreenWidth).
+		Height(styles.ScreenHeight+styles.HeaderSize).
+		Padding(1, 2).
+		Background(background).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (s *switcherModel) renderMatch(match fuzzy.Match, selected bool, textStyle, matchStyle lipgloss.Style) string {
+	if selected {
+		selectedStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(customstyles.SelectionForeground)).
+			Background(lipgloss.Color(customstyles.SelectionBackground))
+		textStyle = selectedStyle
+		matchStyle = selectedStyle.Bold(true).Underline(true)
+	}
+
+	marker := "  "
+	if match.Str == s.current {
+		marker = "* "
+	}
+	line := textStyle.Render(marker)
+	for i, r := range match.Str {
+		if slices.Contains(match.MatchedIndexes, i) {
+			line += matchStyle.Render(string(r))
+		} else {
+			line += textStyle.Render(string(r))
+		}
+	}
+	return line
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func loadedNamespaceSwitcher(t *testing.T, recent []string) *switcherModel {
+	t.Helper()
+	var objects []runtime.Object
+	for _, name := range []string{"monitoring", "default", "kube-system", "staging"} {
+		objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	client := k8s.Client{Namespace: "default", Clientset: fake.NewSimpleClientset(objects...)}
+	switcher := NewNamespaceSwitcher(client, recent)
+	runLoad(switcher, switcher.Init())
+	return switcher
+}
+
+func matchedItems(s *switcherModel) []string {
+	var items []string
+	for _, match := range s.matches {
+		items = append(items, match.Str)
+	}
+	return items
+}
+
+func TestSwitcherListsRecentItemsFirst(t *testing.T) {
+	switcher := loadedNamespaceSwitcher(t, []string{"staging", "deleted", "kube-system"})
+
+	got := strings.Join(matchedItems(switcher), ",")
+	if got != "staging,kube-system,default,monitoring" {
+		t.Errorf("Expected recent namespaces first and the rest sorted, got %s", got)
+	}
+}
+
+func TestSwitcherFuzzyFilters(t *testing.T) {
+	switcher := loadedNamespaceSwitcher(t, nil)
+
+	for _, r := range "kst" {
+		switcher.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	if got := matchedItems(switcher); len(got) != 1 || got[0] != "kube-system" {
+		t.Errorf("Expected only kube-system to match, got %v", got)
+	}
+}
+
+func TestSwitcherEnterChoosesSelection(t *testing.T) {
+	switcher := loadedNamespaceSwitcher(t, nil)
+
+	switcher.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := switcher.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected enter to produce a command")
+	}
+	msg, ok := cmd().(SwitchNamespaceMsg)
+	if !ok || msg.Namespace != "kube-system" {
+		t.Errorf("Expected a switch to kube-system, got %#v", msg)
+	}
+
+	_, cmd = switcher.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if _, ok := cmd().(CloseSwitcherMsg); !ok {
+		t.Error("Expected esc to close the switcher")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -18,6 +19,10 @@ type TabData struct {
 	CurrentIndex int
 }
 
+type ClosableModel interface {
+	Close()
+}
+
 type TabManager struct {
 	tabs        []TabData
 	activeIndex int
@@ -95,6 +100,21 @@ func (tm *TabManager) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return tm.closeTab(msg.TabID)
 		}
 
+	case components.BackMsg:
+		return tm.navigateBack()
+
+	case watchEventMsg:
+		return tm, tm.updateWatcher(msg.sub, msg)
+
+	case watchResyncMsg:
+		return tm, tm.updateWatcher(msg.sub, msg)
+
+	case components.TargetedMsg:
+		return tm, tm.deliverResult(msg)
+
+	case spinner.TickMsg:
+		return tm, tm.updateScreens(msg)
+
 	case components.NavigateMsg:
 		if msg.Error != nil {
 			return tm, nil
@@ -104,6 +124,7 @@ func (tm *TabManager) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			activeTab := &tm.tabs[tm.activeIndex]
 
 			if activeTab.CurrentIndex < len(activeTab.ScreenStack)-1 {
+				closeScreens(activeTab.ScreenStack[activeTab.CurrentIndex+1:])
 				activeTab.ScreenStack = activeTab.ScreenStack[:activeTab.CurrentIndex+1]
 				if activeTab.CurrentIndex+1 < len(activeTab.Breadcrumb) {
 					activeTab.Breadcrumb = activeTab.Breadcrumb[:activeTab.CurrentIndex+1]
@@ -140,6 +161,12 @@ func (tm *TabManager) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return tm, tea.Batch(cmds...)
 
 	case tea.KeyMsg:
+		if tm.CapturingInput() {
+			var cmd tea.Cmd
+			tm.tabs[tm.activeIndex].Model, cmd = tm.tabs[tm.activeIndex].Model.Update(msg)
+			return tm, cmd
+		}
+
 		switch msg.String() {
 		case tm.getKeyBinding("new_tab"):
 			return tm.CreateNewResourceTab()
@@ -205,6 +232,48 @@ func (tm *TabManager) CreateNewResourceTab() (tea.Model, tea.Cmd) {
 	return tm.CreateNewTab(resourceComponent, "Resource List")
 }
 
+func (tm *TabManager) SwitchClient(kubeClient *k8s.Client) tea.Cmd {
+	tm.kubeClient = kubeClient
+	tm.namespace = kubeClient.Namespace
+
+	var cmds []tea.Cmd
+	for i := range tm.tabs {
+		tab := &tm.tabs[i]
+		closeScreens(tab.ScreenStack)
+		tab.ScreenStack, tab.Breadcrumb = tm.rebuildScreens(tab.Breadcrumb)
+		tab.CurrentIndex = len(tab.ScreenStack) - 1
+		tab.Model = tab.ScreenStack[tab.CurrentIndex]
+		tab.Title = tab.Breadcrumb[tab.CurrentIndex]
+		tab.ResourceType = tab.Title
+		cmds = append(cmds, tab.Model.Init())
+	}
+	return tea.Batch(cmds...)
+}
+
+func (tm *TabManager) rebuildScreens(breadcrumb []string) ([]tea.Model, []string) {
+	root := NewResource(*tm.kubeClient, tm.namespace).InitComponent(*tm.kubeClient)
+	screens := []tea.Model{root}
+	trail := []string{"Resource List"}
+
+	for _, crumb := range breadcrumb {
+		if crumb == "Resource List" {
+			continue
+		}
+		resourceType := crumb
+		if crumb == "Port Forwards" {
+			resourceType = "PortForwards"
+		}
+		screen, title, err := resourceScreen(*tm.kubeClient, tm.namespace, resourceType)
+		if err != nil {
+			continue
+		}
+		screens = append(screens, screen)
+		trail = append(trail, title)
+		break
+	}
+	return screens, trail
+}
+
 func (tm *TabManager) switchToTab(tabID string) (tea.Model, tea.Cmd) {
 	for i, tab := range tm.tabs {
 		if tab.ID == tabID {
@@ -222,6 +291,7 @@ func (tm *TabManager) closeTab(tabID string) (tea.Model, tea.Cmd) {
 
 	for i, tab := range tm.tabs {
 		if tab.ID == tabID {
+			closeScreens(tab.ScreenStack)
 			tm.tabs = append(tm.tabs[:i], tm.tabs[i+1:]...)
 			if tm.activeIndex >= len(tm.tabs) {
 				tm.activeIndex = len(tm.tabs) - 1
@@ -261,6 +331,14 @@ func (tm *TabManager) GetActiveTab() *TabData {
 	return nil
 }
 
+func (tm *TabManager) CapturingInput() bool {
+	if tm.activeIndex < 0 || tm.activeIndex >= len(tm.tabs) {
+		return false
+	}
+	capturer, ok := tm.tabs[tm.activeIndex].Model.(components.InputCapturer)
+	return ok && capturer.CapturingInput()
+}
+
 func (tm *TabManager) GetTabCount() int {
 	return len(tm.tabs)
 }
@@ -295,6 +373,7 @@ func (tm *TabManager) navigateBack() (tea.Model, tea.Cmd) {
 	if tm.activeIndex >= 0 && tm.activeIndex < len(tm.tabs) {
 		activeTab := &tm.tabs[tm.activeIndex]
 		if activeTab.CurrentIndex > 0 && activeTab.CurrentIndex < len(activeTab.ScreenStack) {
+			closeScreens(activeTab.ScreenStack[activeTab.CurrentIndex : activeTab.CurrentIndex+1])
 			activeTab.CurrentIndex--
 			activeTab.Model = activeTab.ScreenStack[activeTab.CurrentIndex]
 			if len(activeTab.Breadcrumb) > 0 && activeTab.CurrentIndex < len(activeTab.Breadcrumb) {
@@ -322,3 +401,68 @@ func (tm *TabManager) navigateForward() (tea.Model, tea.Cmd) {
 	}
 	return tm, nil
 }
+
+func (tm *TabManager) updateWatcher(sub *k8s.WatchSubscription, msg tea.Msg) tea.Cmd {
+	for i := range tm.tabs {
+		tab := &tm.tabs[i]
+		for j, screen := range tab.ScreenStack {
+			watcher, ok := screen.(interface {
+				watching(sub *k8s.WatchSubscription) bool
+			})
+			if !ok || !watcher.watching(sub) {
+				continue
+			}
+			updated, cmd := screen.Update(msg)
+			tab.ScreenStack[j] = updated
+			if j == tab.CurrentIndex {
+				tab.Model = updated
+			}
+			return cmd
+		}
+	}
+	return nil
+}
+
+func (tm *TabManager) deliverResult(msg components.TargetedMsg) tea.Cmd {
+	target := msg.Target()
+	for i := range tm.tabs {
+		tab := &tm.tabs[i]
+		for j, screen := range tab.ScreenStack {
+			owner, ok := screen.(resultOwner)
+			if any(screen) != target && (!ok || !owner.owns(target)) {
+				continue
+			}
+			updated, cmd := screen.Update(msg)
+			tab.ScreenStack[j] = updated
+			if j == tab.CurrentIndex {
+				tab.Model = updated
+			}
+			return cmd
+		}
+	}
+	return nil
+}
+
+func (tm *TabManager) updateScreens(msg tea.Msg) tea.Cmd {
+	var cmds []tea.Cmd
+	for i := range tm.tabs {
+		tab := &tm.tabs[i]
+		for j, screen := range tab.ScreenStack {
+			updated, cmd := screen.Update(msg)
+			tab.ScreenStack[j] = updated
+			if j == tab.CurrentIndex {
+				tab.Model = updated
+			}
+			cmds = append(cmds, cmd)
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+func closeScreens(screens []tea.Model) {
+	for _, screen := range screens {
+		if closable, ok := screen.(ClosableModel); ok {
+			closable.Close()
+		}
+	}
+}
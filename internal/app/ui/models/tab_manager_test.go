@@ -0,0 +1,86 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func pendingSecretDetails(t *testing.T, client k8s.Client) (*secretDetailsModel, tea.Msg) {
+	t.Helper()
+	model := NewSecretDetails(client, "default", "creds")
+	model.secret.Raw = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"}}
+	model.InitComponent(&client)
+	return model, resultOf[string](t, model.Init())
+}
+
+func TestTabManagerDeliversResultsToBackgroundScreens(t *testing.T) {
+	client := k8s.Client{Namespace: "default"}
+	tm := NewTabManager(&client, "default", nil)
+	model, result := pendingSecretDetails(t, client)
+
+	tab := &tm.tabs[0]
+	tab.ScreenStack = append(tab.ScreenStack, model)
+	tm.Update(result)
+
+	if model.yamlViewer == nil {
+		t.Error("Expected the result to reach its screen even though it is not shown")
+	}
+	if tab.Model == tea.Model(model) {
+		t.Error("Expected the shown screen to stay unchanged")
+	}
+}
+
+func TestTabManagerDropsResultsForClosedScreens(t *testing.T) {
+	client := k8s.Client{Namespace: "default"}
+	tm := NewTabManager(&client, "default", nil)
+	model, result := pendingSecretDetails(t, client)
+
+	tm.Update(components.NavigateMsg{NewScreen: model, Breadcrumb: "creds"})
+	tm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	if tm.tabs[0].Model == tea.Model(model) {
+		t.Fatal("Expected back to leave the secret screen")
+	}
+	tm.Update(result)
+
+	if model.yamlViewer != nil {
+		t.Error("Expected a late result not to be applied to a screen that was left")
+	}
+}
+
+func TestTabManagerSwitchClientRebuildsTabs(t *testing.T) {
+	client := k8s.Client{Namespace: "default", Clientset: fake.NewSimpleClientset()}
+	tm := NewTabManager(&client, "default", nil)
+	pods, err := NewResourceList(client, "default", "Pods").InitComponent(client)
+	if err != nil {
+		t.Fatalf("Failed to create pods screen: %v", err)
+	}
+	model, result := pendingSecretDetails(t, client)
+	tm.Update(components.NavigateMsg{NewScreen: pods, Breadcrumb: "Pods"})
+	tm.Update(components.NavigateMsg{NewScreen: model, Breadcrumb: "creds"})
+
+	switched := k8s.Client{Namespace: "staging", Clientset: fake.NewSimpleClientset()}
+	tm.SwitchClient(&switched)
+
+	tab := tm.tabs[0]
+	if tm.namespace != "staging" || tm.kubeClient != &switched {
+		t.Errorf("Expected the tab manager to use the new client, got namespace %q", tm.namespace)
+	}
+	if strings.Join(tab.Breadcrumb, "/") != "Resource List/Pods" {
+		t.Errorf("Expected the tab to reopen its resource list, got %v", tab.Breadcrumb)
+	}
+	if tab.Model == pods || tab.Model != tab.ScreenStack[tab.CurrentIndex] {
+		t.Error("Expected the tab to show a rebuilt pods screen")
+	}
+	tm.Update(result)
+	if model.yamlViewer != nil {
+		t.Error("Expected screens from the previous client to be closed")
+	}
+}
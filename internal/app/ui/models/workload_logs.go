@@ -0,0 +1,313 @@
+package models
+
+import (
+	"context"
+	"hash/fnv"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const logInterleaveWindow = 100 * time.Millisecond
+
+type workloadLogLinesMsg struct {
+	model   *workloadLogsModel
+	session int
+	lines   []k8s.LogLine
+}
+
+func (msg workloadLogLinesMsg) Target() any {
+	return msg.model
+}
+
+type workloadLogStreamEndMsg struct {
+	model   *workloadLogsModel
+	session int
+	err     error
+}
+
+func (msg workloadLogStreamEndMsg) Target() any {
+	return msg.model
+}
+
+type workloadLogStream struct {
+	lines chan k8s.LogLine
+	errc  chan error
+}
+
+func newWorkloadLogStream() *workloadLogStream {
+	return &workloadLogStream{
+		lines: make(chan k8s.LogLine, logBatchSize),
+		errc:  make(chan error, 1),
+	}
+}
+
+func (s *workloadLogStream) wait(model *workloadLogsModel, session int) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-s.lines
+		if !ok {
+			return workloadLogStreamEndMsg{model: model, session: session, err: <-s.errc}
+		}
+
+		lines := []k8s.LogLine{line}
+		window := time.NewTimer(logInterleaveWindow)
+		defer window.Stop()
+		for len(lines) < logBatchSize {
+			select {
+			case line, ok := <-s.lines:
+				if !ok {
+					return workloadLogLinesMsg{model: model, session: session, lines: sortLogLines(lines)}
+				}
+				lines = append(lines, line)
+			case <-window.C:
+				return workloadLogLinesMsg{model: model, session: session, lines: sortLogLines(lines)}
+			}
+		}
+		return workloadLogLinesMsg{model: model, session: session, lines: sortLogLines(lines)}
+	}
+}
+
+func sortLogLines(lines []k8s.LogLine) []k8s.LogLine {
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Timestamp.Before(lines[j].Timestamp)
+	})
+	return lines
+}
+
+type workloadLogsModel struct {
+	resourceType k8s.ResourceType
+	name         string
+	namespace    string
+	selector     string
+	k8sClient    *k8s.Client
+	options      k8s.LogOptions
+	sinceIndex   int
+	tailIndex    int
+	pods         map[string]int
+	viewer       *components.LogViewer
+	stream       *workloadLogStream
+	session      int
+	cancel       context.CancelFunc
+	streaming    bool
+	err          error
+}
+
+func NewWorkloadLogs(k k8s.Client, resourceType k8s.ResourceType, namespace, name, selector string) *workloadLogsModel {
+	return &workloadLogsModel{
+		resourceType: resourceType,
+		name:         name,
+		namespace:    namespace,
+		selector:     selector,
+		k8sClient:    &k,
+		options: k8s.LogOptions{
+			Follow:    true,
+			TailLines: logTailPresets[0].value,
+		},
+		pods: map[string]int{},
+	}
+}
+
+func (w *workloadLogsModel) InitComponent(k *k8s.Client) (tea.Model, error) {
+	w.k8sClient = k
+
+	w.viewer = components.NewLogViewer("Logs: " + strings.ToLower(string(w.resourceType)) + "/" + w.name)
+	w.viewer.SetCustomHelp("↑/↓: Scroll • space: Pause • G: Bottom • s: Since • l: Tail • t: Timestamps • /: Search • &: Filter • !: Exclude • ctrl+s: Export • q: Back")
+	w.updateStatus()
+
+	return w, nil
+}
+
+func (w *workloadLogsModel) Init() tea.Cmd {
+	if w.streaming {
+		return w.viewer.Init()
+	}
+	return tea.Batch(w.viewer.Init(), w.restart())
+}
+
+func (w *workloadLogsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case workloadLogLinesMsg:
+		if msg.model != w || msg.session != w.session {
+			return w, nil
+		}
+		entries := make([]components.LogEntry, 0, len(msg.lines))
+		for _, line := range msg.lines {
+			entries = append(entries, w.logEntry(line))
+		}
+		w.viewer.AppendEntries(entries...)
+		w.updateStatus()
+		return w, w.stream.wait(w, msg.session)
+
+	case workloadLogStreamEndMsg:
+		if msg.model != w || msg.session != w.session {
+			return w, nil
+		}
+		w.streaming = false
+		w.err = msg.err
+		if msg.err != nil {
+			w.viewer.AppendLines("--- " + msg.err.Error() + " ---")
+		} else {
+			w.viewer.AppendLines("--- end of log stream ---")
+		}
+		w.updateStatus()
+		return w, nil
+
+	case tea.KeyMsg:
+		if w.viewer.CapturingInput() {
+			break
+		}
+		switch msg.String() {
+		case "s":
+			w.sinceIndex = (w.sinceIndex + 1) % len(logSincePresets)
+			w.options.SinceSeconds = logSincePresets[w.sinceIndex].value
+			return w, w.restart()
+		case "l":
+			w.tailIndex = (w.tailIndex + 1) % len(logTailPresets)
+			w.options.TailLines = logTailPresets[w.tailIndex].value
+			return w, w.restart()
+		case "t":
+			w.options.Timestamps = !w.options.Timestamps
+			return w, w.restart()
+		}
+	}
+
+	updated, cmd := w.viewer.Update(msg)
+	if viewer, ok := updated.(*components.LogViewer); ok {
+		w.viewer = viewer
+	}
+	w.updateStatus()
+	return w, cmd
+}
+
+func (w *workloadLogsModel) View() string {
+	return w.viewer.View()
+}
+
+func (w *workloadLogsModel) CapturingInput() bool {
+	return w.viewer.CapturingInput()
+}
+
+func (w *workloadLogsModel) Close() {
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+	w.streaming = false
+	w.session++
+}
+
+func (w *workloadLogsModel) restart() tea.Cmd {
+	w.Close()
+	w.err = nil
+	w.pods = map[string]int{}
+	w.viewer.Clear()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.streaming = true
+	w.stream = newWorkloadLogStream()
+
+	client := *w.k8sClient
+	namespace := w.namespace
+	selector := w.selector
+	opts := w.options
+	stream := w.stream
+	go func() {
+		stream.errc <- k8s.TailSelectorLogs(ctx, client, namespace, selector, opts, stream.lines)
+		close(stream.lines)
+	}()
+
+	w.viewer.SetExportName(client.ContextName(), namespace, string(w.resourceType), w.name)
+	w.viewer.SetExporter(func(out io.Writer) error {
+		return k8s.WriteSelectorLogs(context.Background(), client, namespace, selector, opts, out)
+	})
+
+	w.updateStatus()
+	return stream.wait(w, w.session)
+}
+
+func (w *workloadLogsModel) logEntry(line k8s.LogLine) components.LogEntry {
+	entry := components.LogEntry{
+		Prefix:      line.Pod + " " + line.Container,
+		PrefixColor: logPrefixColor(line.Pod),
+		Text:        line.Text,
+	}
+
+	switch line.Event {
+	case k8s.LogEventAdded:
+		w.pods[line.Pod]++
+		entry.Prefix = "+ " + entry.Prefix
+		entry.Text = ""
+	case k8s.LogEventRemoved:
+		if w.pods[line.Pod]--; w.pods[line.Pod] <= 0 {
+			delete(w.pods, line.Pod)
+		}
+		entry.Prefix = "- " + entry.Prefix
+		entry.Text = ""
+	case k8s.LogEventError:
+		entry.Text = "--- " + line.Text + " ---"
+	default:
+		if w.options.Timestamps {
+			entry.Text = line.Timestamp.Format(time.RFC3339Nano) + " " + line.Text
+		}
+	}
+	return entry
+}
+
+func (w *workloadLogsModel) updateStatus() {
+	status := []string{
+		"selector=" + w.selector,
+		"pods=" + strconv.Itoa(len(w.pods)),
+		"since=" + logSincePresets[w.sinceIndex].label,
+		"tail=" + logTailPresets[w.tailIndex].label,
+	}
+	if w.options.Timestamps {
+		status = append(status, "timestamps")
+	}
+	if w.streaming {
+		status = append(status, "streaming")
+	} else if w.err != nil {
+		status = append(status, "error")
+	} else {
+		status = append(status, "stopped")
+	}
+	w.viewer.SetStatus(strings.Join(status, " "))
+}
+
+func logPrefixColors() []string {
+	var colors []string
+	for _, color := range []string{
+		customstyles.AccentColor,
+		customstyles.YAMLKeyColor,
+		customstyles.YAMLValueColor,
+		customstyles.YAMLTitleColor,
+		customstyles.HeaderColor,
+		customstyles.HeaderValueColor,
+		customstyles.WarningColor,
+		customstyles.BorderColor,
+	} {
+		if color != "" && !slices.Contains(colors, color) {
+			colors = append(colors, color)
+		}
+	}
+	return colors
+}
+
+func logPrefixColor(pod string) string {
+	colors := logPrefixColors()
+	if len(colors) == 0 {
+		return string(customstyles.TextColor)
+	}
+	h := fnv.New32a()
+	h.Write([]byte(pod))
+	return colors[h.Sum32()%uint32(len(colors))]
+}
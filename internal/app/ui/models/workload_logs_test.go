@@ -0,0 +1,108 @@
+package models
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/internal/app/ui/components"
+	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
+	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestWorkloadLogs() *workloadLogsModel {
+	model := NewWorkloadLogs(k8s.Client{Clientset: fake.NewSimpleClientset(), Namespace: "default"}, k8s.ResourceTypeDeployment, "default", "web", "app=web")
+	model.viewer = components.NewLogViewer("Logs: deployment/web")
+	return model
+}
+
+func TestWorkloadLogsEntries(t *testing.T) {
+	model := newTestWorkloadLogs()
+	model.stream = newWorkloadLogStream()
+
+	now := time.Now()
+	model.Update(workloadLogLinesMsg{model: model, session: model.session, lines: []k8s.LogLine{
+		{Pod: "web-1", Container: "app", Event: k8s.LogEventAdded},
+		{Pod: "web-2", Container: "app", Event: k8s.LogEventAdded},
+		{Pod: "web-1", Container: "app", Timestamp: now, Text: "hello"},
+	}})
+
+	lines := model.viewer.Lines()
+	expected := []string{"+ web-1 app", "+ web-2 app", "web-1 app hello"}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got %v", len(expected), lines)
+	}
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("Line %d: expected %q, got %q", i, expected[i], lines[i])
+		}
+	}
+	if len(model.pods) != 2 {
+		t.Errorf("Expected 2 tailed pods, got %d", len(model.pods))
+	}
+
+	model.Update(workloadLogLinesMsg{model: model, session: model.session, lines: []k8s.LogLine{
+		{Pod: "web-2", Container: "app", Timestamp: now, Event: k8s.LogEventRemoved},
+	}})
+	if len(model.pods) != 1 {
+		t.Errorf("Expected 1 tailed pod after removal, got %d", len(model.pods))
+	}
+
+	model.Update(workloadLogLinesMsg{model: model, session: model.session - 1, lines: []k8s.LogLine{
+		{Pod: "web-1", Container: "app", Timestamp: now, Text: "stale"},
+	}})
+	if len(model.viewer.Lines()) != 4 {
+		t.Errorf("Expected stale session lines to be ignored, got %v", model.viewer.Lines())
+	}
+}
+
+func TestSortLogLines(t *testing.T) {
+	base := time.Now()
+	lines := sortLogLines([]k8s.LogLine{
+		{Pod: "b", Timestamp: base.Add(2 * time.Second), Text: "third"},
+		{Pod: "a", Timestamp: base, Text: "first"},
+		{Pod: "b", Timestamp: base.Add(time.Second), Text: "second"},
+	})
+
+	for i, text := range []string{"first", "second", "third"} {
+		if lines[i].Text != text {
+			t.Errorf("Line %d: expected %q, got %q", i, text, lines[i].Text)
+		}
+	}
+}
+
+func TestLogPrefixColorIsStable(t *testing.T) {
+	if logPrefixColor("web-1") != logPrefixColor("web-1") {
+		t.Error("Expected the same pod to always get the same prefix color")
+	}
+}
+
+func TestLogPrefixColorFollowsTheme(t *testing.T) {
+	previous := customstyles.AccentColor
+	defer func() { customstyles.AccentColor = previous }()
+	customstyles.AccentColor = "#123456"
+
+	if !slices.Contains(logPrefixColors(), "#123456") {
+		t.Errorf("Expected prefix colors to come from the color scheme, got %v", logPrefixColors())
+	}
+}
+
+func TestWorkloadLogsIgnoresPodLogStreamEnd(t *testing.T) {
+	model := newTestWorkloadLogs()
+	model.streaming = true
+
+	model.Update(podLogStreamEndMsg{session: model.session})
+	if !model.streaming {
+		t.Error("Expected a pod log screen's stream end to be ignored")
+	}
+	model.Update(workloadLogStreamEndMsg{model: NewWorkloadLogs(*model.k8sClient, model.resourceType, model.namespace, model.name, model.selector), session: model.session})
+	if !model.streaming {
+		t.Error("Expected another workload screen's stream end to be ignored")
+	}
+	model.Update(workloadLogStreamEndMsg{model: model, session: model.session})
+	if model.streaming {
+		t.Error("Expected the screen's own stream end to stop streaming")
+	}
+}
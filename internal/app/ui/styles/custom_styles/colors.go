@@ -36,6 +36,8 @@ var (
 
 	HeaderLoadingColor string
 
+	WarningColor string
+
 	ResourceIcons map[string]string
 )
 
@@ -81,6 +83,12 @@ func InitColors() error {
 	HeaderValueColor = scheme.HeaderValueColor
 	HeaderLoadingColor = scheme.HeaderLoadingColor
 
+	if scheme.WarningColor != "" {
+		WarningColor = scheme.WarningColor
+	} else {
+		WarningColor = config.DefaultColorScheme().WarningColor
+	}
+
 	ResourceIcons = map[string]string{
 		"Pods":                   "󰀵",
 		"Deployments":            "󰜴",
@@ -99,6 +107,7 @@ func InitColors() error {
 		"PersistentVolumeClaims": "󰋊",
 		"ServiceAccounts":        "󰀄",
 		"ResourceList":           "󰒋",
+		"PortForwards":           "󰌘",
 		"Workloads":              "󰜄",
 		"Networking":             "󰖟",
 		"Configuration":          "󰒓",
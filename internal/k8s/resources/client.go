@@ -1,11 +1,12 @@
 package k8s
 
 import (
+	"context"
 	"time"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 )
 
 type ResourceType string
@@ -42,39 +43,62 @@ type ResourceManager interface {
 	GetName() string
 	GetNamespace() string
 	GetKind() ResourceType
-	Delete() error
-	GetPods() ([]PodInfo, error)
+	Delete(ctx context.Context) error
+	GetPods(ctx context.Context) ([]PodInfo, error)
 }
 
 type Client struct {
 	Clientset      kubernetes.Interface
+	Dynamic        dynamic.Interface
+	Cache          *WatchCache
 	Config         *rest.Config
 	Namespace      string
 	KubeconfigPath string
+	Context        string
 }
 
-func NewClient(kubeconfigPath string, namespace string) (*Client, error) {
-	var config *rest.Config
-	var err error
-
-	if kubeconfigPath == "" {
-		return nil, nil
+func NewClient(options KubeconfigOptions, namespace string) (*Client, error) {
+	clientConfig := options.clientConfig()
+	raw, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, err
 	}
 
-	config, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	config, err := clientConfig.ClientConfig()
 	if err != nil {
 		return nil, err
 	}
+	config.Wrap(withRequestTimeout)
+
+	contextName := options.Context
+	if contextName == "" {
+		contextName = raw.CurrentContext
+	}
+	if context, ok := raw.Contexts[contextName]; ok && namespace == "" {
+		namespace = context.Namespace
+	}
 
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
 		Clientset:      clientset,
+		Dynamic:        dynamicClient,
+		Cache:          NewWatchCache(clientset),
 		Config:         config,
 		Namespace:      namespace,
-		KubeconfigPath: kubeconfigPath,
+		KubeconfigPath: options.Path,
+		Context:        contextName,
 	}, nil
 }
+
+func (c Client) ContextName() string {
+	return c.Context
+}
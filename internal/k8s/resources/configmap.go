@@ -34,8 +34,8 @@ func NewConfigmap(name, namespace string, k Client) *Configmap {
 	}
 }
 
-func (c *Configmap) Fetch() error {
-	cm, err := c.Client.CoreV1().ConfigMaps(c.Namespace).Get(context.Background(), c.Name, metav1.GetOptions{})
+func (c *Configmap) Fetch(ctx context.Context) error {
+	cm, err := c.Client.CoreV1().ConfigMaps(c.Namespace).Get(ctx, c.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get configmaps: %v", err)
 	}
@@ -43,56 +43,33 @@ func (c *Configmap) Fetch() error {
 	return nil
 }
 
-func FetchConfigmaps(client Client, namespace string, selector string) ([]Configmap, error) {
-	listOptions := metav1.ListOptions{}
-	if selector != "" {
-		listOptions.LabelSelector = selector
-	}
-	cms, err := client.Clientset.CoreV1().ConfigMaps(namespace).List(context.Background(), listOptions)
+func FetchConfigmaps(ctx context.Context, client Client, namespace string, selector string) ([]Configmap, error) {
+	cms, err := listConfigMaps(ctx, client, namespace, selector)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch pods: %v", err)
+		return nil, fmt.Errorf("failed to fetch configmaps: %v", err)
 	}
 
-	cmsInfo := make([]Configmap, 0, len(cms.Items))
-	for _, cmCore := range cms.Items {
-		cm, err := GetConfigmapDetails(client, namespace, &cmCore)
-		if err != nil {
-			return nil, err
-		}
-		cmsInfo = append(cmsInfo, cm)
+	cmsInfo := make([]Configmap, 0, len(cms))
+	for _, cm := range cms {
+		cmsInfo = append(cmsInfo, Configmap{
+			Namespace: cm.Namespace,
+			Name:      cm.Name,
+			Data:      fmt.Sprintf("%d", len(cm.Data)),
+			Age:       format.FormatAge(cm.GetCreationTimestamp().Time),
+		})
 	}
 
 	return cmsInfo, nil
 }
 
-func GetConfigmapDetails(client Client, namespace string, cmCore *corev1.ConfigMap) (Configmap, error) {
-	cm, err := client.Clientset.CoreV1().ConfigMaps(namespace).Get(
-		context.Background(),
-		cmCore.Name,
-		metav1.GetOptions{},
-	)
-	if err != nil {
-		return Configmap{}, fmt.Errorf("failed to get pod details: %v", err)
-	}
-
-	age := format.FormatAge(cm.GetCreationTimestamp().Time)
-
-	return Configmap{
-		Namespace: cm.Namespace,
-		Name:      cm.Name,
-		Data:      fmt.Sprintf("%d", len(cm.Data)),
-		Age:       age,
-	}, nil
-}
-
-func (c *Configmap) Describe() (string, error) {
+func (c *Configmap) Describe(ctx context.Context) (string, error) {
 	if c.Raw == nil {
-		if err := c.Fetch(); err != nil {
+		if err := c.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch configmap: %v", err)
 		}
 	}
 
-	events, err := c.Client.CoreV1().Events(c.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := c.Client.CoreV1().Events(c.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=ConfigMap", c.Name, c.Namespace),
 	})
 	if err != nil {
@@ -113,63 +90,14 @@ func (c *Configmap) Describe() (string, error) {
 	return c.YAML, nil
 }
 
-func DeleteConfigmap(client Client, namespace string, cmName string) error {
-	err := client.Clientset.CoreV1().ConfigMaps(namespace).Delete(context.Background(), cmName, metav1.DeleteOptions{})
+func DeleteConfigmap(ctx context.Context, client Client, namespace string, cmName string) error {
+	err := client.Clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, cmName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete configmap %s: %v", cmName, err)
 	}
 	return nil
 }
 
-func (c *Configmap) Update(yamlContent string) error {
-	var cmData map[string]interface{}
-	if err := yaml.Unmarshal([]byte(yamlContent), &cmData); err != nil {
-		return fmt.Errorf("failed to parse YAML: %v", err)
-	}
-
-	newCM := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      c.Name,
-			Namespace: c.Namespace,
-		},
-	}
-
-	if data, ok := cmData["data"].(map[string]interface{}); ok {
-		newCM.Data = make(map[string]string)
-		for k, v := range data {
-			if strVal, ok := v.(string); ok {
-				newCM.Data[k] = strVal
-			}
-		}
-	}
-
-	if labels, ok := cmData["labels"].(map[string]interface{}); ok {
-		newCM.Labels = make(map[string]string)
-		for k, v := range labels {
-			if strVal, ok := v.(string); ok {
-				newCM.Labels[k] = strVal
-			}
-		}
-	}
-
-	if annotations, ok := cmData["annotations"].(map[string]interface{}); ok {
-		newCM.Annotations = make(map[string]string)
-		for k, v := range annotations {
-			if strVal, ok := v.(string); ok {
-				newCM.Annotations[k] = strVal
-			}
-		}
-	}
-
-	_, err := c.Client.CoreV1().ConfigMaps(c.Namespace).Update(context.Background(), newCM, metav1.UpdateOptions{})
-	if err != nil {
-		return fmt.Errorf("failed to update configmap: %v", err)
-	}
-
-	c.Raw = newCM
-	return nil
-}
-
 func (cm *Configmap) DescribeConfigMap(events *corev1.EventList) (map[string]any, error) {
 	type Event struct {
 		Type    string `yaml:"type"`
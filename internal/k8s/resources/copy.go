@@ -0,0 +1,266 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+var ErrTarNotFound = errors.New("tar not found in container")
+
+var lsLinePattern = regexp.MustCompile(`^([-dlcbps]\S*)\s+\d+\s+\S+\s+\S+\s+(\d+|\d+,\s*\d+)\s+(\d{4}-\d{2}-\d{2}\s+\S+|\S+\s+\S+\s+\S+)\s+(.+)$`)
+
+type FileEntry struct {
+	Name     string
+	Mode     string
+	Size     int64
+	Modified string
+	Dir      bool
+	Link     string
+}
+
+type CopyProgress func(bytes int64, files int)
+
+func (p *Pod) ListFiles(ctx context.Context, container, dir string) ([]FileEntry, error) {
+	var stdout, stderr bytes.Buffer
+	err := p.ExecStream(ctx, container, []string{"ls", "-lA", dir}, nil, &stdout, &stderr)
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("failed to list %s: %s", dir, msg)
+		}
+		return nil, fmt.Errorf("failed to list %s: %v", dir, err)
+	}
+	return ParseLsOutput(stdout.String()), nil
+}
+
+func ParseLsOutput(output string) []FileEntry {
+	var entries []FileEntry
+	for _, line := range strings.Split(output, "\n") {
+		match := lsLinePattern.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if match == nil {
+			continue
+		}
+
+		entry := FileEntry{
+			Name:     match[4],
+			Mode:     match[1],
+			Modified: match[3],
+			Dir:      match[1][0] == 'd',
+		}
+		if size, err := strconv.ParseInt(match[2], 10, 64); err == nil {
+			entry.Size = size
+		}
+		if match[1][0] == 'l' {
+			if name, target, found := strings.Cut(entry.Name, " -> "); found {
+				entry.Name = name
+				entry.Link = target
+			}
+		}
+		if entry.Name == "." || entry.Name == ".." {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Dir != entries[j].Dir {
+			return entries[i].Dir
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+func (p *Pod) CopyFrom(ctx context.Context, container, remotePath, localDir string, progress CopyProgress) (string, error) {
+	remotePath = path.Clean(remotePath)
+	if remotePath == "/" || remotePath == "." {
+		return "", fmt.Errorf("refusing to copy the container root")
+	}
+	if err := os.MkdirAll(localDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %v", localDir, err)
+	}
+
+	reader, writer := io.Pipe()
+	var stderr bytes.Buffer
+	errc := make(chan error, 1)
+	go func() {
+		err := p.ExecStream(ctx, container, []string{"tar", "cf", "-", "-C", path.Dir(remotePath), path.Base(remotePath)}, nil, writer, &stderr)
+		writer.CloseWithError(err)
+		errc <- err
+	}()
+
+	extractErr := ExtractTar(reader, localDir, progress)
+	reader.CloseWithError(extractErr)
+	if execErr := <-errc; execErr != nil {
+		err := tarExecError(container, stderr.String(), execErr)
+		if extractErr == nil || stderr.Len() > 0 || errors.Is(err, ErrTarNotFound) {
+			return "", err
+		}
+	}
+	if extractErr != nil {
+		return "", extractErr
+	}
+	return filepath.Join(localDir, path.Base(remotePath)), nil
+}
+
+func (p *Pod) CopyTo(ctx context.Context, container, localPath, remoteDir string, progress CopyProgress) (string, error) {
+	if _, err := os.Stat(localPath); err != nil {
+		return "", fmt.Errorf("failed to read %s: %v", localPath, err)
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(WriteTar(writer, localPath, progress))
+	}()
+
+	var stderr bytes.Buffer
+	err := p.ExecStream(ctx, container, []string{"tar", "xmf", "-", "-C", remoteDir}, reader, nil, &stderr)
+	reader.Close()
+	if err != nil {
+		return "", tarExecError(container, stderr.String(), err)
+	}
+	return path.Join(remoteDir, filepath.Base(localPath)), nil
+}
+
+func ExtractTar(r io.Reader, localDir string, progress CopyProgress) error {
+	root, err := filepath.Abs(localDir)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	counter := &copyCounter{progress: progress}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %v", err)
+		}
+
+		target := filepath.Join(root, filepath.FromSlash(path.Clean("/"+header.Name)))
+		if target != root && !strings.HasPrefix(target, root+string(filepath.Separator)) {
+			return fmt.Errorf("archive entry %q escapes the destination", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(io.MultiWriter(file, counter), tr)
+			file.Close()
+			if err != nil {
+				return fmt.Errorf("failed to write %s: %v", target, err)
+			}
+			counter.fileDone()
+		}
+	}
+}
+
+func WriteTar(w io.Writer, localPath string, progress CopyProgress) error {
+	localPath = filepath.Clean(localPath)
+	base := filepath.Dir(localPath)
+
+	tw := tar.NewWriter(w)
+	counter := &copyCounter{progress: progress}
+	err := filepath.Walk(localPath, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil
+		}
+
+		name, err := filepath.Rel(base, file)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if _, err := io.Copy(io.MultiWriter(tw, counter), f); err != nil {
+			return err
+		}
+		counter.fileDone()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+type copyCounter struct {
+	total    int64
+	files    int
+	progress CopyProgress
+}
+
+func (c *copyCounter) Write(p []byte) (int, error) {
+	c.total += int64(len(p))
+	if c.progress != nil {
+		c.progress(c.total, c.files)
+	}
+	return len(p), nil
+}
+
+func (c *copyCounter) fileDone() {
+	c.files++
+	if c.progress != nil {
+		c.progress(c.total, c.files)
+	}
+}
+
+func tarExecError(container, stderr string, err error) error {
+	stderr = strings.TrimSpace(stderr)
+	var exitErr utilexec.ExitError
+	missing := strings.Contains(stderr, "tar: not found") ||
+		strings.Contains(stderr, "executable file not found") ||
+		strings.Contains(err.Error(), "executable file not found")
+	if errors.As(err, &exitErr) && (exitErr.ExitStatus() == 126 || exitErr.ExitStatus() == 127) {
+		missing = true
+	}
+	if missing {
+		return fmt.Errorf("%w: copying files requires tar in container %s", ErrTarNotFound, container)
+	}
+	if stderr != "" {
+		return fmt.Errorf("copy failed: %s", stderr)
+	}
+	return fmt.Errorf("copy failed: %v", err)
+}
@@ -0,0 +1,132 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestParseLsOutput(t *testing.T) {
+	output := `total 24
+drwxr-xr-x    2 root     root          4096 Jan  2 15:04 bin
+-rw-r--r--    1 root     root           512 Jan  2 15:04 my file.txt
+lrwxrwxrwx    1 root     root             7 Mar 10  2023 lib -> usr/lib
+crw-rw-rw-    1 root     root        1,   3 Jan  2 15:04 null
+drwxr-xr-x 1 app app 0 2024-01-02 15:04 cache
+`
+	entries := ParseLsOutput(output)
+	if len(entries) != 5 {
+		t.Fatalf("Expected 5 entries, got %d: %+v", len(entries), entries)
+	}
+
+	if entries[0].Name != "bin" || !entries[0].Dir {
+		t.Errorf("Expected directories first, got %+v", entries[0])
+	}
+	if entries[1].Name != "cache" || !entries[1].Dir {
+		t.Errorf("Expected ISO dates to parse, got %+v", entries[1])
+	}
+
+	byName := map[string]FileEntry{}
+	for _, entry := range entries {
+		byName[entry.Name] = entry
+	}
+	if file := byName["my file.txt"]; file.Size != 512 || file.Dir {
+		t.Errorf("Expected file with spaces and size 512, got %+v", file)
+	}
+	if link := byName["lib"]; link.Link != "usr/lib" {
+		t.Errorf("Expected symlink target usr/lib, got %+v", link)
+	}
+	if _, ok := byName["null"]; !ok {
+		t.Error("Expected device entries to be parsed")
+	}
+}
+
+func TestTarRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	dir := filepath.Join(src, "data")
+	if err := os.MkdirAll(filepath.Join(dir, "nested"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0644)
+	os.WriteFile(filepath.Join(dir, "nested", "b.txt"), []byte("world!"), 0600)
+
+	var archive bytes.Buffer
+	var writtenBytes int64
+	var writtenFiles int
+	if err := WriteTar(&archive, dir, func(bytes int64, files int) {
+		writtenBytes, writtenFiles = bytes, files
+	}); err != nil {
+		t.Fatalf("WriteTar failed: %v", err)
+	}
+	if writtenBytes != 11 || writtenFiles != 2 {
+		t.Errorf("Expected 11 bytes in 2 files, got %d bytes in %d files", writtenBytes, writtenFiles)
+	}
+
+	dest := t.TempDir()
+	var readFiles int
+	if err := ExtractTar(&archive, dest, func(bytes int64, files int) {
+		readFiles = files
+	}); err != nil {
+		t.Fatalf("ExtractTar failed: %v", err)
+	}
+	if readFiles != 2 {
+		t.Errorf("Expected progress for 2 files, got %d", readFiles)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dest, "data", "nested", "b.txt"))
+	if err != nil || string(content) != "world!" {
+		t.Errorf("Expected nested file to be extracted, got %q (%v)", content, err)
+	}
+	info, err := os.Stat(filepath.Join(dest, "data", "nested", "b.txt"))
+	if err == nil && info.Mode().Perm() != 0600 {
+		t.Errorf("Expected file mode 0600 to be preserved, got %v", info.Mode().Perm())
+	}
+}
+
+func TestExtractTarRejectsTraversal(t *testing.T) {
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	tw.WriteHeader(&tar.Header{Name: "../../evil.txt", Mode: 0644, Size: 4, Typeflag: tar.TypeReg})
+	tw.Write([]byte("evil"))
+	tw.Close()
+
+	dest := t.TempDir()
+	if err := ExtractTar(&archive, dest, nil); err != nil {
+		t.Fatalf("Expected the entry to be contained, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "evil.txt")); err != nil {
+		t.Errorf("Expected the entry to be written inside the destination: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(filepath.Dir(dest)), "evil.txt")); err == nil {
+		t.Error("Expected no file outside the destination")
+	}
+}
+
+func TestTarExecError(t *testing.T) {
+	tests := []struct {
+		stderr  string
+		err     error
+		missing bool
+	}{
+		{"sh: tar: not found", errors.New("command terminated with exit code 1"), true},
+		{"", utilexec.CodeExitError{Err: errors.New("exit"), Code: 127}, true},
+		{"", errors.New(`exec: "tar": executable file not found in $PATH`), true},
+		{"tar: /missing: No such file or directory", utilexec.CodeExitError{Err: errors.New("exit"), Code: 2}, false},
+	}
+
+	for _, tt := range tests {
+		err := tarExecError("app", tt.stderr, tt.err)
+		if errors.Is(err, ErrTarNotFound) != tt.missing {
+			t.Errorf("tarExecError(%q, %v) = %v, expected missing=%v", tt.stderr, tt.err, err, tt.missing)
+		}
+		if !tt.missing && !strings.Contains(err.Error(), "No such file") {
+			t.Errorf("Expected stderr to be surfaced, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/yaml"
+)
+
+type CreateResult struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Object    *unstructured.Unstructured
+	Err       error
+}
+
+func (r CreateResult) String() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s %s", r.Kind, r.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+func ParseManifests(content string) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(strings.NewReader(content), 4096)
+	objects := []*unstructured.Unstructured{}
+	for document := 1; ; document++ {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse document %d: %v", document, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+			return nil, fmt.Errorf("document %d is missing apiVersion or kind", document)
+		}
+
+		if !obj.IsList() {
+			objects = append(objects, obj)
+			continue
+		}
+		err := obj.EachListItem(func(item runtime.Object) error {
+			objects = append(objects, item.(*unstructured.Unstructured))
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read list in document %d: %v", document, err)
+		}
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("no objects found")
+	}
+	return objects, nil
+}
+
+func ManifestsYAML(objects []*unstructured.Unstructured) (string, error) {
+	var buf bytes.Buffer
+	for i, obj := range objects {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s %s to YAML: %v", obj.GetKind(), obj.GetName(), err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	return buf.String(), nil
+}
+
+func (c Client) dynamicClient() (dynamic.Interface, error) {
+	if c.Dynamic != nil {
+		return c.Dynamic, nil
+	}
+	if c.Config == nil {
+		return nil, fmt.Errorf("no cluster configuration available")
+	}
+	return dynamic.NewForConfig(c.Config)
+}
+
+func CreateManifests(ctx context.Context, client Client, namespace, content string) ([]CreateResult, error) {
+	objects, err := ParseManifests(content)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := client.dynamicClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %v", err)
+	}
+	groups, err := restmapper.GetAPIGroupResources(client.Clientset.Discovery())
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover API resources: %v", err)
+	}
+	mapper := restmapper.NewDiscoveryRESTMapper(groups)
+
+	results := make([]CreateResult, 0, len(objects))
+	for _, obj := range objects {
+		results = append(results, createObject(ctx, dynamicClient, mapper, namespace, obj))
+	}
+	return results, nil
+}
+
+func createObject(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, namespace string, obj *unstructured.Unstructured) CreateResult {
+	result := CreateResult{Kind: obj.GetKind(), Name: obj.GetName(), Object: obj}
+	if result.Name == "" {
+		result.Name = obj.GetGenerateName() + "*"
+	}
+
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		result.Err = fmt.Errorf("unknown kind %s: %v", gvk, err)
+		return result
+	}
+
+	var resource dynamic.ResourceInterface
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		result.Namespace = obj.GetNamespace()
+		resource = client.Resource(mapping.Resource).Namespace(result.Namespace)
+	} else {
+		if obj.GetNamespace() != "" {
+			result.Err = fmt.Errorf("%s is cluster-scoped and cannot set metadata.namespace", gvk.Kind)
+			return result
+		}
+		resource = client.Resource(mapping.Resource)
+	}
+
+	created, err := resource.Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Name = created.GetName()
+	return result
+}
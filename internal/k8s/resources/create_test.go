@@ -0,0 +1,189 @@
+package k8s
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newCreateClient() Client {
+	clientset := fake.NewSimpleClientset()
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Namespaced: true, Kind: "ConfigMap"},
+				{Name: "namespaces", Namespaced: false, Kind: "Namespace"},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Namespaced: true, Kind: "Deployment"},
+			},
+		},
+	}
+	return Client{
+		Clientset: clientset,
+		Dynamic:   dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+	}
+}
+
+func TestParseManifests(t *testing.T) {
+	objects, err := ParseManifests(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+---
+# comment only
+---
+apiVersion: v1
+kind: List
+items:
+  - apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: second
+  - apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: third
+`)
+	if err != nil {
+		t.Fatalf("ParseManifests failed: %v", err)
+	}
+	names := []string{}
+	for _, obj := range objects {
+		names = append(names, obj.GetName())
+	}
+	if strings.Join(names, ",") != "first,second,third" {
+		t.Errorf("Expected first,second,third, got %v", names)
+	}
+
+	if _, err := ParseManifests("metadata:\n  name: x\n"); err == nil {
+		t.Error("Expected an error for a document without apiVersion and kind")
+	}
+	if _, err := ParseManifests("---\n"); err == nil {
+		t.Error("Expected an error when no objects are found")
+	}
+}
+
+func TestCreateManifests(t *testing.T) {
+	client := newCreateClient()
+
+	results, err := CreateManifests(context.Background(), client, "team-a", `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  mode: fast
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: team-b
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: team-c
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: gadget
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: scoped
+  namespace: team-a
+`)
+	if err != nil {
+		t.Fatalf("CreateManifests failed: %v", err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("Expected 5 results, got %d", len(results))
+	}
+
+	expected := []string{"ConfigMap team-a/settings", "Deployment team-b/web", "Namespace team-c", "Widget gadget", "Namespace scoped"}
+	for i, result := range results {
+		if result.String() != expected[i] {
+			t.Errorf("Expected result %d to be %q, got %q", i, expected[i], result.String())
+		}
+	}
+	for i, wantErr := range []bool{false, false, false, true, true} {
+		if (results[i].Err != nil) != wantErr {
+			t.Errorf("Expected error=%v for %s, got %v", wantErr, results[i], results[i].Err)
+		}
+	}
+
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	created, err := client.Dynamic.Resource(configMaps).Namespace("team-a").Get(context.Background(), "settings", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected the configmap to be created in the default namespace: %v", err)
+	}
+	if mode, _, _ := unstructured.NestedString(created.Object, "data", "mode"); mode != "fast" {
+		t.Errorf("Expected data.mode fast, got %q", mode)
+	}
+
+	again, err := CreateManifests(context.Background(), client, "team-a", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n")
+	if err != nil {
+		t.Fatalf("CreateManifests failed: %v", err)
+	}
+	if again[0].Err == nil {
+		t.Error("Expected an error creating an object that already exists")
+	}
+
+	retry, err := ManifestsYAML([]*unstructured.Unstructured{results[3].Object, results[4].Object})
+	if err != nil {
+		t.Fatalf("ManifestsYAML failed: %v", err)
+	}
+	reparsed, err := ParseManifests(retry)
+	if err != nil || len(reparsed) != 2 || reparsed[1].GetName() != "scoped" {
+		t.Errorf("Expected failed objects to round-trip, got %v (%v)", reparsed, err)
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	for _, template := range BuiltinTemplates("team-a") {
+		objects, err := ParseManifests(template.Content)
+		if err != nil {
+			t.Errorf("Built-in template %s does not parse: %v", template.Name, err)
+			continue
+		}
+		if objects[0].GetNamespace() != "team-a" {
+			t.Errorf("Expected template %s to be prefilled with team-a, got %q", template.Name, objects[0].GetNamespace())
+		}
+	}
+
+	dir := t.TempDir()
+	if templates, err := LoadUserTemplates(filepath.Join(dir, "missing"), "team-a"); err != nil || len(templates) != 0 {
+		t.Errorf("Expected no templates for a missing directory, got %v (%v)", templates, err)
+	}
+
+	os.WriteFile(filepath.Join(dir, "redis.yaml"), []byte("kind: Service\nmetadata:\n  namespace: {{namespace}}\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "app.yml"), []byte("kind: Deployment\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0644)
+	templates, err := LoadUserTemplates(dir, "team-a")
+	if err != nil {
+		t.Fatalf("LoadUserTemplates failed: %v", err)
+	}
+	if len(templates) != 2 || templates[0].Name != "app" || templates[1].Name != "redis" {
+		t.Fatalf("Expected app and redis templates, got %v", templates)
+	}
+	if !strings.Contains(templates[1].Content, "namespace: team-a") || templates[1].Builtin {
+		t.Errorf("Expected the user template to be prefilled, got %+v", templates[1])
+	}
+}
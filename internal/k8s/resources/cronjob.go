@@ -12,6 +12,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const NextRunsCount = 5
+
 type CronJobInfo struct {
 	Namespace    string
 	Name         string
@@ -32,8 +34,8 @@ func NewCronJob(name, namespace string, k Client) *CronJobInfo {
 	}
 }
 
-func FetchCronJobList(client Client, namespace string) ([]string, error) {
-	cronjobs, err := client.Clientset.BatchV1().CronJobs(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchCronJobList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	cronjobs, err := client.Clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch cronjobs: %v", err)
 	}
@@ -46,17 +48,14 @@ func FetchCronJobList(client Client, namespace string) ([]string, error) {
 	return cronjobNames, nil
 }
 
-func GetCronJobsTableData(client Client, namespace string) ([]CronJobInfo, error) {
-	cronjobs, err := client.Clientset.BatchV1().CronJobs(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetCronJobsTableData(ctx context.Context, client Client, namespace string) ([]CronJobInfo, error) {
+	cronjobs, err := listCronJobs(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list cronjobs: %v", err)
 	}
 
 	var cronjobInfos []CronJobInfo
-	for _, cronjob := range cronjobs.Items {
+	for _, cronjob := range cronjobs {
 		suspend := "False"
 		if cronjob.Spec.Suspend != nil && *cronjob.Spec.Suspend {
 			suspend = "True"
@@ -85,8 +84,8 @@ func GetCronJobsTableData(client Client, namespace string) ([]CronJobInfo, error
 	return cronjobInfos, nil
 }
 
-func (cj *CronJobInfo) Fetch() error {
-	cronjob, err := cj.Client.Clientset.BatchV1().CronJobs(cj.Namespace).Get(context.Background(), cj.Name, metav1.GetOptions{})
+func (cj *CronJobInfo) Fetch(ctx context.Context) error {
+	cronjob, err := cj.Client.Clientset.BatchV1().CronJobs(cj.Namespace).Get(ctx, cj.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get cronjob: %v", err)
 	}
@@ -94,21 +93,21 @@ func (cj *CronJobInfo) Fetch() error {
 	return nil
 }
 
-func (cj *CronJobInfo) Describe() (string, error) {
+func (cj *CronJobInfo) Describe(ctx context.Context) (string, error) {
 	if cj.Raw == nil {
-		if err := cj.Fetch(); err != nil {
+		if err := cj.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch cronjob: %v", err)
 		}
 	}
 
-	events, err := cj.Client.Clientset.CoreV1().Events(cj.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := cj.Client.Clientset.CoreV1().Events(cj.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=CronJob", cj.Name, cj.Namespace),
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to get cronjob events: %v", err)
 	}
 
-	data, err := cj.DescribeCronJob(events)
+	data, err := cj.DescribeCronJob(ctx, events)
 	if err != nil {
 		return "", fmt.Errorf("failed to describe cronjob: %v", err)
 	}
@@ -121,7 +120,7 @@ func (cj *CronJobInfo) Describe() (string, error) {
 	return string(yamlData), nil
 }
 
-func (cj *CronJobInfo) DescribeCronJob(events *corev1.EventList) (map[string]any, error) {
+func (cj *CronJobInfo) DescribeCronJob(ctx context.Context, events *corev1.EventList) (map[string]any, error) {
 	type Event struct {
 		Type    string `yaml:"type"`
 		Reason  string `yaml:"reason"`
@@ -139,6 +138,19 @@ func (cj *CronJobInfo) DescribeCronJob(events *corev1.EventList) (map[string]any
 	}
 
 	desc["schedule"] = cj.Raw.Spec.Schedule
+	if cj.Raw.Spec.TimeZone != nil {
+		desc["timeZone"] = *cj.Raw.Spec.TimeZone
+	}
+	if schedule, err := cj.ParseSchedule(ctx); err != nil {
+		desc["scheduleError"] = err.Error()
+	} else {
+		desc["scheduleDescription"] = schedule.Describe()
+		nextRuns := []string{}
+		for _, run := range schedule.NextN(time.Now(), NextRunsCount) {
+			nextRuns = append(nextRuns, run.Format("2006-01-02 15:04:05 MST"))
+		}
+		desc["nextRuns"] = nextRuns
+	}
 
 	if cj.Raw.Spec.Suspend != nil {
 		desc["suspend"] = *cj.Raw.Spec.Suspend
@@ -206,8 +218,8 @@ func (cj *CronJobInfo) DescribeCronJob(events *corev1.EventList) (map[string]any
 	return desc, nil
 }
 
-func DeleteCronJob(client Client, namespace string, cronjobName string) error {
-	err := client.Clientset.BatchV1().CronJobs(namespace).Delete(context.Background(), cronjobName, metav1.DeleteOptions{})
+func DeleteCronJob(ctx context.Context, client Client, namespace string, cronjobName string) error {
+	err := client.Clientset.BatchV1().CronJobs(namespace).Delete(ctx, cronjobName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete cronjob %s: %v", cronjobName, err)
 	}
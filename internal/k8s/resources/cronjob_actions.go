@@ -0,0 +1,129 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/pkg/cron"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+const InstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+func (cj *CronJobInfo) Trigger(ctx context.Context) (*batchv1.Job, error) {
+	if err := cj.Fetch(ctx); err != nil {
+		return nil, err
+	}
+
+	name := cj.Name + "-manual-" + utilrand.String(5)
+	if len(name) > 63 {
+		name = cj.Name[:63-len("-manual-xxxxx")] + name[len(cj.Name):]
+	}
+
+	template := cj.Raw.Spec.JobTemplate
+	annotations := map[string]string{}
+	for k, v := range template.Annotations {
+		annotations[k] = v
+	}
+	annotations[InstantiateAnnotation] = "manual"
+	labels := map[string]string{}
+	for k, v := range template.Labels {
+		labels[k] = v
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   cj.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cj.Raw, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+
+	created, err := cj.Client.Clientset.BatchV1().Jobs(cj.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to trigger cronjob %s: %v", cj.Name, err)
+	}
+	return created, nil
+}
+
+func SetCronJobSuspended(ctx context.Context, client Client, namespace, name string, suspend bool) error {
+	patch := fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)
+	_, err := client.Clientset.BatchV1().CronJobs(namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		action := "resume"
+		if suspend {
+			action = "suspend"
+		}
+		return fmt.Errorf("failed to %s cronjob %s: %v", action, name, err)
+	}
+	return nil
+}
+
+func (cj *CronJobInfo) GetJobs(ctx context.Context) ([]JobInfo, error) {
+	if cj.Raw == nil {
+		if err := cj.Fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	jobs, err := listJobs(ctx, cj.Client, cj.Namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs for cronjob %s: %v", cj.Name, err)
+	}
+
+	var owned []batchv1.Job
+	for _, job := range jobs {
+		if metav1.IsControlledBy(&job, cj.Raw) {
+			owned = append(owned, job)
+		}
+	}
+	sort.SliceStable(owned, func(i, j int) bool {
+		return owned[j].CreationTimestamp.Before(&owned[i].CreationTimestamp)
+	})
+
+	jobInfos := make([]JobInfo, 0, len(owned))
+	for i := range owned {
+		jobInfos = append(jobInfos, newJobInfo(cj.Client, &owned[i]))
+	}
+	return jobInfos, nil
+}
+
+func (cj *CronJobInfo) ParseSchedule(ctx context.Context) (*cron.Schedule, error) {
+	if cj.Raw == nil {
+		if err := cj.Fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	schedule, err := cron.Parse(cj.Raw.Spec.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	if cj.Raw.Spec.TimeZone != nil && *cj.Raw.Spec.TimeZone != "" {
+		location, err := time.LoadLocation(*cj.Raw.Spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %v", *cj.Raw.Spec.TimeZone, err)
+		}
+		schedule = schedule.In(location)
+	}
+	return schedule, nil
+}
+
+func (cj *CronJobInfo) NextRuns(ctx context.Context, after time.Time, n int) ([]time.Time, *time.Location, error) {
+	schedule, err := cj.ParseSchedule(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule.NextN(after, n), schedule.Location, nil
+}
@@ -0,0 +1,121 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestCronJob() *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default", UID: "cron-uid"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "30 2 * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"app": "nightly"},
+					Annotations: map[string]string{"team": "data"},
+				},
+				Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "task", Image: "task:1"}},
+				}}},
+			},
+		},
+	}
+}
+
+func TestCronJobTrigger(t *testing.T) {
+	cronjob := newTestCronJob()
+	clientset := fake.NewSimpleClientset(cronjob)
+	client := Client{Clientset: clientset, Namespace: "default"}
+
+	job, err := NewCronJob("nightly", "default", client).Trigger(context.Background())
+	if err != nil {
+		t.Fatalf("Trigger failed: %v", err)
+	}
+	if !strings.HasPrefix(job.Name, "nightly-manual-") {
+		t.Errorf("Expected a manual job name, got %s", job.Name)
+	}
+	if job.Annotations[InstantiateAnnotation] != "manual" || job.Annotations["team"] != "data" {
+		t.Errorf("Expected template and instantiate annotations, got %v", job.Annotations)
+	}
+	if job.Labels["app"] != "nightly" {
+		t.Errorf("Expected template labels, got %v", job.Labels)
+	}
+	if !metav1.IsControlledBy(job, cronjob) {
+		t.Errorf("Expected the job to be controlled by the cronjob, got %+v", job.OwnerReferences)
+	}
+	if job.Spec.Template.Spec.Containers[0].Image != "task:1" {
+		t.Errorf("Expected the job spec to come from the template, got %+v", job.Spec)
+	}
+
+	long := newTestCronJob()
+	long.Name = strings.Repeat("a", 60)
+	clientset.BatchV1().CronJobs("default").Create(context.Background(), long, metav1.CreateOptions{})
+	job, err = NewCronJob(long.Name, "default", client).Trigger(context.Background())
+	if err != nil {
+		t.Fatalf("Trigger failed: %v", err)
+	}
+	if len(job.Name) > 63 {
+		t.Errorf("Expected the job name to fit 63 characters, got %d", len(job.Name))
+	}
+}
+
+func TestSetCronJobSuspended(t *testing.T) {
+	clientset := fake.NewSimpleClientset(newTestCronJob())
+	client := Client{Clientset: clientset, Namespace: "default"}
+
+	if err := SetCronJobSuspended(context.Background(), client, "default", "nightly", true); err != nil {
+		t.Fatalf("SetCronJobSuspended failed: %v", err)
+	}
+	cronjob, _ := clientset.BatchV1().CronJobs("default").Get(context.Background(), "nightly", metav1.GetOptions{})
+	if cronjob.Spec.Suspend == nil || !*cronjob.Spec.Suspend {
+		t.Error("Expected the cronjob to be suspended")
+	}
+}
+
+func TestCronJobGetJobs(t *testing.T) {
+	cronjob := newTestCronJob()
+	owner := *metav1.NewControllerRef(cronjob, batchv1.SchemeGroupVersion.WithKind("CronJob"))
+	now := time.Now()
+	clientset := fake.NewSimpleClientset(
+		cronjob,
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "nightly-1", Namespace: "default", OwnerReferences: []metav1.OwnerReference{owner}, CreationTimestamp: metav1.Time{Time: now.Add(-2 * time.Hour)}}},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "nightly-2", Namespace: "default", OwnerReferences: []metav1.OwnerReference{owner}, CreationTimestamp: metav1.Time{Time: now.Add(-time.Hour)}}},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}},
+	)
+	client := Client{Clientset: clientset, Namespace: "default"}
+
+	jobs, err := NewCronJob("nightly", "default", client).GetJobs(context.Background())
+	if err != nil {
+		t.Fatalf("GetJobs failed: %v", err)
+	}
+	if len(jobs) != 2 || jobs[0].Name != "nightly-2" || jobs[1].Name != "nightly-1" {
+		t.Errorf("Expected owned jobs newest first, got %+v", jobs)
+	}
+}
+
+func TestCronJobNextRuns(t *testing.T) {
+	cronjob := newTestCronJob()
+	timeZone := "Asia/Tokyo"
+	cronjob.Spec.TimeZone = &timeZone
+	client := Client{Clientset: fake.NewSimpleClientset(cronjob), Namespace: "default"}
+
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	runs, location, err := NewCronJob("nightly", "default", client).NextRuns(context.Background(), after, 2)
+	if err != nil {
+		t.Fatalf("NextRuns failed: %v", err)
+	}
+	if location.String() != "Asia/Tokyo" || len(runs) != 2 {
+		t.Fatalf("Expected 2 runs in Asia/Tokyo, got %v in %v", runs, location)
+	}
+	if expected := time.Date(2024, 1, 2, 2, 30, 0, 0, location); !runs[0].Equal(expected) {
+		t.Errorf("Expected first run at %v, got %v", expected, runs[0])
+	}
+}
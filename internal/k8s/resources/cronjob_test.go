@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -141,7 +142,7 @@ func TestCronJobDescribe(t *testing.T) {
 	}
 
 	events := &corev1.EventList{}
-	desc, err := cronjobInfo.DescribeCronJob(events)
+	desc, err := cronjobInfo.DescribeCronJob(context.Background(), events)
 	if err != nil {
 		t.Errorf("DescribeCronJob failed: %v", err)
 	}
@@ -155,6 +156,12 @@ func TestCronJobDescribe(t *testing.T) {
 	if desc["schedule"] != "*/5 * * * *" {
 		t.Error("Expected schedule to be '*/5 * * * *'")
 	}
+	if desc["scheduleDescription"] != "Every 5 minutes" {
+		t.Errorf("Expected a human-readable schedule, got %v", desc["scheduleDescription"])
+	}
+	if runs, ok := desc["nextRuns"].([]string); !ok || len(runs) != NextRunsCount {
+		t.Errorf("Expected %d next runs, got %v", NextRunsCount, desc["nextRuns"])
+	}
 	if desc["suspend"] != false {
 		t.Error("Expected suspend to be false")
 	}
@@ -34,8 +34,8 @@ func NewDaemonSet(name, namespace string, k Client) *DaemonSetInfo {
 	}
 }
 
-func FetchDaemonSetList(client Client, namespace string) ([]string, error) {
-	daemonsets, err := client.Clientset.AppsV1().DaemonSets(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchDaemonSetList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	daemonsets, err := client.Clientset.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch daemonsets: %v", err)
 	}
@@ -48,17 +48,14 @@ func FetchDaemonSetList(client Client, namespace string) ([]string, error) {
 	return daemonsetNames, nil
 }
 
-func GetDaemonSetsTableData(client Client, namespace string) ([]DaemonSetInfo, error) {
-	daemonsets, err := client.Clientset.AppsV1().DaemonSets(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetDaemonSetsTableData(ctx context.Context, client Client, namespace string) ([]DaemonSetInfo, error) {
+	daemonsets, err := listDaemonSets(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list daemonsets: %v", err)
 	}
 
 	var daemonsetInfos []DaemonSetInfo
-	for _, daemonset := range daemonsets.Items {
+	for _, daemonset := range daemonsets {
 		desired := fmt.Sprintf("%d", daemonset.Status.DesiredNumberScheduled)
 		current := fmt.Sprintf("%d", daemonset.Status.CurrentNumberScheduled)
 		ready := fmt.Sprintf("%d", daemonset.Status.NumberReady)
@@ -91,8 +88,8 @@ func GetDaemonSetsTableData(client Client, namespace string) ([]DaemonSetInfo, e
 	return daemonsetInfos, nil
 }
 
-func (ds *DaemonSetInfo) Fetch() error {
-	daemonset, err := ds.Client.Clientset.AppsV1().DaemonSets(ds.Namespace).Get(context.Background(), ds.Name, metav1.GetOptions{})
+func (ds *DaemonSetInfo) Fetch(ctx context.Context) error {
+	daemonset, err := ds.Client.Clientset.AppsV1().DaemonSets(ds.Namespace).Get(ctx, ds.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get daemonset: %v", err)
 	}
@@ -100,14 +97,14 @@ func (ds *DaemonSetInfo) Fetch() error {
 	return nil
 }
 
-func (ds *DaemonSetInfo) Describe() (string, error) {
+func (ds *DaemonSetInfo) Describe(ctx context.Context) (string, error) {
 	if ds.Raw == nil {
-		if err := ds.Fetch(); err != nil {
+		if err := ds.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch daemonset: %v", err)
 		}
 	}
 
-	events, err := ds.Client.Clientset.CoreV1().Events(ds.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := ds.Client.Clientset.CoreV1().Events(ds.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=DaemonSet", ds.Name, ds.Namespace),
 	})
 	if err != nil {
@@ -210,8 +207,25 @@ func (ds *DaemonSetInfo) DescribeDaemonSet(events *corev1.EventList) (map[string
 	return desc, nil
 }
 
-func DeleteDaemonSet(client Client, namespace string, daemonsetName string) error {
-	err := client.Clientset.AppsV1().DaemonSets(namespace).Delete(context.Background(), daemonsetName, metav1.DeleteOptions{})
+func (ds *DaemonSetInfo) GetLabelSelector() (string, error) {
+	if ds.Raw == nil {
+		return "", fmt.Errorf("daemonset raw data not available")
+	}
+
+	if ds.Raw.Spec.Selector == nil {
+		return "", fmt.Errorf("daemonset has no selector")
+	}
+
+	requirements, err := metav1.LabelSelectorAsSelector(ds.Raw.Spec.Selector)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert label selector: %v", err)
+	}
+
+	return requirements.String(), nil
+}
+
+func DeleteDaemonSet(ctx context.Context, client Client, namespace string, daemonsetName string) error {
+	err := client.Clientset.AppsV1().DaemonSets(namespace).Delete(ctx, daemonsetName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete daemonset %s: %v", daemonsetName, err)
 	}
@@ -0,0 +1,142 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const debugContainerTimeout = 2 * time.Minute
+
+func (p *Pod) AddDebugContainer(ctx context.Context, image, target string) (string, error) {
+	pod, err := p.Client.CoreV1().Pods(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pod %s: %v", p.Name, err)
+	}
+
+	names := map[string]bool{}
+	for _, c := range pod.Spec.Containers {
+		names[c.Name] = true
+	}
+	for _, c := range pod.Spec.InitContainers {
+		names[c.Name] = true
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		names[c.Name] = true
+	}
+	name := "debugger-" + utilrand.String(5)
+	for names[name] {
+		name = "debugger-" + utilrand.String(5)
+	}
+
+	debugPod := pod.DeepCopy()
+	debugPod.Spec.EphemeralContainers = append(debugPod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    image,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		},
+		TargetContainerName: target,
+	})
+
+	original, err := json.Marshal(pod)
+	if err != nil {
+		return "", err
+	}
+	modified, err := json.Marshal(debugPod)
+	if err != nil {
+		return "", err
+	}
+	patch, err := strategicpatch.CreateTwoWayMergePatch(original, modified, pod)
+	if err != nil {
+		return "", fmt.Errorf("failed to create debug container patch: %v", err)
+	}
+
+	_, err = p.Client.CoreV1().Pods(p.Namespace).Patch(ctx, p.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}, "ephemeralcontainers")
+	if err != nil {
+		return "", fmt.Errorf("failed to add debug container to pod %s: %v", p.Name, err)
+	}
+	return name, nil
+}
+
+func (p *Pod) WaitForContainerRunning(ctx context.Context, container string) error {
+	var waiting string
+	err := wait.PollUntilContextTimeout(ctx, time.Second, debugContainerTimeout, true, func(ctx context.Context) (bool, error) {
+		pod, err := p.Client.CoreV1().Pods(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, status := range pod.Status.EphemeralContainerStatuses {
+			if status.Name != container {
+				continue
+			}
+			switch {
+			case status.State.Running != nil:
+				return true, nil
+			case status.State.Terminated != nil:
+				return false, fmt.Errorf("container %s terminated: %s", container, status.State.Terminated.Reason)
+			case status.State.Waiting != nil:
+				waiting = status.State.Waiting.Reason
+				if status.State.Waiting.Message != "" {
+					waiting += ": " + status.State.Waiting.Message
+				}
+			}
+		}
+		return false, nil
+	})
+	if wait.Interrupted(err) {
+		if waiting != "" {
+			return fmt.Errorf("timed out waiting for container %s (%s)", container, waiting)
+		}
+		return fmt.Errorf("timed out waiting for container %s", container)
+	}
+	return err
+}
+
+func (p *Pod) AttachWithTTY(ctx context.Context, container string, stdin io.Reader, stdout io.Writer, sizes remotecommand.TerminalSizeQueue) error {
+	req := p.Client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(p.Name).
+		Namespace(p.Namespace).
+		SubResource("attach").
+		VersionedParams(&corev1.PodAttachOptions{
+			Container: container,
+			Stdin:     true,
+			Stdout:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(p.Config, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create executor: %v", err)
+	}
+
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Tty:               true,
+		TerminalSizeQueue: sizes,
+	})
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return nil
+	}
+	return err
+}
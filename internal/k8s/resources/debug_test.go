@@ -0,0 +1,64 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAddDebugContainer(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "distroless"}},
+		},
+	})
+	pod := &Pod{Name: "web", Namespace: "default", Client: client}
+
+	name, err := pod.AddDebugContainer(context.Background(), "busybox:latest", "app")
+	if err != nil {
+		t.Fatalf("AddDebugContainer failed: %v", err)
+	}
+	if !strings.HasPrefix(name, "debugger-") {
+		t.Errorf("Expected a generated debugger name, got %s", name)
+	}
+
+	patched, err := client.CoreV1().Pods("default").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patched.Spec.EphemeralContainers) != 1 {
+		t.Fatalf("Expected one ephemeral container, got %d", len(patched.Spec.EphemeralContainers))
+	}
+	debug := patched.Spec.EphemeralContainers[0]
+	if debug.Name != name || debug.Image != "busybox:latest" || debug.TargetContainerName != "app" || !debug.TTY || !debug.Stdin {
+		t.Errorf("Unexpected ephemeral container %+v", debug)
+	}
+	if len(patched.Spec.Containers) != 1 {
+		t.Errorf("Expected regular containers to be untouched, got %d", len(patched.Spec.Containers))
+	}
+}
+
+func TestWaitForContainerRunning(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Status: corev1.PodStatus{
+			EphemeralContainerStatuses: []corev1.ContainerStatus{
+				{Name: "debugger-ok", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "debugger-bad", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error"}}},
+			},
+		},
+	})
+	pod := &Pod{Name: "web", Namespace: "default", Client: client}
+
+	if err := pod.WaitForContainerRunning(context.Background(), "debugger-ok"); err != nil {
+		t.Errorf("Expected running container, got %v", err)
+	}
+	if err := pod.WaitForContainerRunning(context.Background(), "debugger-bad"); err == nil || !strings.Contains(err.Error(), "terminated") {
+		t.Errorf("Expected terminated error, got %v", err)
+	}
+}
@@ -29,9 +29,9 @@ func NewDeployment(name, namespace string, k Client) *DeploymentInfo {
 	}
 }
 
-func (d *DeploymentInfo) Fetch() error {
+func (d *DeploymentInfo) Fetch(ctx context.Context) error {
 	deployment, err := d.Client.Clientset.AppsV1().Deployments(d.Namespace).Get(
-		context.Background(),
+		ctx,
 		d.Name,
 		metav1.GetOptions{},
 	)
@@ -42,8 +42,8 @@ func (d *DeploymentInfo) Fetch() error {
 	return nil
 }
 
-func FetchDeploymentList(client Client, namespace string) ([]string, error) {
-	ds, err := client.Clientset.AppsV1().Deployments(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchDeploymentList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	ds, err := client.Clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch deployments: %v", err)
 	}
@@ -56,28 +56,16 @@ func FetchDeploymentList(client Client, namespace string) ([]string, error) {
 	return deploymentNames, nil
 }
 
-func GetDeploymentsTableData(client Client, namespace string) ([]DeploymentInfo, error) {
-	deployments, err := client.Clientset.AppsV1().Deployments(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetDeploymentsTableData(ctx context.Context, client Client, namespace string) ([]DeploymentInfo, error) {
+	deployments, err := listDeployments(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list deployments: %v", err)
 	}
 
 	var deploymentInfos []DeploymentInfo
-	for _, deployment := range deployments.Items {
-		freshDeployment, err := client.Clientset.AppsV1().Deployments(namespace).Get(
-			context.Background(),
-			deployment.Name,
-			metav1.GetOptions{},
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get fresh status for deployment %s: %v", deployment.Name, err)
-		}
-
-		status := freshDeployment.Status
-		spec := freshDeployment.Spec
+	for _, deployment := range deployments {
+		status := deployment.Status
+		spec := deployment.Spec
 
 		var desiredReplicas int32
 		if spec.Replicas != nil {
@@ -87,13 +75,13 @@ func GetDeploymentsTableData(client Client, namespace string) ([]DeploymentInfo,
 		readyStr := fmt.Sprintf("%d/%d", status.ReadyReplicas, desiredReplicas)
 
 		deploymentInfos = append(deploymentInfos, DeploymentInfo{
-			Namespace: freshDeployment.Namespace,
-			Name:      freshDeployment.Name,
+			Namespace: deployment.Namespace,
+			Name:      deployment.Name,
 			Ready:     readyStr,
 			UpToDate:  fmt.Sprintf("%d", status.UpdatedReplicas),
 			Available: fmt.Sprintf("%d", status.AvailableReplicas),
-			Age:       format.FormatAge(freshDeployment.CreationTimestamp.Time),
-			Raw:       freshDeployment.DeepCopy(),
+			Age:       format.FormatAge(deployment.CreationTimestamp.Time),
+			Raw:       deployment.DeepCopy(),
 			Client:    client,
 		})
 	}
@@ -101,12 +89,12 @@ func GetDeploymentsTableData(client Client, namespace string) ([]DeploymentInfo,
 	return deploymentInfos, nil
 }
 
-func (d *DeploymentInfo) GetPods() ([]PodInfo, error) {
+func (d *DeploymentInfo) GetPods(ctx context.Context) ([]PodInfo, error) {
 	selector, err := d.GetLabelSelector()
 	if err != nil {
 		return nil, err
 	}
-	pods, err := FetchPods(d.Client, d.Namespace, selector)
+	pods, err := FetchPods(ctx, d.Client, d.Namespace, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -131,8 +119,8 @@ func (d *DeploymentInfo) GetLabelSelector() (string, error) {
 	return requirements.String(), nil
 }
 
-func DeleteDeployment(client Client, namespace string, deploymentName string) error {
-	err := client.Clientset.AppsV1().Deployments(namespace).Delete(context.Background(), deploymentName, metav1.DeleteOptions{})
+func DeleteDeployment(ctx context.Context, client Client, namespace string, deploymentName string) error {
+	err := client.Clientset.AppsV1().Deployments(namespace).Delete(ctx, deploymentName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete deployment %s: %v", deploymentName, err)
 	}
@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"context"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -156,7 +157,7 @@ func TestDeploymentInfo_Fetch(t *testing.T) {
 	}
 
 	
-	err := deploymentInfo.Fetch()
+	err := deploymentInfo.Fetch(context.Background())
 	if err != nil {
 		t.Errorf("Fetch failed: %v", err)
 	}
@@ -236,7 +237,7 @@ func TestDeploymentInfo_GetPods(t *testing.T) {
 	}
 
 	
-	pods, err := deploymentInfo.GetPods()
+	pods, err := deploymentInfo.GetPods(context.Background())
 	if err != nil {
 		t.Errorf("GetPods failed: %v", err)
 	}
@@ -278,7 +279,7 @@ func TestDeploymentInfo_GetPods_NoSelector(t *testing.T) {
 	}
 
 	
-	_, err := deploymentInfo.GetPods()
+	_, err := deploymentInfo.GetPods(context.Background())
 	if err == nil {
 		t.Error("Expected error when deployment has no selector")
 	}
@@ -300,7 +301,7 @@ func TestDeploymentInfo_GetPods_NoRawData(t *testing.T) {
 	}
 
 	
-	_, err := deploymentInfo.GetPods()
+	_, err := deploymentInfo.GetPods(context.Background())
 	if err == nil {
 		t.Error("Expected error when deployment has no raw data")
 	}
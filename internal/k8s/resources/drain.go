@@ -0,0 +1,237 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const MirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+var drainPollInterval = 2 * time.Second
+
+type EvictionState string
+
+const (
+	EvictionPending  EvictionState = "Pending"
+	EvictionBlocked  EvictionState = "Blocked"
+	EvictionEvicting EvictionState = "Evicting"
+	EvictionDeleted  EvictionState = "Deleted"
+	EvictionFailed   EvictionState = "Failed"
+	EvictionSkipped  EvictionState = "Skipped"
+)
+
+type DrainOptions struct {
+	GracePeriod        *time.Duration
+	Timeout            time.Duration
+	Force              bool
+	DeleteEmptyDirData bool
+}
+
+type PodEviction struct {
+	Namespace string
+	Name      string
+	State     EvictionState
+	Message   string
+}
+
+func (e PodEviction) Finished() bool {
+	return e.State == EvictionDeleted || e.State == EvictionFailed || e.State == EvictionSkipped
+}
+
+func SetNodeUnschedulable(ctx context.Context, client Client, name string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := client.Clientset.CoreV1().Nodes().Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		action := "uncordon"
+		if unschedulable {
+			action = "cordon"
+		}
+		return fmt.Errorf("failed to %s node %s: %v", action, name, err)
+	}
+	return nil
+}
+
+func (n *NodeInfo) Drain(ctx context.Context, opts DrainOptions, progress func(PodEviction)) error {
+	if err := SetNodeUnschedulable(ctx, n.Client, n.Name, true); err != nil {
+		return err
+	}
+
+	pods, err := n.Client.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", n.Name).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods on node %s: %v", n.Name, err)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		if pods.Items[i].Namespace != pods.Items[j].Namespace {
+			return pods.Items[i].Namespace < pods.Items[j].Namespace
+		}
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var failed []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		eviction := PodEviction{Namespace: pod.Namespace, Name: pod.Name, State: EvictionPending}
+		if reason := drainSkipReason(pod); reason != "" {
+			eviction.State = EvictionSkipped
+			eviction.Message = reason
+			reportEviction(progress, eviction)
+			continue
+		}
+		if reason := drainRefuseReason(pod, opts); reason != "" {
+			eviction.State = EvictionFailed
+			eviction.Message = reason
+			reportEviction(progress, eviction)
+			mu.Lock()
+			failed = append(failed, fmt.Sprintf("%s/%s: %s", pod.Namespace, pod.Name, reason))
+			mu.Unlock()
+			continue
+		}
+		reportEviction(progress, eviction)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := n.evictPod(ctx, pod, opts, progress)
+			if result.State != EvictionDeleted {
+				mu.Lock()
+				failed = append(failed, fmt.Sprintf("%s/%s: %s", result.Namespace, result.Name, result.Message))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("failed to drain node %s:\n%s", n.Name, strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+func drainSkipReason(pod *corev1.Pod) string {
+	if _, ok := pod.Annotations[MirrorPodAnnotation]; ok {
+		return "mirror pod"
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return "managed by DaemonSet " + owner.Name
+	}
+	return ""
+}
+
+func drainRefuseReason(pod *corev1.Pod, opts DrainOptions) string {
+	if metav1.GetControllerOf(pod) == nil && !opts.Force {
+		return "not managed by a controller, use force=true to delete it"
+	}
+	if !opts.DeleteEmptyDirData {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.EmptyDir != nil {
+				return "uses emptyDir volume " + volume.Name + ", use delete-emptydir-data=true to delete its data"
+			}
+		}
+	}
+	return ""
+}
+
+func (n *NodeInfo) evictPod(ctx context.Context, pod *corev1.Pod, opts DrainOptions, progress func(PodEviction)) PodEviction {
+	eviction := PodEviction{Namespace: pod.Namespace, Name: pod.Name, State: EvictionPending}
+	request := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+	}
+	if opts.GracePeriod != nil {
+		seconds := int64(opts.GracePeriod.Seconds())
+		request.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: &seconds}
+	}
+
+	for {
+		err := n.Client.Clientset.PolicyV1().Evictions(pod.Namespace).Evict(ctx, request)
+		if err == nil || apierrors.IsNotFound(err) {
+			break
+		}
+		if apierrors.IsTooManyRequests(err) {
+			eviction.State = EvictionBlocked
+			eviction.Message = "blocked by PodDisruptionBudget: " + err.Error()
+			reportEviction(progress, eviction)
+		} else {
+			eviction.State = EvictionFailed
+			eviction.Message = err.Error()
+			reportEviction(progress, eviction)
+			return eviction
+		}
+		if !sleepContext(ctx, drainPollInterval) {
+			return stopEviction(ctx, eviction, progress)
+		}
+	}
+
+	eviction.State = EvictionEvicting
+	eviction.Message = ""
+	reportEviction(progress, eviction)
+
+	for {
+		current, err := n.Client.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+			eviction.State = EvictionDeleted
+			reportEviction(progress, eviction)
+			return eviction
+		}
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+			eviction.Message = err.Error()
+			reportEviction(progress, eviction)
+		}
+		if !sleepContext(ctx, drainPollInterval) {
+			return stopEviction(ctx, eviction, progress)
+		}
+	}
+}
+
+func stopEviction(ctx context.Context, eviction PodEviction, progress func(PodEviction)) PodEviction {
+	reason := "timed out"
+	if errors.Is(ctx.Err(), context.Canceled) {
+		reason = "canceled"
+	}
+	if eviction.Message != "" {
+		reason += ", " + eviction.Message
+	}
+	eviction.Message = reason
+	eviction.State = EvictionFailed
+	reportEviction(progress, eviction)
+	return eviction
+}
+
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func reportEviction(progress func(PodEviction), eviction PodEviction) {
+	if progress != nil {
+		progress(eviction)
+	}
+}
@@ -0,0 +1,194 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestSetNodeUnschedulable(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	client := Client{Clientset: clientset}
+
+	if err := SetNodeUnschedulable(context.Background(), client, "node-1", true); err != nil {
+		t.Fatalf("cordon failed: %v", err)
+	}
+	node, _ := clientset.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+	if !node.Spec.Unschedulable {
+		t.Error("Expected the node to be cordoned")
+	}
+
+	if err := SetNodeUnschedulable(context.Background(), client, "node-1", false); err != nil {
+		t.Fatalf("uncordon failed: %v", err)
+	}
+	node, _ = clientset.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+	if node.Spec.Unschedulable {
+		t.Error("Expected the node to be uncordoned")
+	}
+}
+
+func TestNodeDrain(t *testing.T) {
+	drainPollInterval = 10 * time.Millisecond
+	defer func() { drainPollInterval = 2 * time.Second }()
+
+	controller := true
+	pod := func(name string, mutate func(*corev1.Pod)) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "default",
+				UID:             types.UID("uid-" + name),
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-abc", Controller: &controller}},
+			},
+			Spec: corev1.PodSpec{NodeName: "node-1"},
+		}
+		if mutate != nil {
+			mutate(p)
+		}
+		return p
+	}
+	clientset := fake.NewSimpleClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		pod("web", nil),
+		pod("guarded", nil),
+		pod("logger", func(p *corev1.Pod) {
+			p.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "fluentd", Controller: &controller}}
+		}),
+		pod("static", func(p *corev1.Pod) {
+			p.Annotations = map[string]string{MirrorPodAnnotation: "hash"}
+		}),
+		pod("bare", func(p *corev1.Pod) {
+			p.OwnerReferences = nil
+		}),
+		pod("cache", func(p *corev1.Pod) {
+			p.Spec.Volumes = []corev1.Volume{{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+		}),
+	)
+
+	var mu sync.Mutex
+	evicted := map[string]*policyv1.Eviction{}
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+		if eviction.Name == "guarded" {
+			return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		}
+		mu.Lock()
+		evicted[eviction.Name] = eviction
+		mu.Unlock()
+		clientset.Tracker().Delete(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, eviction.Namespace, eviction.Name)
+		return true, nil, nil
+	})
+
+	var updates sync.Map
+	grace := 5 * time.Second
+	node := NewNode("node-1", Client{Clientset: clientset})
+	err := node.Drain(context.Background(), DrainOptions{GracePeriod: &grace, Timeout: 200 * time.Millisecond}, func(e PodEviction) {
+		updates.Store(e.Name, e)
+	})
+	if err == nil || !strings.Contains(err.Error(), "default/guarded") {
+		t.Fatalf("Expected the PDB-blocked pod to fail the drain, got %v", err)
+	}
+
+	n, _ := clientset.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+	if !n.Spec.Unschedulable {
+		t.Error("Expected drain to cordon the node")
+	}
+
+	state := func(name string) PodEviction {
+		value, _ := updates.Load(name)
+		eviction, _ := value.(PodEviction)
+		return eviction
+	}
+	if e := state("web"); e.State != EvictionDeleted {
+		t.Errorf("Expected web to be evicted, got %+v", e)
+	}
+	if e := state("guarded"); e.State != EvictionFailed || !strings.Contains(e.Message, "PodDisruptionBudget") {
+		t.Errorf("Expected guarded to be blocked by its PDB, got %+v", e)
+	}
+	if e := state("bare"); e.State != EvictionFailed || !strings.Contains(e.Message, "not managed by a controller") {
+		t.Errorf("Expected bare to be refused as unmanaged, got %+v", e)
+	}
+	if e := state("cache"); e.State != EvictionFailed || !strings.Contains(e.Message, "emptyDir volume scratch") {
+		t.Errorf("Expected cache to be refused for its emptyDir volume, got %+v", e)
+	}
+	for _, name := range []string{"default/bare", "default/cache"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("Expected %s to fail the drain, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"bare", "cache"} {
+		if _, ok := evicted[name]; ok {
+			t.Errorf("Expected %s not to be evicted without its option", name)
+		}
+	}
+	for _, name := range []string{"logger", "static"} {
+		if e := state(name); e.State != EvictionSkipped {
+			t.Errorf("Expected %s to be skipped, got %+v", name, e)
+		}
+		if _, ok := evicted[name]; ok {
+			t.Errorf("Expected %s not to be evicted", name)
+		}
+	}
+	if e := evicted["web"]; e == nil || e.DeleteOptions == nil || *e.DeleteOptions.GracePeriodSeconds != 5 {
+		t.Errorf("Expected the grace period to be passed to the eviction, got %+v", e)
+	}
+}
+
+func TestNodeDrainForceAndEmptyDirData(t *testing.T) {
+	drainPollInterval = 10 * time.Millisecond
+	defer func() { drainPollInterval = 2 * time.Second }()
+
+	clientset := fake.NewSimpleClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "bare", Namespace: "default"},
+			Spec: corev1.PodSpec{
+				NodeName: "node-1",
+				Volumes:  []corev1.Volume{{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+			},
+		},
+	)
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+		clientset.Tracker().Delete(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, eviction.Namespace, eviction.Name)
+		return true, nil, nil
+	})
+
+	node := NewNode("node-1", Client{Clientset: clientset})
+	if err := node.Drain(context.Background(), DrainOptions{Timeout: time.Second, Force: true}, nil); err == nil || !strings.Contains(err.Error(), "emptyDir") {
+		t.Errorf("Expected force alone to still refuse the emptyDir pod, got %v", err)
+	}
+	if err := node.Drain(context.Background(), DrainOptions{Timeout: time.Second, Force: true, DeleteEmptyDirData: true}, nil); err != nil {
+		t.Errorf("Expected the opted-in drain to succeed, got %v", err)
+	}
+}
+
+func TestNodeStatusShowsCordon(t *testing.T) {
+	node := &corev1.Node{
+		Spec: corev1.NodeSpec{Unschedulable: true},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+		}},
+	}
+	if status := getNodeStatus(node); status != "Ready,SchedulingDisabled" {
+		t.Errorf("Expected a cordoned node status, got %s", status)
+	}
+}
@@ -0,0 +1,228 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/otavioCosta2110/k8s-tui/pkg/diff"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+type EditConflictError struct {
+	ResourceType ResourceType
+	Name         string
+	Err          error
+}
+
+func (e *EditConflictError) Error() string {
+	return fmt.Sprintf("%s %s was changed on the server after it was loaded", e.ResourceType, e.Name)
+}
+
+func (e *EditConflictError) Unwrap() error {
+	return e.Err
+}
+
+func IsEditConflict(err error) bool {
+	var conflict *EditConflictError
+	return errors.As(err, &conflict)
+}
+
+type objectClient[T runtime.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+}
+
+type resourceEditor struct {
+	gvk    schema.GroupVersionKind
+	get    func(ctx context.Context, name string) (runtime.Object, error)
+	update func(ctx context.Context, obj runtime.Object, opts metav1.UpdateOptions) (runtime.Object, error)
+}
+
+func newResourceEditor[T runtime.Object](gvk schema.GroupVersionKind, client objectClient[T]) resourceEditor {
+	return resourceEditor{
+		gvk: gvk,
+		get: func(ctx context.Context, name string) (runtime.Object, error) {
+			return client.Get(ctx, name, metav1.GetOptions{})
+		},
+		update: func(ctx context.Context, obj runtime.Object, opts metav1.UpdateOptions) (runtime.Object, error) {
+			typed, ok := obj.(T)
+			if !ok {
+				return nil, fmt.Errorf("expected a %s, got %T", gvk.Kind, obj)
+			}
+			return client.Update(ctx, typed, opts)
+		},
+	}
+}
+
+func editorFor(client Client, resourceType ResourceType, namespace string) (resourceEditor, error) {
+	if IsCustomResourceType(resourceType) {
+		return resourceEditor{}, fmt.Errorf("editing YAML is not supported for custom resource type %s", resourceType)
+	}
+
+	core := client.Clientset.CoreV1()
+	apps := client.Clientset.AppsV1()
+	batch := client.Clientset.BatchV1()
+	networking := client.Clientset.NetworkingV1()
+	switch resourceType {
+	case ResourceTypePod:
+		return newResourceEditor[*corev1.Pod](corev1.SchemeGroupVersion.WithKind("Pod"), core.Pods(namespace)), nil
+	case ResourceTypeService:
+		return newResourceEditor[*corev1.Service](corev1.SchemeGroupVersion.WithKind("Service"), core.Services(namespace)), nil
+	case ResourceTypeServiceAccount:
+		return newResourceEditor[*corev1.ServiceAccount](corev1.SchemeGroupVersion.WithKind("ServiceAccount"), core.ServiceAccounts(namespace)), nil
+	case ResourceTypeConfigMap:
+		return newResourceEditor[*corev1.ConfigMap](corev1.SchemeGroupVersion.WithKind("ConfigMap"), core.ConfigMaps(namespace)), nil
+	case ResourceTypeSecret:
+		return newResourceEditor[*corev1.Secret](corev1.SchemeGroupVersion.WithKind("Secret"), core.Secrets(namespace)), nil
+	case ResourceTypeNode:
+		return newResourceEditor[*corev1.Node](corev1.SchemeGroupVersion.WithKind("Node"), core.Nodes()), nil
+	case ResourceTypePersistentVolume:
+		return newResourceEditor[*corev1.PersistentVolume](corev1.SchemeGroupVersion.WithKind("PersistentVolume"), core.PersistentVolumes()), nil
+	case ResourceTypePersistentVolumeClaim:
+		return newResourceEditor[*corev1.PersistentVolumeClaim](corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"), core.PersistentVolumeClaims(namespace)), nil
+	case ResourceTypeEvent:
+		return newResourceEditor[*corev1.Event](corev1.SchemeGroupVersion.WithKind("Event"), core.Events(namespace)), nil
+	case ResourceTypeDeployment:
+		return newResourceEditor[*appsv1.Deployment](appsv1.SchemeGroupVersion.WithKind("Deployment"), apps.Deployments(namespace)), nil
+	case ResourceTypeReplicaSet:
+		return newResourceEditor[*appsv1.ReplicaSet](appsv1.SchemeGroupVersion.WithKind("ReplicaSet"), apps.ReplicaSets(namespace)), nil
+	case ResourceTypeDaemonSet:
+		return newResourceEditor[*appsv1.DaemonSet](appsv1.SchemeGroupVersion.WithKind("DaemonSet"), apps.DaemonSets(namespace)), nil
+	case ResourceTypeStatefulSet:
+		return newResourceEditor[*appsv1.StatefulSet](appsv1.SchemeGroupVersion.WithKind("StatefulSet"), apps.StatefulSets(namespace)), nil
+	case ResourceTypeJob:
+		return newResourceEditor[*batchv1.Job](batchv1.SchemeGroupVersion.WithKind("Job"), batch.Jobs(namespace)), nil
+	case ResourceTypeCronJob:
+		return newResourceEditor[*batchv1.CronJob](batchv1.SchemeGroupVersion.WithKind("CronJob"), batch.CronJobs(namespace)), nil
+	case ResourceTypeIngress:
+		return newResourceEditor[*networkingv1.Ingress](networkingv1.SchemeGroupVersion.WithKind("Ingress"), networking.Ingresses(namespace)), nil
+	case ResourceTypeNetworkPolicy:
+		return newResourceEditor[*networkingv1.NetworkPolicy](networkingv1.SchemeGroupVersion.WithKind("NetworkPolicy"), networking.NetworkPolicies(namespace)), nil
+	default:
+		return resourceEditor{}, fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+}
+
+func GetResourceYAML(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (string, error) {
+	editor, err := editorFor(client, resourceType, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	obj, err := editor.get(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s %s: %v", resourceType, name, err)
+	}
+	return editor.marshal(obj)
+}
+
+func (e resourceEditor) marshal(obj runtime.Object) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	accessor.SetManagedFields(nil)
+	obj.GetObjectKind().SetGroupVersionKind(e.gvk)
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s %s to YAML: %v", e.gvk.Kind, accessor.GetName(), err)
+	}
+	return string(data), nil
+}
+
+func (e resourceEditor) decode(ctx context.Context, namespace, name, content string, force bool) (runtime.Object, error) {
+	obj, gvk, err := scheme.Codecs.UniversalDeserializer().Decode([]byte(content), &e.gvk, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %v", err)
+	}
+	if *gvk != e.gvk {
+		return nil, fmt.Errorf("expected %s, got %s", e.gvk.GroupKind(), gvk.GroupKind())
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if accessor.GetName() != name {
+		return nil, fmt.Errorf("metadata.name cannot be changed from %s to %s", name, accessor.GetName())
+	}
+	if accessor.GetNamespace() == "" {
+		accessor.SetNamespace(namespace)
+	} else if accessor.GetNamespace() != namespace {
+		return nil, fmt.Errorf("metadata.namespace cannot be changed from %s to %s", namespace, accessor.GetNamespace())
+	}
+
+	if force {
+		current, err := e.get(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s %s: %v", e.gvk.Kind, name, err)
+		}
+		currentAccessor, err := meta.Accessor(current)
+		if err != nil {
+			return nil, err
+		}
+		accessor.SetResourceVersion(currentAccessor.GetResourceVersion())
+	} else if accessor.GetResourceVersion() == "" {
+		return nil, fmt.Errorf("metadata.resourceVersion is required to detect conflicting changes")
+	}
+	return obj, nil
+}
+
+func updateError(resourceType ResourceType, name string, err error) error {
+	if apierrors.IsConflict(err) {
+		return &EditConflictError{ResourceType: resourceType, Name: name, Err: err}
+	}
+	return fmt.Errorf("failed to update %s %s: %v", resourceType, name, err)
+}
+
+func DryRunResourceYAML(ctx context.Context, client Client, resourceType ResourceType, namespace, name, content string, force bool) (string, error) {
+	editor, err := editorFor(client, resourceType, namespace)
+	if err != nil {
+		return "", err
+	}
+	obj, err := editor.decode(ctx, namespace, name, content, force)
+	if err != nil {
+		return "", err
+	}
+
+	live, err := GetResourceYAML(ctx, client, resourceType, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	result, err := editor.update(ctx, obj, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return "", updateError(resourceType, name, err)
+	}
+	dryRun, err := editor.marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return diff.Unified("live/"+name, "dry-run/"+name, live, dryRun, 3), nil
+}
+
+func ApplyResourceYAML(ctx context.Context, client Client, resourceType ResourceType, namespace, name, content string, force bool) error {
+	editor, err := editorFor(client, resourceType, namespace)
+	if err != nil {
+		return err
+	}
+	obj, err := editor.decode(ctx, namespace, name, content, force)
+	if err != nil {
+		return err
+	}
+	if _, err := editor.update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return updateError(resourceType, name, err)
+	}
+	return nil
+}
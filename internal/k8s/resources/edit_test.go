@@ -0,0 +1,142 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newEditClientset(objects ...runtime.Object) *fake.Clientset {
+	clientset := fake.NewSimpleClientset(objects...)
+	clientset.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		updated := action.(k8stesting.UpdateAction).GetObject().(*corev1.ConfigMap)
+		current, err := clientset.Tracker().Get(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, updated.Namespace, updated.Name)
+		if err != nil {
+			return true, nil, err
+		}
+		if current.(*corev1.ConfigMap).ResourceVersion != updated.ResourceVersion {
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, updated.Name, nil)
+		}
+		if len(action.(k8stesting.UpdateActionImpl).UpdateOptions.DryRun) > 0 {
+			return true, updated, nil
+		}
+		updated.ResourceVersion += "1"
+		return true, updated, clientset.Tracker().Update(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, updated, updated.Namespace)
+	})
+	return clientset
+}
+
+func editConfigMap() *corev1.ConfigMap {
+	controller := true
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "settings",
+			Namespace:       "default",
+			ResourceVersion: "1",
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "uid-web", Controller: &controller}},
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}},
+		},
+		Data:       map[string]string{"mode": "fast"},
+		BinaryData: map[string][]byte{"blob": {0, 1, 2}},
+	}
+}
+
+func TestGetResourceYAML(t *testing.T) {
+	client := Client{Clientset: newEditClientset(editConfigMap())}
+
+	content, err := GetResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings")
+	if err != nil {
+		t.Fatalf("GetResourceYAML failed: %v", err)
+	}
+	for _, want := range []string{"apiVersion: v1", "kind: ConfigMap", "resourceVersion: \"1\"", "binaryData:", "ownerReferences:"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected YAML to contain %q, got:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "managedFields") {
+		t.Errorf("Expected managedFields to be hidden, got:\n%s", content)
+	}
+}
+
+func TestApplyResourceYAML(t *testing.T) {
+	clientset := newEditClientset(editConfigMap())
+	client := Client{Clientset: clientset}
+
+	content, err := GetResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings")
+	if err != nil {
+		t.Fatalf("GetResourceYAML failed: %v", err)
+	}
+	edited := strings.Replace(content, "mode: fast", "mode: slow", 1)
+	if err := ApplyResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings", edited, false); err != nil {
+		t.Fatalf("ApplyResourceYAML failed: %v", err)
+	}
+
+	cm, _ := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "settings", metav1.GetOptions{})
+	if cm.Data["mode"] != "slow" {
+		t.Errorf("Expected the data change to be applied, got %v", cm.Data)
+	}
+	if len(cm.BinaryData["blob"]) != 3 || len(cm.OwnerReferences) != 1 {
+		t.Errorf("Expected binaryData and owner references to be kept, got %+v", cm)
+	}
+
+	stale := strings.Replace(content, "mode: fast", "mode: stale", 1)
+	err = ApplyResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings", stale, false)
+	if !IsEditConflict(err) {
+		t.Fatalf("Expected a conflict for a stale resourceVersion, got %v", err)
+	}
+	if err := ApplyResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings", stale, true); err != nil {
+		t.Fatalf("Expected force to overwrite the newer version, got %v", err)
+	}
+	cm, _ = clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "settings", metav1.GetOptions{})
+	if cm.Data["mode"] != "stale" {
+		t.Errorf("Expected the forced change to be applied, got %v", cm.Data)
+	}
+
+	renamed := strings.Replace(content, "name: settings", "name: other", 1)
+	if err := ApplyResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings", renamed, true); err == nil || !strings.Contains(err.Error(), "metadata.name") {
+		t.Errorf("Expected renaming to be rejected, got %v", err)
+	}
+	if err := ApplyResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings", "kind: Secret\napiVersion: v1\nmetadata:\n  name: settings\n", true); err == nil {
+		t.Error("Expected a kind change to be rejected")
+	}
+}
+
+func TestDryRunResourceYAML(t *testing.T) {
+	clientset := newEditClientset(editConfigMap())
+	client := Client{Clientset: clientset}
+
+	content, err := GetResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings")
+	if err != nil {
+		t.Fatalf("GetResourceYAML failed: %v", err)
+	}
+	edited := strings.Replace(content, "mode: fast", "mode: slow", 1)
+	changes, err := DryRunResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings", edited, false)
+	if err != nil {
+		t.Fatalf("DryRunResourceYAML failed: %v", err)
+	}
+	for _, want := range []string{"--- live/settings", "+++ dry-run/settings", "-  mode: fast", "+  mode: slow"} {
+		if !strings.Contains(changes, want) {
+			t.Errorf("Expected the diff to contain %q, got:\n%s", want, changes)
+		}
+	}
+	cm, _ := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "settings", metav1.GetOptions{})
+	if cm.Data["mode"] != "fast" {
+		t.Errorf("Expected the dry-run not to persist, got %v", cm.Data)
+	}
+
+	clientset.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "settings", errors.New(`admission webhook "policy.example.com" denied the request`))
+	})
+	if _, err := DryRunResourceYAML(context.Background(), client, ResourceTypeConfigMap, "default", "settings", edited, false); err == nil || !strings.Contains(err.Error(), "denied the request") {
+		t.Errorf("Expected the webhook rejection to surface in the dry-run, got %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type ContainerImage struct {
+	Name  string
+	Image string
+	Init  bool
+}
+
+func GetContainerImages(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) ([]ContainerImage, error) {
+	var spec *corev1.PodSpec
+
+	switch resourceType {
+	case ResourceTypeDeployment:
+		deployment, err := client.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployment %s: %v", name, err)
+		}
+		spec = &deployment.Spec.Template.Spec
+	case ResourceTypeStatefulSet:
+		statefulset, err := client.Clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get statefulset %s: %v", name, err)
+		}
+		spec = &statefulset.Spec.Template.Spec
+	case ResourceTypeDaemonSet:
+		daemonset, err := client.Clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daemonset %s: %v", name, err)
+		}
+		spec = &daemonset.Spec.Template.Spec
+	case ResourceTypeCronJob:
+		cronjob, err := client.Clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cronjob %s: %v", name, err)
+		}
+		spec = &cronjob.Spec.JobTemplate.Spec.Template.Spec
+	default:
+		return nil, fmt.Errorf("setting images not supported for resource type: %s", resourceType)
+	}
+
+	var images []ContainerImage
+	for _, c := range spec.InitContainers {
+		images = append(images, ContainerImage{Name: c.Name, Image: c.Image, Init: true})
+	}
+	for _, c := range spec.Containers {
+		images = append(images, ContainerImage{Name: c.Name, Image: c.Image})
+	}
+	return images, nil
+}
+
+func SetContainerImages(ctx context.Context, client Client, resourceType ResourceType, namespace, name string, images []ContainerImage) error {
+	if len(images) == 0 {
+		return nil
+	}
+	patch, err := ContainerImagesPatch(resourceType, images)
+	if err != nil {
+		return err
+	}
+
+	switch resourceType {
+	case ResourceTypeDeployment:
+		_, err = client.Clientset.AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case ResourceTypeStatefulSet:
+		_, err = client.Clientset.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case ResourceTypeDaemonSet:
+		_, err = client.Clientset.AppsV1().DaemonSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case ResourceTypeCronJob:
+		_, err = client.Clientset.BatchV1().CronJobs(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set images on %s %s: %v", resourceType, name, err)
+	}
+	return nil
+}
+
+func ContainerImagesPatch(resourceType ResourceType, images []ContainerImage) ([]byte, error) {
+	containers := []map[string]string{}
+	initContainers := []map[string]string{}
+	for _, image := range images {
+		if image.Image == "" {
+			return nil, fmt.Errorf("image for container %s must not be empty", image.Name)
+		}
+		entry := map[string]string{"name": image.Name, "image": image.Image}
+		if image.Init {
+			initContainers = append(initContainers, entry)
+		} else {
+			containers = append(containers, entry)
+		}
+	}
+
+	podSpec := map[string]any{}
+	if len(containers) > 0 {
+		podSpec["containers"] = containers
+	}
+	if len(initContainers) > 0 {
+		podSpec["initContainers"] = initContainers
+	}
+	template := map[string]any{"template": map[string]any{"spec": podSpec}}
+
+	var patch map[string]any
+	switch resourceType {
+	case ResourceTypeDeployment, ResourceTypeStatefulSet, ResourceTypeDaemonSet:
+		patch = map[string]any{"spec": template}
+	case ResourceTypeCronJob:
+		patch = map[string]any{"spec": map[string]any{"jobTemplate": map[string]any{"spec": template}}}
+	default:
+		return nil, fmt.Errorf("setting images not supported for resource type: %s", resourceType)
+	}
+	return json.Marshal(patch)
+}
@@ -0,0 +1,65 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSetContainerImages(t *testing.T) {
+	podSpec := corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "migrate", Image: "migrate:1"}},
+		Containers: []corev1.Container{
+			{Name: "app", Image: "app:1"},
+			{Name: "proxy", Image: "proxy:1"},
+		},
+	}
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+			Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: podSpec}},
+			}},
+		},
+	)
+	client := Client{Clientset: clientset, Namespace: "default"}
+
+	images, err := GetContainerImages(context.Background(), client, ResourceTypeDeployment, "default", "web")
+	if err != nil {
+		t.Fatalf("GetContainerImages failed: %v", err)
+	}
+	if len(images) != 3 || !images[0].Init || images[0].Name != "migrate" {
+		t.Fatalf("Expected init containers first, got %+v", images)
+	}
+
+	changes := []ContainerImage{{Name: "migrate", Image: "migrate:2", Init: true}, {Name: "app", Image: "app:2"}}
+	if err := SetContainerImages(context.Background(), client, ResourceTypeDeployment, "default", "web", changes); err != nil {
+		t.Fatalf("SetContainerImages failed: %v", err)
+	}
+	deployment, _ := clientset.AppsV1().Deployments("default").Get(context.Background(), "web", metav1.GetOptions{})
+	spec := deployment.Spec.Template.Spec
+	if spec.InitContainers[0].Image != "migrate:2" || spec.Containers[0].Image != "app:2" || spec.Containers[1].Image != "proxy:1" {
+		t.Errorf("Expected only the changed images to be patched, got %+v", spec)
+	}
+
+	if err := SetContainerImages(context.Background(), client, ResourceTypeCronJob, "default", "nightly", []ContainerImage{{Name: "proxy", Image: "proxy:2"}}); err != nil {
+		t.Fatalf("SetContainerImages on cronjob failed: %v", err)
+	}
+	cronjob, _ := clientset.BatchV1().CronJobs("default").Get(context.Background(), "nightly", metav1.GetOptions{})
+	if image := cronjob.Spec.JobTemplate.Spec.Template.Spec.Containers[1].Image; image != "proxy:2" {
+		t.Errorf("Expected the cronjob job template to be patched, got %s", image)
+	}
+
+	if err := SetContainerImages(context.Background(), client, ResourceTypeDeployment, "default", "web", []ContainerImage{{Name: "app"}}); err == nil {
+		t.Error("Expected empty images to be rejected")
+	}
+}
@@ -32,8 +32,8 @@ func NewIngress(name, namespace string, k Client) *IngressInfo {
 	}
 }
 
-func FetchIngressList(client Client, namespace string) ([]string, error) {
-	ingresses, err := client.Clientset.NetworkingV1().Ingresses(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchIngressList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	ingresses, err := client.Clientset.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch ingresses: %v", err)
 	}
@@ -46,17 +46,14 @@ func FetchIngressList(client Client, namespace string) ([]string, error) {
 	return ingressNames, nil
 }
 
-func GetIngressesTableData(client Client, namespace string) ([]IngressInfo, error) {
-	ingresses, err := client.Clientset.NetworkingV1().Ingresses(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetIngressesTableData(ctx context.Context, client Client, namespace string) ([]IngressInfo, error) {
+	ingresses, err := listIngresses(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list ingresses: %v", err)
 	}
 
 	var ingressInfos []IngressInfo
-	for _, ingress := range ingresses.Items {
+	for _, ingress := range ingresses {
 		ingressClass := ""
 		if ingress.Spec.IngressClassName != nil {
 			ingressClass = *ingress.Spec.IngressClassName
@@ -119,16 +116,16 @@ func GetIngressesTableData(client Client, namespace string) ([]IngressInfo, erro
 	return ingressInfos, nil
 }
 
-func DeleteIngress(client Client, namespace string, ingressName string) error {
-	err := client.Clientset.NetworkingV1().Ingresses(namespace).Delete(context.Background(), ingressName, metav1.DeleteOptions{})
+func DeleteIngress(ctx context.Context, client Client, namespace string, ingressName string) error {
+	err := client.Clientset.NetworkingV1().Ingresses(namespace).Delete(ctx, ingressName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete ingress %s: %v", ingressName, err)
 	}
 	return nil
 }
 
-func (i *IngressInfo) Fetch() error {
-	ingress, err := i.Client.Clientset.NetworkingV1().Ingresses(i.Namespace).Get(context.Background(), i.Name, metav1.GetOptions{})
+func (i *IngressInfo) Fetch(ctx context.Context) error {
+	ingress, err := i.Client.Clientset.NetworkingV1().Ingresses(i.Namespace).Get(ctx, i.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get ingress: %v", err)
 	}
@@ -136,14 +133,14 @@ func (i *IngressInfo) Fetch() error {
 	return nil
 }
 
-func (i *IngressInfo) Describe() (string, error) {
+func (i *IngressInfo) Describe(ctx context.Context) (string, error) {
 	if i.Raw == nil {
-		if err := i.Fetch(); err != nil {
+		if err := i.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch ingress: %v", err)
 		}
 	}
 
-	events, err := i.Client.Clientset.CoreV1().Events(i.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := i.Client.Clientset.CoreV1().Events(i.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=Ingress", i.Name, i.Namespace),
 	})
 	if err != nil {
@@ -30,8 +30,8 @@ func NewJob(name, namespace string, k Client) *JobInfo {
 	}
 }
 
-func FetchJobList(client Client, namespace string) ([]string, error) {
-	jobs, err := client.Clientset.BatchV1().Jobs(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchJobList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	jobs, err := client.Clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch jobs: %v", err)
 	}
@@ -44,43 +44,44 @@ func FetchJobList(client Client, namespace string) ([]string, error) {
 	return jobNames, nil
 }
 
-func GetJobsTableData(client Client, namespace string) ([]JobInfo, error) {
-	jobs, err := client.Clientset.BatchV1().Jobs(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetJobsTableData(ctx context.Context, client Client, namespace string) ([]JobInfo, error) {
+	jobs, err := listJobs(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list jobs: %v", err)
 	}
 
 	var jobInfos []JobInfo
-	for _, job := range jobs.Items {
-		completions := "1/1"
-		if job.Spec.Completions != nil && job.Status.Succeeded != 0 {
-			completions = fmt.Sprintf("%d/%d", job.Status.Succeeded, *job.Spec.Completions)
-		}
+	for _, job := range jobs {
+		jobInfos = append(jobInfos, newJobInfo(client, &job))
+	}
 
-		duration := "<none>"
-		if job.Status.CompletionTime != nil && job.Status.StartTime != nil {
-			duration = job.Status.CompletionTime.Sub(job.Status.StartTime.Time).Round(time.Second).String()
-		}
+	return jobInfos, nil
+}
 
-		jobInfos = append(jobInfos, JobInfo{
-			Namespace:   job.Namespace,
-			Name:        job.Name,
-			Completions: completions,
-			Duration:    duration,
-			Age:         format.FormatAge(job.CreationTimestamp.Time),
-			Raw:         job.DeepCopy(),
-			Client:      client,
-		})
+func newJobInfo(client Client, job *batchv1.Job) JobInfo {
+	completions := "1/1"
+	if job.Spec.Completions != nil && job.Status.Succeeded != 0 {
+		completions = fmt.Sprintf("%d/%d", job.Status.Succeeded, *job.Spec.Completions)
 	}
 
-	return jobInfos, nil
+	duration := "<none>"
+	if job.Status.CompletionTime != nil && job.Status.StartTime != nil {
+		duration = job.Status.CompletionTime.Sub(job.Status.StartTime.Time).Round(time.Second).String()
+	}
+
+	return JobInfo{
+		Namespace:   job.Namespace,
+		Name:        job.Name,
+		Completions: completions,
+		Duration:    duration,
+		Age:         format.FormatAge(job.CreationTimestamp.Time),
+		Raw:         job.DeepCopy(),
+		Client:      client,
+	}
 }
 
-func (j *JobInfo) Fetch() error {
-	job, err := j.Client.Clientset.BatchV1().Jobs(j.Namespace).Get(context.Background(), j.Name, metav1.GetOptions{})
+func (j *JobInfo) Fetch(ctx context.Context) error {
+	job, err := j.Client.Clientset.BatchV1().Jobs(j.Namespace).Get(ctx, j.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get job: %v", err)
 	}
@@ -88,14 +89,14 @@ func (j *JobInfo) Fetch() error {
 	return nil
 }
 
-func (j *JobInfo) Describe() (string, error) {
+func (j *JobInfo) Describe(ctx context.Context) (string, error) {
 	if j.Raw == nil {
-		if err := j.Fetch(); err != nil {
+		if err := j.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch job: %v", err)
 		}
 	}
 
-	events, err := j.Client.Clientset.CoreV1().Events(j.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := j.Client.Clientset.CoreV1().Events(j.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=Job", j.Name, j.Namespace),
 	})
 	if err != nil {
@@ -190,8 +191,25 @@ func (j *JobInfo) DescribeJob(events *corev1.EventList) (map[string]any, error)
 	return desc, nil
 }
 
-func DeleteJob(client Client, namespace string, jobName string) error {
-	err := client.Clientset.BatchV1().Jobs(namespace).Delete(context.Background(), jobName, metav1.DeleteOptions{})
+func (j *JobInfo) GetLabelSelector() (string, error) {
+	if j.Raw == nil {
+		return "", fmt.Errorf("job raw data not available")
+	}
+
+	if j.Raw.Spec.Selector == nil {
+		return "", fmt.Errorf("job has no selector")
+	}
+
+	requirements, err := metav1.LabelSelectorAsSelector(j.Raw.Spec.Selector)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert label selector: %v", err)
+	}
+
+	return requirements.String(), nil
+}
+
+func DeleteJob(ctx context.Context, client Client, namespace string, jobName string) error {
+	err := client.Clientset.BatchV1().Jobs(namespace).Delete(ctx, jobName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete job %s: %v", jobName, err)
 	}
@@ -0,0 +1,78 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+var rerunSuffix = regexp.MustCompile(`-rerun-[a-z0-9]{5}$`)
+
+var controllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	batchv1.ControllerUidLabel,
+	batchv1.JobNameLabel,
+}
+
+func (j *JobInfo) Rerun(ctx context.Context) (*batchv1.Job, error) {
+	if err := j.Fetch(ctx); err != nil {
+		return nil, err
+	}
+
+	job, err := j.Client.Clientset.BatchV1().Jobs(j.Namespace).Create(ctx, RerunJob(j.Raw), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-run job %s: %v", j.Name, err)
+	}
+	return job, nil
+}
+
+func RerunJob(original *batchv1.Job) *batchv1.Job {
+	base := rerunSuffix.ReplaceAllString(original.Name, "")
+	suffix := "-rerun-" + utilrand.String(5)
+	if len(base)+len(suffix) > 63 {
+		base = base[:63-len(suffix)]
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        base + suffix,
+			Namespace:   original.Namespace,
+			Labels:      withoutControllerLabels(original.Labels),
+			Annotations: make(map[string]string),
+		},
+		Spec: *original.Spec.DeepCopy(),
+	}
+	for k, v := range original.Annotations {
+		if k != InstantiateAnnotation {
+			job.Annotations[k] = v
+		}
+	}
+
+	job.Spec.Selector = nil
+	job.Spec.ManualSelector = nil
+	job.Spec.Template.Labels = withoutControllerLabels(job.Spec.Template.Labels)
+	return job
+}
+
+func withoutControllerLabels(labels map[string]string) map[string]string {
+	stripped := make(map[string]string, len(labels))
+	for k, v := range labels {
+		stripped[k] = v
+	}
+	for _, label := range controllerLabels {
+		delete(stripped, label)
+	}
+	return stripped
+}
+
+func (j *JobInfo) PodSelector() string {
+	if selector, err := j.GetLabelSelector(); err == nil && selector != "" {
+		return selector
+	}
+	return batchv1.JobNameLabel + "=" + j.Name
+}
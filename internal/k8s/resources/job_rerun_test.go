@@ -0,0 +1,89 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestJobRerun(t *testing.T) {
+	manual := true
+	jobLabels := map[string]string{
+		"app":                      "migrate",
+		"controller-uid":           "old-uid",
+		"job-name":                 "migrate",
+		batchv1.ControllerUidLabel: "old-uid",
+		batchv1.JobNameLabel:       "migrate",
+	}
+	original := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "migrate", Namespace: "default", ResourceVersion: "42", UID: "old-uid",
+			Labels:      jobLabels,
+			Annotations: map[string]string{InstantiateAnnotation: "manual", "team": "db"},
+		},
+		Spec: batchv1.JobSpec{
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{batchv1.ControllerUidLabel: "old-uid"}},
+			ManualSelector: &manual,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: jobLabels},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "migrate", Image: "migrate:1"}}},
+			},
+		},
+		Status: batchv1.JobStatus{Failed: 1},
+	}
+	client := Client{Clientset: fake.NewSimpleClientset(original), Namespace: "default"}
+
+	job, err := NewJob("migrate", "default", client).Rerun(context.Background())
+	if err != nil {
+		t.Fatalf("Rerun failed: %v", err)
+	}
+	if !strings.HasPrefix(job.Name, "migrate-rerun-") || job.ResourceVersion == "42" {
+		t.Errorf("Expected a fresh job, got %s (resourceVersion %s)", job.Name, job.ResourceVersion)
+	}
+	if job.Spec.Selector != nil || job.Spec.ManualSelector != nil {
+		t.Errorf("Expected the selector to be stripped, got %+v", job.Spec.Selector)
+	}
+	for _, labels := range []map[string]string{job.Labels, job.Spec.Template.Labels} {
+		if len(labels) != 1 || labels["app"] != "migrate" {
+			t.Errorf("Expected only user labels, got %v", labels)
+		}
+	}
+	if _, ok := job.Annotations[InstantiateAnnotation]; ok || job.Annotations["team"] != "db" {
+		t.Errorf("Expected user annotations only, got %v", job.Annotations)
+	}
+	if job.Status.Failed != 0 {
+		t.Errorf("Expected an empty status, got %+v", job.Status)
+	}
+	if original.Spec.Template.Labels[batchv1.ControllerUidLabel] != "old-uid" {
+		t.Error("Expected the original job to be left untouched")
+	}
+
+	again := RerunJob(job)
+	if strings.Count(again.Name, "-rerun-") != 1 {
+		t.Errorf("Expected re-runs not to stack suffixes, got %s", again.Name)
+	}
+
+	long := original.DeepCopy()
+	long.Name = strings.Repeat("m", 63)
+	if name := RerunJob(long).Name; len(name) > 63 {
+		t.Errorf("Expected the name to fit 63 characters, got %d", len(name))
+	}
+}
+
+func TestJobPodSelector(t *testing.T) {
+	job := NewJob("migrate", "default", Client{})
+	job.Raw = &batchv1.Job{}
+	if selector := job.PodSelector(); selector != batchv1.JobNameLabel+"=migrate" {
+		t.Errorf("Expected the job-name fallback, got %s", selector)
+	}
+
+	job.Raw.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{batchv1.ControllerUidLabel: "uid"}}
+	if selector := job.PodSelector(); selector != batchv1.ControllerUidLabel+"=uid" {
+		t.Errorf("Expected the job selector, got %s", selector)
+	}
+}
@@ -0,0 +1,58 @@
+package k8s
+
+import (
+	"sort"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+type KubeconfigOptions struct {
+	Path    string
+	Context string
+	Cluster string
+	User    string
+}
+
+type KubeContext struct {
+	Name      string
+	Cluster   string
+	User      string
+	Namespace string
+	Current   bool
+}
+
+func (o KubeconfigOptions) clientConfig() clientcmd.ClientConfig {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = o.Path
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: o.Context,
+		Context: clientcmdapi.Context{
+			Cluster:  o.Cluster,
+			AuthInfo: o.User,
+		},
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+}
+
+func ListContexts(kubeconfigPath string) ([]KubeContext, error) {
+	raw, err := KubeconfigOptions{Path: kubeconfigPath}.clientConfig().RawConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	contexts := make([]KubeContext, 0, len(raw.Contexts))
+	for name, context := range raw.Contexts {
+		contexts = append(contexts, KubeContext{
+			Name:      name,
+			Cluster:   context.Cluster,
+			User:      context.AuthInfo,
+			Namespace: context.Namespace,
+			Current:   name == raw.CurrentContext,
+		})
+	}
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+	return contexts, nil
+}
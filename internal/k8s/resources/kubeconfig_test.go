@@ -0,0 +1,134 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const stagingKubeconfig = `apiVersion: v1
+kind: Config
+current-context: staging
+clusters:
+- name: staging
+  cluster:
+    server: https://staging.example.com
+users:
+- name: dev
+  user:
+    token: dev-token
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: dev
+`
+
+const prodKubeconfig = `apiVersion: v1
+kind: Config
+current-context: prod
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+users:
+- name: admin
+  user:
+    token: admin-token
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+    namespace: web
+`
+
+func writeKubeconfigs(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	staging := filepath.Join(dir, "staging")
+	prod := filepath.Join(dir, "prod")
+	os.WriteFile(staging, []byte(stagingKubeconfig), 0600)
+	os.WriteFile(prod, []byte(prodKubeconfig), 0600)
+	return staging, prod
+}
+
+func TestListContextsMergesKubeconfigPaths(t *testing.T) {
+	staging, prod := writeKubeconfigs(t)
+	t.Setenv("KUBECONFIG", strings.Join([]string{staging, prod}, string(os.PathListSeparator)))
+
+	contexts, err := ListContexts("")
+	if err != nil {
+		t.Fatalf("ListContexts failed: %v", err)
+	}
+	if len(contexts) != 2 {
+		t.Fatalf("Expected contexts from both files, got %+v", contexts)
+	}
+	want := KubeContext{Name: "prod", Cluster: "prod", User: "admin", Namespace: "web"}
+	if contexts[0] != want {
+		t.Errorf("Expected %+v, got %+v", want, contexts[0])
+	}
+	if contexts[1].Name != "staging" || !contexts[1].Current {
+		t.Errorf("Expected the first file's current-context to win, got %+v", contexts[1])
+	}
+}
+
+func TestListContextsExplicitPath(t *testing.T) {
+	staging, prod := writeKubeconfigs(t)
+	t.Setenv("KUBECONFIG", staging)
+
+	contexts, err := ListContexts(prod)
+	if err != nil {
+		t.Fatalf("ListContexts failed: %v", err)
+	}
+	if len(contexts) != 1 || contexts[0].Name != "prod" {
+		t.Errorf("Expected only the explicit file's contexts, got %+v", contexts)
+	}
+}
+
+func TestNewClientSelectsContext(t *testing.T) {
+	staging, prod := writeKubeconfigs(t)
+	t.Setenv("KUBECONFIG", strings.Join([]string{staging, prod}, string(os.PathListSeparator)))
+
+	client, err := NewClient(KubeconfigOptions{}, "")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if client.ContextName() != "staging" || client.Config.Host != "https://staging.example.com" || client.Namespace != "" {
+		t.Errorf("Expected the current context, got %s at %s in %q", client.ContextName(), client.Config.Host, client.Namespace)
+	}
+
+	client, err = NewClient(KubeconfigOptions{Context: "prod"}, "")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if client.ContextName() != "prod" || client.Config.BearerToken != "admin-token" || client.Namespace != "web" {
+		t.Errorf("Expected the prod context and its namespace, got %s with %q in %q", client.ContextName(), client.Config.BearerToken, client.Namespace)
+	}
+
+	client, err = NewClient(KubeconfigOptions{Context: "prod"}, "kube-system")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if client.Namespace != "kube-system" {
+		t.Errorf("Expected an explicit namespace to win over the context's, got %q", client.Namespace)
+	}
+
+	if _, err := NewClient(KubeconfigOptions{Context: "missing"}, ""); err == nil {
+		t.Error("Expected an unknown context to be rejected")
+	}
+}
+
+func TestNewClientClusterAndUserOverrides(t *testing.T) {
+	staging, prod := writeKubeconfigs(t)
+	t.Setenv("KUBECONFIG", strings.Join([]string{staging, prod}, string(os.PathListSeparator)))
+
+	client, err := NewClient(KubeconfigOptions{Context: "staging", Cluster: "prod", User: "admin"}, "")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if client.Config.Host != "https://prod.example.com" || client.Config.BearerToken != "admin-token" {
+		t.Errorf("Expected the overridden cluster and user, got %s with %q", client.Config.Host, client.Config.BearerToken)
+	}
+}
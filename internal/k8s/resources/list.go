@@ -0,0 +1,141 @@
+package k8s
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func listPods(ctx context.Context, client Client, namespace, selector string) ([]corev1.Pod, error) {
+	return listObjects(client, ResourceTypePod, namespace, selector, func(opts metav1.ListOptions) ([]corev1.Pod, error) {
+		list, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listServices(ctx context.Context, client Client, namespace, selector string) ([]corev1.Service, error) {
+	return listObjects(client, ResourceTypeService, namespace, selector, func(opts metav1.ListOptions) ([]corev1.Service, error) {
+		list, err := client.Clientset.CoreV1().Services(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listServiceAccounts(ctx context.Context, client Client, namespace, selector string) ([]corev1.ServiceAccount, error) {
+	return listObjects(client, ResourceTypeServiceAccount, namespace, selector, func(opts metav1.ListOptions) ([]corev1.ServiceAccount, error) {
+		list, err := client.Clientset.CoreV1().ServiceAccounts(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listConfigMaps(ctx context.Context, client Client, namespace, selector string) ([]corev1.ConfigMap, error) {
+	return listObjects(client, ResourceTypeConfigMap, namespace, selector, func(opts metav1.ListOptions) ([]corev1.ConfigMap, error) {
+		list, err := client.Clientset.CoreV1().ConfigMaps(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listSecrets(ctx context.Context, client Client, namespace, selector string) ([]corev1.Secret, error) {
+	return listObjects(client, ResourceTypeSecret, namespace, selector, func(opts metav1.ListOptions) ([]corev1.Secret, error) {
+		list, err := client.Clientset.CoreV1().Secrets(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listNodes(ctx context.Context, client Client) ([]corev1.Node, error) {
+	return listObjects(client, ResourceTypeNode, "", "", func(opts metav1.ListOptions) ([]corev1.Node, error) {
+		list, err := client.Clientset.CoreV1().Nodes().List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listDeployments(ctx context.Context, client Client, namespace, selector string) ([]appsv1.Deployment, error) {
+	return listObjects(client, ResourceTypeDeployment, namespace, selector, func(opts metav1.ListOptions) ([]appsv1.Deployment, error) {
+		list, err := client.Clientset.AppsV1().Deployments(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listReplicaSets(ctx context.Context, client Client, namespace, selector string) ([]appsv1.ReplicaSet, error) {
+	return listObjects(client, ResourceTypeReplicaSet, namespace, selector, func(opts metav1.ListOptions) ([]appsv1.ReplicaSet, error) {
+		list, err := client.Clientset.AppsV1().ReplicaSets(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listDaemonSets(ctx context.Context, client Client, namespace, selector string) ([]appsv1.DaemonSet, error) {
+	return listObjects(client, ResourceTypeDaemonSet, namespace, selector, func(opts metav1.ListOptions) ([]appsv1.DaemonSet, error) {
+		list, err := client.Clientset.AppsV1().DaemonSets(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listStatefulSets(ctx context.Context, client Client, namespace, selector string) ([]appsv1.StatefulSet, error) {
+	return listObjects(client, ResourceTypeStatefulSet, namespace, selector, func(opts metav1.ListOptions) ([]appsv1.StatefulSet, error) {
+		list, err := client.Clientset.AppsV1().StatefulSets(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listJobs(ctx context.Context, client Client, namespace, selector string) ([]batchv1.Job, error) {
+	return listObjects(client, ResourceTypeJob, namespace, selector, func(opts metav1.ListOptions) ([]batchv1.Job, error) {
+		list, err := client.Clientset.BatchV1().Jobs(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listCronJobs(ctx context.Context, client Client, namespace, selector string) ([]batchv1.CronJob, error) {
+	return listObjects(client, ResourceTypeCronJob, namespace, selector, func(opts metav1.ListOptions) ([]batchv1.CronJob, error) {
+		list, err := client.Clientset.BatchV1().CronJobs(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func listIngresses(ctx context.Context, client Client, namespace, selector string) ([]networkingv1.Ingress, error) {
+	return listObjects(client, ResourceTypeIngress, namespace, selector, func(opts metav1.ListOptions) ([]networkingv1.Ingress, error) {
+		list, err := client.Clientset.NetworkingV1().Ingresses(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
@@ -0,0 +1,375 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type LogEvent string
+
+const (
+	LogEventNone    LogEvent = ""
+	LogEventAdded   LogEvent = "added"
+	LogEventRemoved LogEvent = "removed"
+	LogEventError   LogEvent = "error"
+)
+
+type LogLine struct {
+	Pod       string
+	Container string
+	Timestamp time.Time
+	Text      string
+	Event     LogEvent
+}
+
+type LogOptions struct {
+	Container    string
+	Follow       bool
+	Previous     bool
+	SinceSeconds int64
+	TailLines    int64
+	Timestamps   bool
+}
+
+func (o LogOptions) PodLogOptions() *corev1.PodLogOptions {
+	opts := &corev1.PodLogOptions{
+		Container:  o.Container,
+		Follow:     o.Follow,
+		Previous:   o.Previous,
+		Timestamps: o.Timestamps,
+	}
+	if o.SinceSeconds > 0 {
+		since := o.SinceSeconds
+		opts.SinceSeconds = &since
+	}
+	if o.TailLines > 0 {
+		tail := o.TailLines
+		opts.TailLines = &tail
+	}
+	return opts
+}
+
+func (p *Pod) StreamLogs(ctx context.Context, opts LogOptions) (io.ReadCloser, error) {
+	req := p.Client.CoreV1().Pods(p.Namespace).GetLogs(p.Name, opts.PodLogOptions())
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs: %v", err)
+	}
+	return stream, nil
+}
+
+func (p *Pod) FollowLogs(ctx context.Context, opts LogOptions, lines chan<- string) error {
+	stream, err := p.StreamLogs(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			stream.Close()
+		case <-done:
+		}
+	}()
+
+	return ReadLogLines(ctx, stream, lines)
+}
+
+func (p *Pod) WriteLogs(ctx context.Context, opts LogOptions, w io.Writer) error {
+	opts.Follow = false
+	opts.TailLines = 0
+
+	stream, err := p.StreamLogs(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if _, err := io.Copy(w, stream); err != nil {
+		return fmt.Errorf("failed to read logs: %v", err)
+	}
+	return nil
+}
+
+func WriteSelectorLogs(ctx context.Context, client Client, namespace, selector string, opts LogOptions, w io.Writer) error {
+	pods, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %v", err)
+	}
+
+	opts.Follow = false
+	opts.TailLines = 0
+	showTimestamps := opts.Timestamps
+	opts.Timestamps = true
+
+	var lines []LogLine
+	for _, item := range pods.Items {
+		pod := NewPod(item.Name, namespace, client)
+		for _, status := range item.Status.ContainerStatuses {
+			if status.ContainerID == "" {
+				continue
+			}
+			containerOpts := opts
+			containerOpts.Container = status.Name
+
+			stream, err := pod.StreamLogs(ctx, containerOpts)
+			if err != nil {
+				return err
+			}
+			err = scanLogLines(ctx, stream, func(line string) bool {
+				ts, text := ParseLogTimestamp(line)
+				lines = append(lines, LogLine{Pod: item.Name, Container: status.Name, Timestamp: ts, Text: text})
+				return true
+			})
+			stream.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Timestamp.Before(lines[j].Timestamp)
+	})
+
+	for _, line := range lines {
+		text := line.Text
+		if showTimestamps && !line.Timestamp.IsZero() {
+			text = line.Timestamp.Format(time.RFC3339Nano) + " " + text
+		}
+		if _, err := fmt.Fprintf(w, "%s %s %s\n", line.Pod, line.Container, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ReadLogLines(ctx context.Context, r io.Reader, lines chan<- string) error {
+	return scanLogLines(ctx, r, func(line string) bool {
+		select {
+		case lines <- line:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
+
+func scanLogLines(ctx context.Context, r io.Reader, emit func(line string) bool) error {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" && !emit(strings.TrimRight(line, "\r\n")) {
+			return nil
+		}
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read logs: %v", err)
+		}
+	}
+}
+
+func ParseLogTimestamp(line string) (time.Time, string) {
+	stamp, text, found := strings.Cut(line, " ")
+	if !found {
+		stamp, text = line, ""
+	}
+	ts, err := time.Parse(time.RFC3339Nano, stamp)
+	if err != nil {
+		return time.Time{}, line
+	}
+	return ts, text
+}
+
+type selectorTailer struct {
+	client    Client
+	namespace string
+	opts      LogOptions
+	lines     chan<- LogLine
+	mu        sync.Mutex
+	active    map[string]*containerTail
+	wg        sync.WaitGroup
+}
+
+type containerTail struct {
+	containerID string
+	cancel      context.CancelFunc
+}
+
+func TailSelectorLogs(ctx context.Context, client Client, namespace, selector string, opts LogOptions, lines chan<- LogLine) error {
+	t := &selectorTailer{
+		client:    client,
+		namespace: namespace,
+		opts:      opts,
+		lines:     lines,
+		active:    map[string]*containerTail{},
+	}
+	defer t.wg.Wait()
+
+	listOpts := metav1.ListOptions{LabelSelector: selector}
+	for {
+		pods, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, listOpts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to list pods: %v", err)
+		}
+		for i := range pods.Items {
+			t.sync(ctx, &pods.Items[i])
+		}
+
+		watchOpts := listOpts
+		watchOpts.ResourceVersion = pods.ResourceVersion
+		w, err := client.Clientset.CoreV1().Pods(namespace).Watch(ctx, watchOpts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to watch pods: %v", err)
+		}
+		t.consume(ctx, w)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (t *selectorTailer) consume(ctx context.Context, w watch.Interface) {
+	defer w.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+			pod, ok := event.Object.(*corev1.Pod)
+			if !ok {
+				return
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				t.sync(ctx, pod)
+			case watch.Deleted:
+				t.remove(ctx, pod.Name)
+			}
+		}
+	}
+}
+
+func (t *selectorTailer) sync(ctx context.Context, pod *corev1.Pod) {
+	if pod.DeletionTimestamp != nil {
+		t.remove(ctx, pod.Name)
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.ContainerID == "" || (status.State.Running == nil && status.State.Terminated == nil) {
+			continue
+		}
+		key := pod.Name + "/" + status.Name
+		if tail, ok := t.active[key]; ok && tail.containerID == status.ContainerID {
+			continue
+		}
+		if tail, ok := t.active[key]; ok {
+			tail.cancel()
+		}
+
+		tailCtx, cancel := context.WithCancel(ctx)
+		t.active[key] = &containerTail{containerID: status.ContainerID, cancel: cancel}
+		t.wg.Add(1)
+		go t.follow(tailCtx, pod.Name, status.Name)
+	}
+}
+
+func (t *selectorTailer) remove(ctx context.Context, podName string) {
+	t.mu.Lock()
+	var removed []string
+	for key, tail := range t.active {
+		pod, container, _ := strings.Cut(key, "/")
+		if pod != podName {
+			continue
+		}
+		tail.cancel()
+		delete(t.active, key)
+		removed = append(removed, container)
+	}
+	t.mu.Unlock()
+
+	for _, container := range removed {
+		t.send(ctx, LogLine{Pod: podName, Container: container, Timestamp: time.Now(), Event: LogEventRemoved})
+	}
+}
+
+func (t *selectorTailer) follow(ctx context.Context, podName, container string) {
+	defer t.wg.Done()
+
+	if !t.send(ctx, LogLine{Pod: podName, Container: container, Event: LogEventAdded}) {
+		return
+	}
+
+	opts := t.opts
+	opts.Container = container
+	opts.Follow = true
+	opts.Timestamps = true
+
+	pod := NewPod(podName, t.namespace, t.client)
+	stream, err := pod.StreamLogs(ctx, opts)
+	if err != nil {
+		t.send(ctx, LogLine{Pod: podName, Container: container, Timestamp: time.Now(), Text: err.Error(), Event: LogEventError})
+		return
+	}
+	defer stream.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			stream.Close()
+		case <-done:
+		}
+	}()
+
+	err = scanLogLines(ctx, stream, func(line string) bool {
+		ts, text := ParseLogTimestamp(line)
+		if ts.IsZero() {
+			ts = time.Now()
+		}
+		return t.send(ctx, LogLine{Pod: podName, Container: container, Timestamp: ts, Text: text})
+	})
+	if err != nil {
+		t.send(ctx, LogLine{Pod: podName, Container: container, Timestamp: time.Now(), Text: err.Error(), Event: LogEventError})
+	}
+}
+
+func (t *selectorTailer) send(ctx context.Context, line LogLine) bool {
+	select {
+	case t.lines <- line:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,233 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestLogOptions_PodLogOptions(t *testing.T) {
+	opts := LogOptions{
+		Container:    "app",
+		Follow:       true,
+		Previous:     true,
+		SinceSeconds: 300,
+		TailLines:    100,
+		Timestamps:   true,
+	}.PodLogOptions()
+
+	if opts.Container != "app" || !opts.Follow || !opts.Previous || !opts.Timestamps {
+		t.Errorf("Unexpected pod log options: %+v", opts)
+	}
+	if opts.SinceSeconds == nil || *opts.SinceSeconds != 300 {
+		t.Error("Expected SinceSeconds to be 300")
+	}
+	if opts.TailLines == nil || *opts.TailLines != 100 {
+		t.Error("Expected TailLines to be 100")
+	}
+
+	empty := LogOptions{}.PodLogOptions()
+	if empty.SinceSeconds != nil {
+		t.Error("Expected SinceSeconds to be unset for zero value")
+	}
+	if empty.TailLines != nil {
+		t.Error("Expected TailLines to be unset for zero value")
+	}
+}
+
+func TestReadLogLines(t *testing.T) {
+	lines := make(chan string, 10)
+	input := "first\r\nsecond\nthird"
+
+	if err := ReadLogLines(context.Background(), strings.NewReader(input), lines); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	close(lines)
+
+	var got []string
+	for line := range lines {
+		got = append(got, line)
+	}
+
+	expected := []string{"first", "second", "third"}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d lines, got %d: %v", len(expected), len(got), got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Line %d: expected %q, got %q", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestReadLogLines_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	lines := make(chan string)
+	if err := ReadLogLines(ctx, strings.NewReader("blocked\n"), lines); err != nil {
+		t.Errorf("Expected cancelled read to return nil, got %v", err)
+	}
+}
+
+func TestPod_FollowLogs(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "default"},
+	}
+	client := Client{Clientset: fake.NewSimpleClientset(pod)}
+
+	lines := make(chan string, 10)
+	err := NewPod("test-pod", "default", client).FollowLogs(context.Background(), LogOptions{Container: "app"}, lines)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	close(lines)
+
+	var got []string
+	for line := range lines {
+		got = append(got, line)
+	}
+	if len(got) != 1 || got[0] != "fake logs" {
+		t.Errorf("Expected fake logs line, got %v", got)
+	}
+}
+
+func TestParseLogTimestamp(t *testing.T) {
+	ts, text := ParseLogTimestamp("2024-01-02T03:04:05.123456789Z hello world")
+	if ts.IsZero() {
+		t.Fatal("Expected timestamp to be parsed")
+	}
+	if ts.Nanosecond() != 123456789 || ts.Second() != 5 {
+		t.Errorf("Unexpected timestamp: %v", ts)
+	}
+	if text != "hello world" {
+		t.Errorf("Expected text %q, got %q", "hello world", text)
+	}
+
+	ts, text = ParseLogTimestamp("no timestamp here")
+	if !ts.IsZero() {
+		t.Errorf("Expected zero timestamp, got %v", ts)
+	}
+	if text != "no timestamp here" {
+		t.Errorf("Expected line to be returned unchanged, got %q", text)
+	}
+}
+
+func runningPod(name string, labels map[string]string, containers ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+	}
+	for _, container := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container})
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:        container,
+			ContainerID: "containerd://" + name + "-" + container,
+			State:       corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		})
+	}
+	return pod
+}
+
+func receiveLogLine(t *testing.T, lines <-chan LogLine) LogLine {
+	t.Helper()
+	select {
+	case line := <-lines:
+		return line
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for log line")
+	}
+	return LogLine{}
+}
+
+func TestTailSelectorLogs(t *testing.T) {
+	labels := map[string]string{"app": "web"}
+	clientset := fake.NewSimpleClientset(
+		runningPod("web-1", labels, "app", "sidecar"),
+		runningPod("other", map[string]string{"app": "db"}, "db"),
+	)
+
+	watching := make(chan struct{})
+	clientset.PrependWatchReactor("pods", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		select {
+		case <-watching:
+		default:
+			close(watching)
+		}
+		return false, nil, nil
+	})
+
+	client := Client{Clientset: clientset}
+	ctx, cancel := context.WithCancel(context.Background())
+	lines := make(chan LogLine, 100)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- TailSelectorLogs(ctx, client, "default", "app=web", LogOptions{}, lines)
+	}()
+
+	seen := map[string]bool{}
+	for len(seen) < 4 {
+		line := receiveLogLine(t, lines)
+		if line.Pod != "web-1" {
+			t.Fatalf("Unexpected pod %q in tailed logs", line.Pod)
+		}
+		seen[line.Container+":"+string(line.Event)] = true
+	}
+	for _, key := range []string{"app:added", "app:", "sidecar:added", "sidecar:"} {
+		if !seen[key] {
+			t.Errorf("Expected %s in tailed logs, got %v", key, seen)
+		}
+	}
+
+	<-watching
+	newPod := runningPod("web-2", labels, "app")
+	if _, err := clientset.CoreV1().Pods("default").Create(ctx, newPod, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create pod: %v", err)
+	}
+
+	var text LogLine
+	for text.Text == "" {
+		text = receiveLogLine(t, lines)
+		if text.Pod != "web-2" {
+			t.Fatalf("Expected lines from new pod, got %+v", text)
+		}
+	}
+	if text.Text != "fake logs" || text.Timestamp.IsZero() {
+		t.Errorf("Unexpected line from new pod: %+v", text)
+	}
+
+	cancel()
+	if err := <-errc; err != nil {
+		t.Errorf("Expected nil error after cancel, got %v", err)
+	}
+}
+
+func TestWriteSelectorLogs(t *testing.T) {
+	labels := map[string]string{"app": "web"}
+	client := Client{Clientset: fake.NewSimpleClientset(
+		runningPod("web-1", labels, "app"),
+		runningPod("web-2", labels, "app"),
+		runningPod("other", map[string]string{"app": "db"}, "db"),
+	)}
+
+	var out strings.Builder
+	if err := WriteSelectorLogs(context.Background(), client, "default", "app=web", LogOptions{TailLines: 10}, &out); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %v", lines)
+	}
+	for _, line := range lines {
+		if !strings.HasSuffix(line, " app fake logs") || strings.HasPrefix(line, "other") {
+			t.Errorf("Unexpected exported line %q", line)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type MetadataChanges struct {
+	Labels      map[string]*string
+	Annotations map[string]*string
+}
+
+func (c MetadataChanges) Empty() bool {
+	return len(c.Labels) == 0 && len(c.Annotations) == 0
+}
+
+func ValidateMetadataChanges(changes MetadataChanges) error {
+	labels := map[string]string{}
+	for _, key := range sortedChangeKeys(changes.Labels) {
+		if value := changes.Labels[key]; value != nil {
+			labels[key] = *value
+		} else if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	if err := ValidateLabels(labels); err != nil {
+		return err
+	}
+
+	for _, key := range sortedChangeKeys(changes.Annotations) {
+		if errs := validation.IsQualifiedName(strings.ToLower(key)); len(errs) > 0 {
+			return fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+func sortedChangeKeys(m map[string]*string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func MetadataMergePatch(changes MetadataChanges) ([]byte, error) {
+	metadata := map[string]map[string]*string{}
+	if len(changes.Labels) > 0 {
+		metadata["labels"] = changes.Labels
+	}
+	if len(changes.Annotations) > 0 {
+		metadata["annotations"] = changes.Annotations
+	}
+	return json.Marshal(map[string]any{"metadata": metadata})
+}
+
+func GetResourceMetadata(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (map[string]string, map[string]string, error) {
+	if IsCustomResourceType(resourceType) {
+		return nil, nil, fmt.Errorf("reading metadata is not supported for custom resource type %s", resourceType)
+	}
+
+	var obj metav1.Object
+	var err error
+
+	opts := metav1.GetOptions{}
+	core := client.Clientset.CoreV1()
+	apps := client.Clientset.AppsV1()
+	batch := client.Clientset.BatchV1()
+	networking := client.Clientset.NetworkingV1()
+	switch resourceType {
+	case ResourceTypePod:
+		obj, err = core.Pods(namespace).Get(ctx, name, opts)
+	case ResourceTypeService:
+		obj, err = core.Services(namespace).Get(ctx, name, opts)
+	case ResourceTypeServiceAccount:
+		obj, err = core.ServiceAccounts(namespace).Get(ctx, name, opts)
+	case ResourceTypeConfigMap:
+		obj, err = core.ConfigMaps(namespace).Get(ctx, name, opts)
+	case ResourceTypeSecret:
+		obj, err = core.Secrets(namespace).Get(ctx, name, opts)
+	case ResourceTypeNode:
+		obj, err = core.Nodes().Get(ctx, name, opts)
+	case ResourceTypePersistentVolume:
+		obj, err = core.PersistentVolumes().Get(ctx, name, opts)
+	case ResourceTypePersistentVolumeClaim:
+		obj, err = core.PersistentVolumeClaims(namespace).Get(ctx, name, opts)
+	case ResourceTypeEvent:
+		obj, err = core.Events(namespace).Get(ctx, name, opts)
+	case ResourceTypeDeployment:
+		obj, err = apps.Deployments(namespace).Get(ctx, name, opts)
+	case ResourceTypeReplicaSet:
+		obj, err = apps.ReplicaSets(namespace).Get(ctx, name, opts)
+	case ResourceTypeDaemonSet:
+		obj, err = apps.DaemonSets(namespace).Get(ctx, name, opts)
+	case ResourceTypeStatefulSet:
+		obj, err = apps.StatefulSets(namespace).Get(ctx, name, opts)
+	case ResourceTypeJob:
+		obj, err = batch.Jobs(namespace).Get(ctx, name, opts)
+	case ResourceTypeCronJob:
+		obj, err = batch.CronJobs(namespace).Get(ctx, name, opts)
+	case ResourceTypeIngress:
+		obj, err = networking.Ingresses(namespace).Get(ctx, name, opts)
+	case ResourceTypeNetworkPolicy:
+		obj, err = networking.NetworkPolicies(namespace).Get(ctx, name, opts)
+	default:
+		return nil, nil, fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get %s %s: %v", resourceType, name, err)
+	}
+	return obj.GetLabels(), obj.GetAnnotations(), nil
+}
+
+func PatchResourceMetadata(ctx context.Context, client Client, resourceType ResourceType, namespace, name string, changes MetadataChanges) error {
+	if err := ValidateMetadataChanges(changes); err != nil {
+		return err
+	}
+	if changes.Empty() {
+		return nil
+	}
+
+	patch, err := MetadataMergePatch(changes)
+	if err != nil {
+		return fmt.Errorf("failed to build patch for %s %s: %v", resourceType, name, err)
+	}
+	if IsCustomResourceType(resourceType) {
+		return PatchCustomResource(ctx, client, resourceType, namespace, name, patch)
+	}
+
+	opts := metav1.PatchOptions{}
+	pt := types.MergePatchType
+	core := client.Clientset.CoreV1()
+	apps := client.Clientset.AppsV1()
+	batch := client.Clientset.BatchV1()
+	networking := client.Clientset.NetworkingV1()
+	switch resourceType {
+	case ResourceTypePod:
+		_, err = core.Pods(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeService:
+		_, err = core.Services(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeServiceAccount:
+		_, err = core.ServiceAccounts(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeConfigMap:
+		_, err = core.ConfigMaps(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeSecret:
+		_, err = core.Secrets(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeNode:
+		_, err = core.Nodes().Patch(ctx, name, pt, patch, opts)
+	case ResourceTypePersistentVolume:
+		_, err = core.PersistentVolumes().Patch(ctx, name, pt, patch, opts)
+	case ResourceTypePersistentVolumeClaim:
+		_, err = core.PersistentVolumeClaims(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeEvent:
+		_, err = core.Events(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeDeployment:
+		_, err = apps.Deployments(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeReplicaSet:
+		_, err = apps.ReplicaSets(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeDaemonSet:
+		_, err = apps.DaemonSets(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeStatefulSet:
+		_, err = apps.StatefulSets(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeJob:
+		_, err = batch.Jobs(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeCronJob:
+		_, err = batch.CronJobs(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeIngress:
+		_, err = networking.Ingresses(namespace).Patch(ctx, name, pt, patch, opts)
+	case ResourceTypeNetworkPolicy:
+		_, err = networking.NetworkPolicies(namespace).Patch(ctx, name, pt, patch, opts)
+	default:
+		return fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update metadata of %s %s: %v", resourceType, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestValidateMetadataChanges(t *testing.T) {
+	value := func(s string) *string { return &s }
+	tests := []struct {
+		name    string
+		changes MetadataChanges
+		wantErr string
+	}{
+		{"valid", MetadataChanges{
+			Labels:      map[string]*string{"app.kubernetes.io/name": value("web"), "old": nil},
+			Annotations: map[string]*string{"example.com/note": value("any text: allowed")},
+		}, ""},
+		{"bad label key", MetadataChanges{Labels: map[string]*string{"bad key": value("x")}}, "invalid label key"},
+		{"bad label value", MetadataChanges{Labels: map[string]*string{"tier": value("not valid!")}}, "invalid label value"},
+		{"bad removed key", MetadataChanges{Labels: map[string]*string{"-x": nil}}, "invalid label key"},
+		{"bad annotation key", MetadataChanges{Annotations: map[string]*string{"a/b/c": value("x")}}, "invalid annotation key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMetadataChanges(tt.changes)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMetadataMergePatch(t *testing.T) {
+	value := "web"
+	patch, err := MetadataMergePatch(MetadataChanges{Labels: map[string]*string{"tier": &value, "old": nil}})
+	if err != nil {
+		t.Fatalf("MetadataMergePatch failed: %v", err)
+	}
+	if string(patch) != `{"metadata":{"labels":{"old":null,"tier":"web"}}}` {
+		t.Errorf("Unexpected patch %s", patch)
+	}
+}
+
+func TestPatchResourceMetadata(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		Namespace:   "default",
+		Labels:      map[string]string{"app": "web", "old": "yes"},
+		Annotations: map[string]string{"keep": "me"},
+	}})
+	client := Client{Clientset: clientset}
+
+	tier := "frontend"
+	note := "edited"
+	err := PatchResourceMetadata(context.Background(), client, ResourceTypeDeployment, "default", "web", MetadataChanges{
+		Labels:      map[string]*string{"tier": &tier, "old": nil},
+		Annotations: map[string]*string{"note": &note},
+	})
+	if err != nil {
+		t.Fatalf("PatchResourceMetadata failed: %v", err)
+	}
+
+	labels, annotations, err := GetResourceMetadata(context.Background(), client, ResourceTypeDeployment, "default", "web")
+	if err != nil {
+		t.Fatalf("GetResourceMetadata failed: %v", err)
+	}
+	if len(labels) != 2 || labels["app"] != "web" || labels["tier"] != "frontend" {
+		t.Errorf("Unexpected labels %v", labels)
+	}
+	if len(annotations) != 2 || annotations["keep"] != "me" || annotations["note"] != "edited" {
+		t.Errorf("Unexpected annotations %v", annotations)
+	}
+
+	bad := "not valid!"
+	if err := PatchResourceMetadata(context.Background(), client, ResourceTypeDeployment, "default", "web", MetadataChanges{
+		Labels: map[string]*string{"tier": &bad},
+	}); err == nil {
+		t.Error("Expected an invalid label value to be rejected")
+	}
+	deployment, _ := clientset.AppsV1().Deployments("default").Get(context.Background(), "web", metav1.GetOptions{})
+	if deployment.Labels["tier"] != "frontend" {
+		t.Errorf("Expected the invalid change not to be sent, got %v", deployment.Labels)
+	}
+}
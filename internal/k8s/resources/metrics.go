@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -76,18 +77,28 @@ func (ml *MetricsLoader) loadMetrics() {
 		ml.mutex.Unlock()
 	}()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-ml.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
 	select {
 	case <-ml.stopChan:
 		return
 	default:
-		ml.loadCriticalMetrics()
+		ml.loadCriticalMetrics(ctx)
 	}
 
 	select {
 	case <-ml.stopChan:
 		return
 	default:
-		ml.loadNamespaceMetrics()
+		ml.loadNamespaceMetrics(ctx)
 	}
 
 	ml.mutex.Lock()
@@ -95,8 +106,8 @@ func (ml *MetricsLoader) loadMetrics() {
 	ml.mutex.Unlock()
 }
 
-func (ml *MetricsLoader) loadCriticalMetrics() {
-	namespaces, err := FetchNamespaces(ml.client)
+func (ml *MetricsLoader) loadCriticalMetrics(ctx context.Context) {
+	namespaces, err := FetchNamespaces(ctx, ml.client)
 	ml.mutex.Lock()
 	if err != nil {
 		ml.metrics.Error = err
@@ -106,7 +117,7 @@ func (ml *MetricsLoader) loadCriticalMetrics() {
 	ml.metrics.NamespacesNumber = len(namespaces)
 	ml.mutex.Unlock()
 
-	nodes, err := FetchNodeList(ml.client)
+	nodes, err := FetchNodeList(ctx, ml.client)
 	ml.mutex.Lock()
 	if err == nil {
 		ml.metrics.NodesNumber = len(nodes)
@@ -114,8 +125,8 @@ func (ml *MetricsLoader) loadCriticalMetrics() {
 	ml.mutex.Unlock()
 }
 
-func (ml *MetricsLoader) loadNamespaceMetrics() {
-	namespaces, err := FetchNamespaces(ml.client)
+func (ml *MetricsLoader) loadNamespaceMetrics(ctx context.Context) {
+	namespaces, err := FetchNamespaces(ctx, ml.client)
 	if err != nil {
 		ml.mutex.Lock()
 		ml.metrics.Error = err
@@ -142,7 +153,7 @@ func (ml *MetricsLoader) loadNamespaceMetrics() {
 		}
 
 		batch := namespaces[i:end]
-		ml.loadBatchMetrics(batch, &totalPods, &totalDeployments, &totalServices, &totalReplicaSets)
+		ml.loadBatchMetrics(ctx, batch, &totalPods, &totalDeployments, &totalServices, &totalReplicaSets)
 	}
 
 	ml.mutex.Lock()
@@ -153,7 +164,7 @@ func (ml *MetricsLoader) loadNamespaceMetrics() {
 	ml.mutex.Unlock()
 }
 
-func (ml *MetricsLoader) loadBatchMetrics(namespaces []string, totalPods, totalDeployments, totalServices, totalReplicaSets *int) {
+func (ml *MetricsLoader) loadBatchMetrics(ctx context.Context, namespaces []string, totalPods, totalDeployments, totalServices, totalReplicaSets *int) {
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 
@@ -168,25 +179,25 @@ func (ml *MetricsLoader) loadBatchMetrics(namespaces []string, totalPods, totalD
 			default:
 			}
 
-			if pods, err := FetchPods(ml.client, ns, ""); err == nil {
+			if pods, err := FetchPods(ctx, ml.client, ns, ""); err == nil {
 				mu.Lock()
 				*totalPods += len(pods)
 				mu.Unlock()
 			}
 
-			if deployments, err := FetchDeploymentList(ml.client, ns); err == nil {
+			if deployments, err := FetchDeploymentList(ctx, ml.client, ns); err == nil {
 				mu.Lock()
 				*totalDeployments += len(deployments)
 				mu.Unlock()
 			}
 
-			if services, err := FetchServiceList(ml.client, ns); err == nil {
+			if services, err := FetchServiceList(ctx, ml.client, ns); err == nil {
 				mu.Lock()
 				*totalServices += len(services)
 				mu.Unlock()
 			}
 
-			if replicaSets, err := FetchReplicaSetList(ml.client, ns); err == nil {
+			if replicaSets, err := FetchReplicaSetList(ctx, ml.client, ns); err == nil {
 				mu.Lock()
 				*totalReplicaSets += len(replicaSets)
 				mu.Unlock()
@@ -11,12 +11,12 @@ type Namespaces struct {
 	k Client
 }
 
-func FetchNamespaces(k Client) ([]string, error) {
+func FetchNamespaces(ctx context.Context, k Client) ([]string, error) {
 	if k.Clientset == nil {
 		return []string{}, errors.New("clientset is nil")
 	}
 
-	namespaces, err := k.Clientset.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+	namespaces, err := k.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return []string{}, err
 	}
@@ -31,8 +31,8 @@ func NewNode(name string, k Client) *NodeInfo {
 	}
 }
 
-func FetchNodeList(client Client) ([]string, error) {
-	nodes, err := client.Clientset.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+func FetchNodeList(ctx context.Context, client Client) ([]string, error) {
+	nodes, err := client.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch nodes: %v", err)
 	}
@@ -45,17 +45,14 @@ func FetchNodeList(client Client) ([]string, error) {
 	return nodeNames, nil
 }
 
-func GetNodesTableData(client Client) ([]NodeInfo, error) {
-	nodes, err := client.Clientset.CoreV1().Nodes().List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetNodesTableData(ctx context.Context, client Client) ([]NodeInfo, error) {
+	nodes, err := listNodes(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list nodes: %v", err)
 	}
 
 	var nodeInfos []NodeInfo
-	for _, node := range nodes.Items {
+	for _, node := range nodes {
 		roles := getNodeRoles(&node)
 
 		status := getNodeStatus(&node)
@@ -106,16 +103,21 @@ func getNodeRoles(node *corev1.Node) string {
 }
 
 func getNodeStatus(node *corev1.Node) string {
+	status := "Unknown"
 	for _, condition := range node.Status.Conditions {
 		if condition.Type == corev1.NodeReady {
 			if condition.Status == corev1.ConditionTrue {
-				return "Ready"
+				status = "Ready"
 			} else {
-				return "NotReady"
+				status = "NotReady"
 			}
+			break
 		}
 	}
-	return "Unknown"
+	if node.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+	return status
 }
 
 func getNodeResources(node *corev1.Node) (cpu, memory string) {
@@ -130,8 +132,8 @@ func getNodeResources(node *corev1.Node) (cpu, memory string) {
 	return cpu, memory
 }
 
-func (n *NodeInfo) Fetch() error {
-	node, err := n.Client.Clientset.CoreV1().Nodes().Get(context.Background(), n.Name, metav1.GetOptions{})
+func (n *NodeInfo) Fetch(ctx context.Context) error {
+	node, err := n.Client.Clientset.CoreV1().Nodes().Get(ctx, n.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get node: %v", err)
 	}
@@ -139,14 +141,14 @@ func (n *NodeInfo) Fetch() error {
 	return nil
 }
 
-func (n *NodeInfo) Describe() (string, error) {
+func (n *NodeInfo) Describe(ctx context.Context) (string, error) {
 	if n.Raw == nil {
-		if err := n.Fetch(); err != nil {
+		if err := n.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch node: %v", err)
 		}
 	}
 
-	events, err := n.Client.Clientset.CoreV1().Events("").List(context.Background(), metav1.ListOptions{
+	events, err := n.Client.Clientset.CoreV1().Events("").List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.kind=Node", n.Name),
 	})
 	if err != nil {
@@ -243,6 +245,15 @@ func (n *NodeInfo) DescribeNode(events *corev1.EventList) (map[string]any, error
 		desc["roles"] = roles
 	}
 
+	desc["unschedulable"] = n.Raw.Spec.Unschedulable
+	if len(n.Raw.Spec.Taints) > 0 {
+		taints := make([]string, 0, len(n.Raw.Spec.Taints))
+		for _, taint := range n.Raw.Spec.Taints {
+			taints = append(taints, taint.ToString())
+		}
+		desc["taints"] = taints
+	}
+
 	if len(events.Items) > 0 {
 		eventList := make([]Event, 0)
 		for _, event := range events.Items {
@@ -261,8 +272,8 @@ func (n *NodeInfo) DescribeNode(events *corev1.EventList) (map[string]any, error
 	return desc, nil
 }
 
-func DeleteNode(client Client, nodeName string) error {
-	err := client.Clientset.CoreV1().Nodes().Delete(context.Background(), nodeName, metav1.DeleteOptions{})
+func DeleteNode(ctx context.Context, client Client, nodeName string) error {
+	err := client.Clientset.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete node %s: %v", nodeName, err)
 	}
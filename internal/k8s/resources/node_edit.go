@@ -0,0 +1,159 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var TaintEffects = []corev1.TaintEffect{
+	corev1.TaintEffectNoSchedule,
+	corev1.TaintEffectPreferNoSchedule,
+	corev1.TaintEffectNoExecute,
+}
+
+type jsonPatchOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+func ValidateTaints(taints []corev1.Taint) error {
+	seen := map[string]bool{}
+	for _, taint := range taints {
+		if errs := validation.IsQualifiedName(taint.Key); len(errs) > 0 {
+			return fmt.Errorf("invalid taint key %q: %s", taint.Key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(taint.Value); len(errs) > 0 {
+			return fmt.Errorf("invalid taint value %q: %s", taint.Value, strings.Join(errs, "; "))
+		}
+		valid := false
+		for _, effect := range TaintEffects {
+			valid = valid || taint.Effect == effect
+		}
+		if !valid {
+			return fmt.Errorf("invalid taint effect %q for %s", taint.Effect, taint.Key)
+		}
+		id := taint.Key + ":" + string(taint.Effect)
+		if seen[id] {
+			return fmt.Errorf("duplicate taint %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func ValidateLabels(labels map[string]string) error {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(labels[key]); len(errs) > 0 {
+			return fmt.Errorf("invalid label value %q for %s: %s", labels[key], key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+func NodeEditPatch(node *corev1.Node, taints []corev1.Taint, labels map[string]string) ([]byte, error) {
+	var ops []jsonPatchOp
+	if node.ResourceVersion != "" {
+		ops = append(ops, jsonPatchOp{Op: "test", Path: "/metadata/resourceVersion", Value: node.ResourceVersion})
+	}
+
+	switch {
+	case len(taints) == 0 && len(node.Spec.Taints) > 0:
+		ops = append(ops, jsonPatchOp{Op: "remove", Path: "/spec/taints"})
+	case len(taints) > 0 && !reflect.DeepEqual(taints, node.Spec.Taints):
+		ops = append(ops, jsonPatchOp{Op: "add", Path: "/spec/taints", Value: taints})
+	}
+
+	ops = append(ops, mapPatch("/metadata/labels", node.Labels, labels)...)
+
+	if len(ops) == 0 || (len(ops) == 1 && ops[0].Op == "test") {
+		return nil, nil
+	}
+	return json.Marshal(ops)
+}
+
+func mapPatch(path string, original, updated map[string]string) []jsonPatchOp {
+	if len(original) == 0 {
+		if len(updated) == 0 {
+			return nil
+		}
+		return []jsonPatchOp{{Op: "add", Path: path, Value: updated}}
+	}
+
+	keys := make([]string, 0, len(original)+len(updated))
+	for key := range original {
+		keys = append(keys, key)
+	}
+	for key := range updated {
+		if _, ok := original[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var ops []jsonPatchOp
+	for _, key := range keys {
+		keyPath := path + "/" + escapeJSONPointer(key)
+		value, keep := updated[key]
+		previous, existed := original[key]
+		switch {
+		case !keep:
+			ops = append(ops, jsonPatchOp{Op: "remove", Path: keyPath})
+		case !existed:
+			ops = append(ops, jsonPatchOp{Op: "add", Path: keyPath, Value: value})
+		case previous != value:
+			ops = append(ops, jsonPatchOp{Op: "replace", Path: keyPath, Value: value})
+		}
+	}
+	return ops
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+func (n *NodeInfo) UpdateTaintsAndLabels(ctx context.Context, taints []corev1.Taint, labels map[string]string) error {
+	if err := ValidateTaints(taints); err != nil {
+		return err
+	}
+	if err := ValidateLabels(labels); err != nil {
+		return err
+	}
+	if n.Raw == nil {
+		if err := n.Fetch(ctx); err != nil {
+			return err
+		}
+	}
+
+	patch, err := NodeEditPatch(n.Raw, taints, labels)
+	if err != nil {
+		return fmt.Errorf("failed to build patch for node %s: %v", n.Name, err)
+	}
+	if patch == nil {
+		return nil
+	}
+
+	node, err := n.Client.Clientset.CoreV1().Nodes().Patch(ctx, n.Name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update node %s: %v", n.Name, err)
+	}
+	n.Raw = node
+	return nil
+}
@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodeUpdateTaintsAndLabels(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{"kubernetes.io/hostname": "node-1", "tier": "old", "stale": "yes"},
+		},
+		Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}},
+	})
+	node := NewNode("node-1", Client{Clientset: clientset})
+
+	taints := []corev1.Taint{
+		{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "maintenance", Effect: corev1.TaintEffectNoExecute},
+	}
+	labels := map[string]string{"kubernetes.io/hostname": "node-1", "tier": "new", "zone": "a"}
+	if err := node.UpdateTaintsAndLabels(context.Background(), taints, labels); err != nil {
+		t.Fatalf("UpdateTaintsAndLabels failed: %v", err)
+	}
+
+	updated, _ := clientset.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+	if len(updated.Spec.Taints) != 2 || updated.Spec.Taints[1].Key != "maintenance" {
+		t.Errorf("Expected the new taint, got %+v", updated.Spec.Taints)
+	}
+	if len(updated.Labels) != 3 || updated.Labels["tier"] != "new" || updated.Labels["zone"] != "a" {
+		t.Errorf("Expected labels to be replaced, got %v", updated.Labels)
+	}
+
+	desc, err := node.DescribeNode(&corev1.EventList{})
+	if err != nil {
+		t.Fatalf("DescribeNode failed: %v", err)
+	}
+	if described, _ := desc["taints"].([]string); len(described) != 2 || described[1] != "maintenance:NoExecute" {
+		t.Errorf("Expected the describe output to include the new taints, got %v", desc["taints"])
+	}
+
+	if err := node.UpdateTaintsAndLabels(context.Background(), nil, labels); err != nil {
+		t.Fatalf("removing taints failed: %v", err)
+	}
+	updated, _ = clientset.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+	if len(updated.Spec.Taints) != 0 {
+		t.Errorf("Expected taints to be removed, got %+v", updated.Spec.Taints)
+	}
+}
+
+func TestNodeEditPatch(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		ResourceVersion: "7",
+		Labels:          map[string]string{"example.com/role": "db"},
+	}}
+
+	patch, err := NodeEditPatch(node, nil, map[string]string{"example.com/role": "db"})
+	if err != nil || patch != nil {
+		t.Errorf("Expected no patch when nothing changed, got %s (%v)", patch, err)
+	}
+
+	patch, _ = NodeEditPatch(node, nil, map[string]string{"example.com/role": "cache"})
+	for _, expected := range []string{`"op":"test","path":"/metadata/resourceVersion","value":"7"`, `"path":"/metadata/labels/example.com~1role"`} {
+		if !strings.Contains(string(patch), expected) {
+			t.Errorf("Expected %s in patch %s", expected, patch)
+		}
+	}
+}
+
+func TestValidateTaintsAndLabels(t *testing.T) {
+	invalidTaints := [][]corev1.Taint{
+		{{Key: "bad key", Effect: corev1.TaintEffectNoSchedule}},
+		{{Key: "a", Effect: "Sometimes"}},
+		{{Key: "a", Effect: corev1.TaintEffectNoSchedule}, {Key: "a", Value: "b", Effect: corev1.TaintEffectNoSchedule}},
+	}
+	for _, taints := range invalidTaints {
+		if err := ValidateTaints(taints); err == nil {
+			t.Errorf("Expected %+v to be rejected", taints)
+		}
+	}
+	if err := ValidateLabels(map[string]string{"app": "has spaces"}); err == nil {
+		t.Error("Expected an invalid label value to be rejected")
+	}
+}
@@ -3,13 +3,12 @@ package k8s
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"os"
+	"strings"
 	"time"
 
-	"github.com/charmbracelet/lipgloss"
-	customstyles "github.com/otavioCosta2110/k8s-tui/internal/app/ui/styles/custom_styles"
 	"gopkg.in/yaml.v3"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,8 +16,11 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 )
 
+var DefaultShells = []string{"bash", "sh"}
+
 type Pod struct {
 	Name      string
 	Namespace string
@@ -37,8 +39,8 @@ func NewPod(name, namespace string, k Client) *Pod {
 	}
 }
 
-func (p *Pod) Fetch() error {
-	pod, err := p.Client.CoreV1().Pods(p.Namespace).Get(context.Background(), p.Name, metav1.GetOptions{})
+func (p *Pod) Fetch(ctx context.Context) error {
+	pod, err := p.Client.CoreV1().Pods(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get pod: %v", err)
 	}
@@ -46,14 +48,14 @@ func (p *Pod) Fetch() error {
 	return nil
 }
 
-func (p *Pod) Describe() (string, error) {
+func (p *Pod) Describe(ctx context.Context) (string, error) {
 	if p.Raw == nil {
-		if err := p.Fetch(); err != nil {
+		if err := p.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch pod: %v", err)
 		}
 	}
 
-	events, err := p.Client.CoreV1().Events(p.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := p.Client.CoreV1().Events(p.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s", p.Name, p.Namespace),
 	})
 	if err != nil {
@@ -243,56 +245,61 @@ func (pod Pod) DescribePod(events *corev1.EventList) (any, error) {
 	return desc, nil
 }
 
-func (p *Pod) GetLogs() (string, error) {
+func (p *Pod) GetLogs(ctx context.Context) (string, error) {
 	req := p.Client.CoreV1().Pods(p.Namespace).GetLogs(p.Name, &corev1.PodLogOptions{})
-	logs, err := req.DoRaw(context.Background())
+	logs, err := req.DoRaw(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get logs: %v", err)
 	}
 	return string(logs), nil
 }
 
-func (p *Pod) Exec(command []string) (string, string, error) {
+func (p *Pod) Exec(ctx context.Context, command []string) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	err := p.ExecStream(ctx, "", command, nil, &stdout, &stderr)
+	return stdout.String(), stderr.String(), err
+}
+
+func (p *Pod) ExecStream(ctx context.Context, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
 	req := p.Client.CoreV1().RESTClient().Post().
 		Resource("pods").
 		Name(p.Name).
 		Namespace(p.Namespace).
 		SubResource("exec").
 		VersionedParams(&corev1.PodExecOptions{
-			Command: command,
-			Stdin:   true,
-			Stdout:  true,
-			Stderr:  true,
-			TTY:     false,
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil,
+			TTY:       false,
 		}, scheme.ParameterCodec)
 
 	exec, err := remotecommand.NewSPDYExecutor(p.Config, "POST", req.URL())
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create executor: %v", err)
+		return fmt.Errorf("failed to create executor: %v", err)
 	}
 
-	var stdout, stderr bytes.Buffer
-	err = exec.StreamWithContext(context.Background(), remotecommand.StreamOptions{
-		Stdout: &stdout,
-		Stderr: &stderr,
+	return exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
 		Tty:    false,
 	})
-
-	return stdout.String(), stderr.String(), err
 }
 
-func (p *Pod) ExecWithTTY(command []string) error {
+func (p *Pod) ExecWithTTY(ctx context.Context, container string, command []string, stdin io.Reader, stdout io.Writer, sizes remotecommand.TerminalSizeQueue) error {
 	req := p.Client.CoreV1().RESTClient().Post().
 		Resource("pods").
 		Name(p.Name).
 		Namespace(p.Namespace).
 		SubResource("exec").
 		VersionedParams(&corev1.PodExecOptions{
-			Command: command,
-			Stdin:   true,
-			Stdout:  true,
-			Stderr:  true,
-			TTY:     true,
+			Container: container,
+			Command:   command,
+			Stdin:     true,
+			Stdout:    true,
+			TTY:       true,
 		}, scheme.ParameterCodec)
 
 	exec, err := remotecommand.NewSPDYExecutor(p.Config, "POST", req.URL())
@@ -300,32 +307,56 @@ func (p *Pod) ExecWithTTY(command []string) error {
 		return fmt.Errorf("failed to create executor: %v", err)
 	}
 
-	borderedStdout := newBorderedWriter(os.Stdout)
-
-	return exec.StreamWithContext(context.Background(), remotecommand.StreamOptions{
-		Stdin:  os.Stdin,
-		Stdout: borderedStdout,
-		Stderr: os.Stderr,
-		Tty:    true,
+	return exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Tty:               true,
+		TerminalSizeQueue: sizes,
 	})
 }
 
-func (p *Pod) GetStatus() (corev1.PodStatus, error) {
+func (p *Pod) Shell(ctx context.Context, container string, stdin io.Reader, stdout io.Writer, sizes remotecommand.TerminalSizeQueue) error {
+	err := p.ExecWithTTY(ctx, container, ShellCommand(DefaultShells...), stdin, stdout, sizes)
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		switch exitErr.ExitStatus() {
+		case 126, 127:
+			return fmt.Errorf("no shell found in container %s (tried %s)", container, strings.Join(DefaultShells, ", "))
+		}
+		return nil
+	}
+	return err
+}
+
+func ShellCommand(shells ...string) []string {
+	var script strings.Builder
+	for i, shell := range shells {
+		if i == len(shells)-1 {
+			script.WriteString("exec " + shell)
+			break
+		}
+		fmt.Fprintf(&script, "command -v %[1]s >/dev/null 2>&1 && exec %[1]s; ", shell)
+	}
+	return []string{"sh", "-c", script.String()}
+}
+
+func (p *Pod) GetStatus(ctx context.Context) (corev1.PodStatus, error) {
 	if p.Raw == nil {
-		if err := p.Fetch(); err != nil {
+		if err := p.Fetch(ctx); err != nil {
 			return corev1.PodStatus{}, err
 		}
 	}
 	return p.Raw.Status, nil
 }
 
-func (p *Pod) Delete() error {
-	return p.Client.CoreV1().Pods(p.Namespace).Delete(context.Background(), p.Name, metav1.DeleteOptions{})
+func (p *Pod) Delete(ctx context.Context) error {
+	return p.Client.CoreV1().Pods(p.Namespace).Delete(ctx, p.Name, metav1.DeleteOptions{})
 }
 
-func (p *Pod) GetContainers() ([]string, error) {
+func (p *Pod) GetContainers(ctx context.Context) ([]string, error) {
 	if p.Raw == nil {
-		if err := p.Fetch(); err != nil {
+		if err := p.Fetch(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -341,23 +372,3 @@ func (p *Pod) GetContainers() ([]string, error) {
 func formatTime(t metav1.Time) string {
 	return t.Format(time.RFC1123)
 }
-
-type borderedWriter struct {
-	innerWriter io.Writer
-}
-
-func newBorderedWriter(w io.Writer) *borderedWriter {
-	return &borderedWriter{innerWriter: w}
-}
-
-func (b *borderedWriter) Write(p []byte) (n int, err error) {
-	style := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#874BFD")).
-		Padding(1, 2).
-		BorderBackground(lipgloss.Color(customstyles.BackgroundColor)).
-		Background(lipgloss.Color(customstyles.BackgroundColor))
-
-	styledText := style.Render(string(p))
-	return b.innerWriter.Write([]byte(styledText))
-}
@@ -1,9 +1,9 @@
 package k8s
 
 import (
-	"bytes"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -37,29 +37,16 @@ func TestFormatTime(t *testing.T) {
 	}
 }
 
-func TestBorderedWriter(t *testing.T) {
-	var buf bytes.Buffer
-	writer := newBorderedWriter(&buf)
-	if writer == nil {
-		t.Error("Expected borderedWriter to be non-nil")
+func TestShellCommand(t *testing.T) {
+	command := ShellCommand("bash", "sh")
+	expected := []string{"sh", "-c", "command -v bash >/dev/null 2>&1 && exec bash; exec sh"}
+	if !reflect.DeepEqual(command, expected) {
+		t.Errorf("Expected %q, got %q", expected, command)
 	}
 
-	testData := []byte("test output")
-	n, err := writer.Write(testData)
-	if err != nil {
-		t.Errorf("Write failed: %v", err)
-	}
-	if n <= len(testData) {
-		t.Errorf("Expected to write more than %d bytes (styled output), wrote %d", len(testData), n)
-	}
-
-	if buf.Len() <= len(testData) {
-		t.Error("Expected styled output to be longer than input")
-	}
-
-	output := buf.String()
-	if len(output) == 0 {
-		t.Error("Expected non-empty output")
+	command = ShellCommand("sh")
+	if command[2] != "exec sh" {
+		t.Errorf("Expected a single shell to be exec'd directly, got %q", command[2])
 	}
 }
 
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/otavioCosta2110/k8s-tui/pkg/format"
-	"github.com/otavioCosta2110/k8s-tui/pkg/logger"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,40 +19,21 @@ type PodInfo struct {
 	Age       string
 }
 
-func FetchPods(client Client, namespace string, selector string) ([]PodInfo, error) {
-	logger.Debug("selector 5" + selector)
-	listOptions := metav1.ListOptions{}
-	if selector != "" {
-		listOptions.LabelSelector = selector
-	}
-	logger.Debug("selector " + listOptions.LabelSelector + selector)
-	pods, err := client.Clientset.CoreV1().Pods(namespace).List(context.Background(), listOptions)
+func FetchPods(ctx context.Context, client Client, namespace string, selector string) ([]PodInfo, error) {
+	pods, err := listPods(ctx, client, namespace, selector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch pods: %v", err)
 	}
 
-	podsInfo := make([]PodInfo, 0, len(pods.Items))
-	for _, podCore := range pods.Items {
-		pod, err := GetPodDetails(client, namespace, &podCore)
-		if err != nil {
-			return nil, err
-		}
-		podsInfo = append(podsInfo, pod)
+	podsInfo := make([]PodInfo, 0, len(pods))
+	for _, pod := range pods {
+		podsInfo = append(podsInfo, newPodInfo(&pod))
 	}
 
 	return podsInfo, nil
 }
 
-func GetPodDetails(client Client, namespace string, podCore *corev1.Pod) (PodInfo, error) {
-	pod, err := client.Clientset.CoreV1().Pods(namespace).Get(
-		context.Background(),
-		podCore.Name,
-		metav1.GetOptions{},
-	)
-	if err != nil {
-		return PodInfo{}, fmt.Errorf("failed to get pod details: %v", err)
-	}
-
+func newPodInfo(pod *corev1.Pod) PodInfo {
 	readyContainers := 0
 	totalContainers := len(pod.Spec.Containers)
 	for _, cs := range pod.Status.ContainerStatuses {
@@ -79,11 +59,11 @@ func GetPodDetails(client Client, namespace string, podCore *corev1.Pod) (PodInf
 		Status:    string(pod.Status.Phase),
 		Restarts:  restarts,
 		Age:       age,
-	}, nil
+	}
 }
 
-func DeletePod(client Client, namespace string, podName string) error {
-	err := client.Clientset.CoreV1().Pods(namespace).Delete(context.Background(), podName, metav1.DeleteOptions{})
+func DeletePod(ctx context.Context, client Client, namespace string, podName string) error {
+	err := client.Clientset.CoreV1().Pods(namespace).Delete(ctx, podName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete pod %s: %v", podName, err)
 	}
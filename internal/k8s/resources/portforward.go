@@ -0,0 +1,333 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const portForwardReadyTimeout = 15 * time.Second
+
+type PortForwardStatus string
+
+const (
+	PortForwardStarting PortForwardStatus = "Starting"
+	PortForwardActive   PortForwardStatus = "Active"
+	PortForwardStopped  PortForwardStatus = "Stopped"
+	PortForwardFailed   PortForwardStatus = "Failed"
+)
+
+type PortForward struct {
+	ID         int
+	Kind       ResourceType
+	Namespace  string
+	Name       string
+	Pod        string
+	LocalPort  int
+	RemotePort int
+	StartedAt  time.Time
+
+	mu     sync.Mutex
+	status PortForwardStatus
+	err    error
+	stopCh chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+func (f *PortForward) Target() string {
+	return strings.ToLower(string(f.Kind)) + "/" + f.Name
+}
+
+func (f *PortForward) Status() (PortForwardStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.status, f.err
+}
+
+func (f *PortForward) setStatus(status PortForwardStatus, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+	f.err = err
+}
+
+func (f *PortForward) stop() {
+	f.once.Do(func() { close(f.stopCh) })
+}
+
+type PortForwardDialer func(client Client, namespace, pod string) (httpstream.Dialer, error)
+
+type PortForwardManager struct {
+	mu       sync.Mutex
+	nextID   int
+	forwards map[int]*PortForward
+	dialer   PortForwardDialer
+}
+
+var PortForwards = NewPortForwardManager()
+
+func NewPortForwardManager() *PortForwardManager {
+	return &PortForwardManager{
+		forwards: map[int]*PortForward{},
+		dialer:   spdyPortForwardDialer,
+	}
+}
+
+func spdyPortForwardDialer(client Client, namespace, pod string) (httpstream.Dialer, error) {
+	if client.Config == nil {
+		return nil, fmt.Errorf("no cluster config available for port-forward")
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(client.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create port-forward transport: %v", err)
+	}
+
+	req := client.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("portforward")
+
+	return spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL()), nil
+}
+
+func (m *PortForwardManager) StartPod(client Client, namespace, pod string, localPort, remotePort int) (*PortForward, error) {
+	if remotePort <= 0 {
+		return nil, fmt.Errorf("a remote port is required")
+	}
+	return m.start(client, &PortForward{
+		Kind:       ResourceTypePod,
+		Namespace:  namespace,
+		Name:       pod,
+		Pod:        pod,
+		LocalPort:  localPort,
+		RemotePort: remotePort,
+	})
+}
+
+func (m *PortForwardManager) StartService(ctx context.Context, client Client, namespace, service string, localPort, servicePort int) (*PortForward, error) {
+	pod, targetPort, err := ResolveServicePort(ctx, client, namespace, service, servicePort)
+	if err != nil {
+		return nil, err
+	}
+	return m.start(client, &PortForward{
+		Kind:       ResourceTypeService,
+		Namespace:  namespace,
+		Name:       service,
+		Pod:        pod,
+		LocalPort:  localPort,
+		RemotePort: targetPort,
+	})
+}
+
+func (m *PortForwardManager) start(client Client, forward *PortForward) (*PortForward, error) {
+	dialer, err := m.dialer(client, forward.Namespace, forward.Pod)
+	if err != nil {
+		return nil, err
+	}
+
+	forward.status = PortForwardStarting
+	forward.stopCh = make(chan struct{})
+	forward.done = make(chan struct{})
+	readyCh := make(chan struct{})
+
+	forwarder, err := portforward.NewOnAddresses(
+		dialer,
+		[]string{"localhost"},
+		[]string{fmt.Sprintf("%d:%d", forward.LocalPort, forward.RemotePort)},
+		forward.stopCh,
+		readyCh,
+		io.Discard,
+		io.Discard,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create port-forward: %v", err)
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		defer close(forward.done)
+		err := forwarder.ForwardPorts()
+		errc <- err
+		if err != nil {
+			forward.setStatus(PortForwardFailed, err)
+		} else {
+			forward.setStatus(PortForwardStopped, nil)
+		}
+	}()
+
+	select {
+	case <-readyCh:
+	case err := <-errc:
+		if err == nil {
+			err = fmt.Errorf("port-forward stopped before it was ready")
+		}
+		return nil, fmt.Errorf("failed to start port-forward to %s: %v", forward.Target(), err)
+	case <-time.After(portForwardReadyTimeout):
+		forward.stop()
+		return nil, fmt.Errorf("timed out starting port-forward to %s", forward.Target())
+	}
+
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		forward.stop()
+		return nil, fmt.Errorf("failed to get forwarded ports: %v", err)
+	}
+	if len(ports) > 0 {
+		forward.LocalPort = int(ports[0].Local)
+	}
+	forward.StartedAt = time.Now()
+	forward.setStatus(PortForwardActive, nil)
+
+	m.mu.Lock()
+	m.nextID++
+	forward.ID = m.nextID
+	m.forwards[forward.ID] = forward
+	m.mu.Unlock()
+
+	return forward, nil
+}
+
+func (m *PortForwardManager) List() []*PortForward {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	forwards := make([]*PortForward, 0, len(m.forwards))
+	for _, forward := range m.forwards {
+		forwards = append(forwards, forward)
+	}
+	sort.Slice(forwards, func(i, j int) bool {
+		return forwards[i].ID < forwards[j].ID
+	})
+	return forwards
+}
+
+func (m *PortForwardManager) Stop(id int) error {
+	m.mu.Lock()
+	forward, ok := m.forwards[id]
+	delete(m.forwards, id)
+	m.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("port-forward %d not found", id)
+	}
+	forward.stop()
+	<-forward.done
+	return nil
+}
+
+func (m *PortForwardManager) StopAll() {
+	for _, forward := range m.List() {
+		m.Stop(forward.ID)
+	}
+}
+
+func ResolveServicePort(ctx context.Context, client Client, namespace, name string, servicePort int) (string, int, error) {
+	service, err := client.Clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get service: %v", err)
+	}
+	if len(service.Spec.Selector) == 0 {
+		return "", 0, fmt.Errorf("service %s has no selector", name)
+	}
+
+	var port *corev1.ServicePort
+	for i := range service.Spec.Ports {
+		if servicePort <= 0 || int(service.Spec.Ports[i].Port) == servicePort {
+			port = &service.Spec.Ports[i]
+			break
+		}
+	}
+	if port == nil {
+		return "", 0, fmt.Errorf("service %s does not expose port %d", name, servicePort)
+	}
+
+	pods, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(service.Spec.Selector).String(),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to list pods for service %s: %v", name, err)
+	}
+
+	var target *corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if target == nil || (isPodReady(pod) && !isPodReady(target)) {
+			target = pod
+		}
+	}
+	if target == nil {
+		return "", 0, fmt.Errorf("no running pods found for service %s", name)
+	}
+
+	targetPort, err := containerPortForServicePort(target, *port)
+	if err != nil {
+		return "", 0, err
+	}
+	return target.Name, targetPort, nil
+}
+
+func containerPortForServicePort(pod *corev1.Pod, port corev1.ServicePort) (int, error) {
+	switch {
+	case port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal > 0:
+		return int(port.TargetPort.IntVal), nil
+	case port.TargetPort.Type == intstr.String && port.TargetPort.StrVal != "":
+		for _, container := range pod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == port.TargetPort.StrVal {
+					return int(containerPort.ContainerPort), nil
+				}
+			}
+		}
+		return 0, fmt.Errorf("pod %s has no container port named %s", pod.Name, port.TargetPort.StrVal)
+	}
+	return int(port.Port), nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func ParsePortPair(value string) (int, int, error) {
+	value = strings.TrimSpace(value)
+	local, remote, found := strings.Cut(value, ":")
+	if !found {
+		remote = local
+	}
+
+	remotePort, err := strconv.Atoi(remote)
+	if err != nil || remotePort <= 0 || remotePort > 65535 {
+		return 0, 0, fmt.Errorf("invalid remote port %q", remote)
+	}
+	if local == "" {
+		return 0, remotePort, nil
+	}
+	localPort, err := strconv.Atoi(local)
+	if err != nil || localPort < 0 || localPort > 65535 {
+		return 0, 0, fmt.Errorf("invalid local port %q", local)
+	}
+	return localPort, remotePort, nil
+}
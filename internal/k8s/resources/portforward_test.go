@@ -0,0 +1,245 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type standInDialer struct {
+	addr string
+}
+
+func (d *standInDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	return &standInConnection{addr: d.addr, closed: make(chan bool)}, "portforward.k8s.io", nil
+}
+
+type standInConnection struct {
+	addr      string
+	closed    chan bool
+	closeOnce sync.Once
+}
+
+func (c *standInConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	if headers.Get(corev1.StreamType) == corev1.StreamTypeError {
+		return &standInStream{headers: headers, Reader: strings.NewReader("")}, nil
+	}
+	conn, err := net.Dial("tcp", c.addr)
+	if err != nil {
+		return nil, err
+	}
+	return &standInStream{headers: headers, Reader: conn, conn: conn.(*net.TCPConn)}, nil
+}
+
+func (c *standInConnection) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *standInConnection) CloseChan() <-chan bool                     { return c.closed }
+func (c *standInConnection) RemoveStreams(streams ...httpstream.Stream) {}
+func (c *standInConnection) SetIdleTimeout(timeout time.Duration)       {}
+
+type standInStream struct {
+	io.Reader
+	headers http.Header
+	conn    *net.TCPConn
+}
+
+func (s *standInStream) Write(p []byte) (int, error) {
+	if s.conn == nil {
+		return len(p), nil
+	}
+	return s.conn.Write(p)
+}
+
+func (s *standInStream) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.CloseWrite()
+}
+
+func (s *standInStream) Reset() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+func (s *standInStream) Headers() http.Header { return s.headers }
+func (s *standInStream) Identifier() uint32   { return 0 }
+
+func newStandInManager(t *testing.T) (*PortForwardManager, *[]string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "hello from "+r.URL.Path)
+	}))
+	t.Cleanup(server.Close)
+
+	var dialed []string
+	manager := NewPortForwardManager()
+	manager.dialer = func(client Client, namespace, pod string) (httpstream.Dialer, error) {
+		dialed = append(dialed, namespace+"/"+pod)
+		return &standInDialer{addr: server.Listener.Addr().String()}, nil
+	}
+	t.Cleanup(manager.StopAll)
+	return manager, &dialed
+}
+
+func TestPortForwardManager_StartPod(t *testing.T) {
+	manager, dialed := newStandInManager(t)
+
+	forward, err := manager.StartPod(Client{}, "default", "web-0", 0, 8080)
+	if err != nil {
+		t.Fatalf("StartPod failed: %v", err)
+	}
+	if forward.LocalPort == 0 {
+		t.Fatal("Expected a dynamically assigned local port")
+	}
+	if status, _ := forward.Status(); status != PortForwardActive {
+		t.Errorf("Expected active status, got %s", status)
+	}
+	if len(*dialed) != 1 || (*dialed)[0] != "default/web-0" {
+		t.Errorf("Expected the pod to be dialed, got %v", *dialed)
+	}
+
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/ping", forward.LocalPort))
+	if err != nil {
+		t.Fatalf("Request through port-forward failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "hello from /ping" {
+		t.Errorf("Unexpected response %q", body)
+	}
+
+	if forwards := manager.List(); len(forwards) != 1 || forwards[0].ID != forward.ID {
+		t.Fatalf("Expected the forward to be listed, got %v", forwards)
+	}
+	if err := manager.Stop(forward.ID); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	if status, _ := forward.Status(); status != PortForwardStopped {
+		t.Errorf("Expected stopped status, got %s", status)
+	}
+	if len(manager.List()) != 0 {
+		t.Error("Expected no forwards after stop")
+	}
+	if _, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", forward.LocalPort)); err == nil {
+		t.Error("Expected the local listener to be closed")
+	}
+	if err := manager.Stop(forward.ID); err == nil {
+		t.Error("Expected an error stopping an unknown forward")
+	}
+}
+
+func TestPortForwardManager_StartService(t *testing.T) {
+	manager, dialed := newStandInManager(t)
+	client := Client{Clientset: fake.NewSimpleClientset(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "web"},
+				Ports: []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromString("http")},
+				},
+			},
+		},
+		servicePod("web-pending", corev1.PodPending, false),
+		servicePod("web-1", corev1.PodRunning, true),
+	)}
+
+	forward, err := manager.StartService(context.Background(), client, "default", "web", 0, 80)
+	if err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	if forward.Target() != "service/web" || forward.Pod != "web-1" || forward.RemotePort != 8080 {
+		t.Errorf("Unexpected forward %s pod=%s remote=%d", forward.Target(), forward.Pod, forward.RemotePort)
+	}
+	if len(*dialed) != 1 || (*dialed)[0] != "default/web-1" {
+		t.Errorf("Expected the running pod to be dialed, got %v", *dialed)
+	}
+
+	manager.StopAll()
+	if len(manager.List()) != 0 {
+		t.Error("Expected StopAll to stop every forward")
+	}
+}
+
+func TestResolveServicePort_Errors(t *testing.T) {
+	client := Client{Clientset: fake.NewSimpleClientset(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "web"},
+				Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(8080)}},
+			},
+		},
+	)}
+
+	if _, _, err := ResolveServicePort(context.Background(), client, "default", "web", 443); err == nil {
+		t.Error("Expected an error for a port the service does not expose")
+	}
+	if _, _, err := ResolveServicePort(context.Background(), client, "default", "web", 80); err == nil {
+		t.Error("Expected an error when no pods are running")
+	}
+}
+
+func TestParsePortPair(t *testing.T) {
+	tests := []struct {
+		value         string
+		local, remote int
+		wantErr       bool
+	}{
+		{"8080:80", 8080, 80, false},
+		{"80", 80, 80, false},
+		{":80", 0, 80, false},
+		{"8080:", 0, 0, true},
+		{"abc", 0, 0, true},
+		{"1:70000", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		local, remote, err := ParsePortPair(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePortPair(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if local != tt.local || remote != tt.remote {
+			t.Errorf("ParsePortPair(%q) = %d:%d, expected %d:%d", tt.value, local, remote, tt.local, tt.remote)
+		}
+	}
+}
+
+func servicePod(name string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "web"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "web",
+				Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase:      phase,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
@@ -28,9 +28,9 @@ func NewReplicaSet(name, namespace string, k Client) *ReplicaSetInfo {
 	}
 }
 
-func (r *ReplicaSetInfo) Fetch() error {
+func (r *ReplicaSetInfo) Fetch(ctx context.Context) error {
 	replicaSet, err := r.Client.Clientset.AppsV1().ReplicaSets(r.Namespace).Get(
-		context.Background(),
+		ctx,
 		r.Name,
 		metav1.GetOptions{},
 	)
@@ -41,8 +41,8 @@ func (r *ReplicaSetInfo) Fetch() error {
 	return nil
 }
 
-func FetchReplicaSetList(client Client, namespace string) ([]string, error) {
-	rs, err := client.Clientset.AppsV1().ReplicaSets(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchReplicaSetList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	rs, err := client.Clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch replicasets: %v", err)
 	}
@@ -55,28 +55,16 @@ func FetchReplicaSetList(client Client, namespace string) ([]string, error) {
 	return replicaSetNames, nil
 }
 
-func GetReplicaSetsTableData(client Client, namespace string) ([]ReplicaSetInfo, error) {
-	replicaSets, err := client.Clientset.AppsV1().ReplicaSets(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetReplicaSetsTableData(ctx context.Context, client Client, namespace string) ([]ReplicaSetInfo, error) {
+	replicaSets, err := listReplicaSets(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list replicasets: %v", err)
 	}
 
 	var replicaSetInfos []ReplicaSetInfo
-	for _, replicaSet := range replicaSets.Items {
-		freshReplicaSet, err := client.Clientset.AppsV1().ReplicaSets(namespace).Get(
-			context.Background(),
-			replicaSet.Name,
-			metav1.GetOptions{},
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get fresh status for replicaset %s: %v", replicaSet.Name, err)
-		}
-
-		status := freshReplicaSet.Status
-		spec := freshReplicaSet.Spec
+	for _, replicaSet := range replicaSets {
+		status := replicaSet.Status
+		spec := replicaSet.Spec
 
 		var desiredReplicas int32
 		if spec.Replicas != nil {
@@ -86,13 +74,13 @@ func GetReplicaSetsTableData(client Client, namespace string) ([]ReplicaSetInfo,
 		readyStr := fmt.Sprintf("%d/%d", status.ReadyReplicas, desiredReplicas)
 
 		replicaSetInfos = append(replicaSetInfos, ReplicaSetInfo{
-			Namespace: freshReplicaSet.Namespace,
-			Name:      freshReplicaSet.Name,
+			Namespace: replicaSet.Namespace,
+			Name:      replicaSet.Name,
 			Desired:   fmt.Sprintf("%d", desiredReplicas),
 			Current:   fmt.Sprintf("%d", status.Replicas),
 			Ready:     readyStr,
-			Age:       format.FormatAge(freshReplicaSet.CreationTimestamp.Time),
-			Raw:       freshReplicaSet.DeepCopy(),
+			Age:       format.FormatAge(replicaSet.CreationTimestamp.Time),
+			Raw:       replicaSet.DeepCopy(),
 			Client:    client,
 		})
 	}
@@ -100,12 +88,12 @@ func GetReplicaSetsTableData(client Client, namespace string) ([]ReplicaSetInfo,
 	return replicaSetInfos, nil
 }
 
-func (r *ReplicaSetInfo) GetPods() ([]PodInfo, error) {
+func (r *ReplicaSetInfo) GetPods(ctx context.Context) ([]PodInfo, error) {
 	selector, err := r.GetLabelSelector()
 	if err != nil {
 		return nil, err
 	}
-	pods, err := FetchPods(r.Client, r.Namespace, selector)
+	pods, err := FetchPods(ctx, r.Client, r.Namespace, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +118,8 @@ func (r *ReplicaSetInfo) GetLabelSelector() (string, error) {
 	return requirements.String(), nil
 }
 
-func DeleteReplicaSet(client Client, namespace string, replicaSetName string) error {
-	err := client.Clientset.AppsV1().ReplicaSets(namespace).Delete(context.Background(), replicaSetName, metav1.DeleteOptions{})
+func DeleteReplicaSet(ctx context.Context, client Client, namespace string, replicaSetName string) error {
+	err := client.Clientset.AppsV1().ReplicaSets(namespace).Delete(ctx, replicaSetName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete replicaset %s: %v", replicaSetName, err)
 	}
@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"context"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -156,7 +157,7 @@ func TestReplicaSetInfo_Fetch(t *testing.T) {
 	}
 
 	
-	err := replicaSetInfo.Fetch()
+	err := replicaSetInfo.Fetch(context.Background())
 	if err != nil {
 		t.Errorf("Fetch failed: %v", err)
 	}
@@ -236,7 +237,7 @@ func TestReplicaSetInfo_GetPods(t *testing.T) {
 	}
 
 	
-	pods, err := replicaSetInfo.GetPods()
+	pods, err := replicaSetInfo.GetPods(context.Background())
 	if err != nil {
 		t.Errorf("GetPods failed: %v", err)
 	}
@@ -278,7 +279,7 @@ func TestReplicaSetInfo_GetPods_NoSelector(t *testing.T) {
 	}
 
 	
-	_, err := replicaSetInfo.GetPods()
+	_, err := replicaSetInfo.GetPods(context.Background())
 	if err == nil {
 		t.Error("Expected error when replicaset has no selector")
 	}
@@ -300,7 +301,7 @@ func TestReplicaSetInfo_GetPods_NoRawData(t *testing.T) {
 	}
 
 	
-	_, err := replicaSetInfo.GetPods()
+	_, err := replicaSetInfo.GetPods(context.Background())
 	if err == nil {
 		t.Error("Expected error when replicaset has no raw data")
 	}
@@ -1,50 +1,51 @@
 package k8s
 
 import (
+	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
 )
 
-func DeleteResource(client Client, resourceType ResourceType, namespace, name string) error {
+func DeleteResource(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) error {
 	if IsCustomResourceType(resourceType) {
-		return DeleteCustomResource(client, resourceType, namespace, name)
+		return DeleteCustomResource(ctx, client, resourceType, namespace, name)
 	}
 
 	switch resourceType {
 	case ResourceTypePod:
-		return DeletePod(client, namespace, name)
+		return DeletePod(ctx, client, namespace, name)
 	case ResourceTypeDeployment:
-		return DeleteDeployment(client, namespace, name)
+		return DeleteDeployment(ctx, client, namespace, name)
 	case ResourceTypeReplicaSet:
-		return DeleteReplicaSet(client, namespace, name)
+		return DeleteReplicaSet(ctx, client, namespace, name)
 	case ResourceTypeConfigMap:
-		return DeleteConfigmap(client, namespace, name)
+		return DeleteConfigmap(ctx, client, namespace, name)
 	case ResourceTypeIngress:
-		return DeleteIngress(client, namespace, name)
+		return DeleteIngress(ctx, client, namespace, name)
 	case ResourceTypeService:
-		return DeleteService(client, namespace, name)
+		return DeleteService(ctx, client, namespace, name)
 	case ResourceTypeServiceAccount:
-		return DeleteServiceAccount(client, namespace, name)
+		return DeleteServiceAccount(ctx, client, namespace, name)
 	case ResourceTypeSecret:
-		return DeleteSecret(client, namespace, name)
+		return DeleteSecret(ctx, client, namespace, name)
 	case ResourceTypeNode:
-		return DeleteNode(client, name)
+		return DeleteNode(ctx, client, name)
 	case ResourceTypeJob:
-		return DeleteJob(client, namespace, name)
+		return DeleteJob(ctx, client, namespace, name)
 	case ResourceTypeCronJob:
-		return DeleteCronJob(client, namespace, name)
+		return DeleteCronJob(ctx, client, namespace, name)
 	case ResourceTypeDaemonSet:
-		return DeleteDaemonSet(client, namespace, name)
+		return DeleteDaemonSet(ctx, client, namespace, name)
 	case ResourceTypeStatefulSet:
-		return DeleteStatefulSet(client, namespace, name)
+		return DeleteStatefulSet(ctx, client, namespace, name)
 	default:
 		return fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
 }
 
-func ListResources(client Client, resourceType ResourceType, namespace string) ([]string, error) {
+func ListResources(ctx context.Context, client Client, resourceType ResourceType, namespace string) ([]string, error) {
 	if IsCustomResourceType(resourceType) {
-		data, err := GetCustomResourceData(client, resourceType, namespace)
+		data, err := GetCustomResourceData(ctx, client, resourceType, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -57,7 +58,7 @@ func ListResources(client Client, resourceType ResourceType, namespace string) (
 
 	switch resourceType {
 	case ResourceTypePod:
-		pods, err := FetchPods(client, namespace, "")
+		pods, err := FetchPods(ctx, client, namespace, "")
 		if err != nil {
 			return nil, err
 		}
@@ -67,11 +68,11 @@ func ListResources(client Client, resourceType ResourceType, namespace string) (
 		}
 		return names, nil
 	case ResourceTypeDeployment:
-		return FetchDeploymentList(client, namespace)
+		return FetchDeploymentList(ctx, client, namespace)
 	case ResourceTypeReplicaSet:
-		return FetchReplicaSetList(client, namespace)
+		return FetchReplicaSetList(ctx, client, namespace)
 	case ResourceTypeConfigMap:
-		cms, err := FetchConfigmaps(client, namespace, "")
+		cms, err := FetchConfigmaps(ctx, client, namespace, "")
 		if err != nil {
 			return nil, err
 		}
@@ -81,36 +82,36 @@ func ListResources(client Client, resourceType ResourceType, namespace string) (
 		}
 		return names, nil
 	case ResourceTypeIngress:
-		return FetchIngressList(client, namespace)
+		return FetchIngressList(ctx, client, namespace)
 	case ResourceTypeService:
-		return FetchServiceList(client, namespace)
+		return FetchServiceList(ctx, client, namespace)
 	case ResourceTypeServiceAccount:
-		return FetchServiceAccountList(client, namespace)
+		return FetchServiceAccountList(ctx, client, namespace)
 	case ResourceTypeSecret:
-		return FetchSecretList(client, namespace)
+		return FetchSecretList(ctx, client, namespace)
 	case ResourceTypeNode:
-		return FetchNodeList(client)
+		return FetchNodeList(ctx, client)
 	case ResourceTypeJob:
-		return FetchJobList(client, namespace)
+		return FetchJobList(ctx, client, namespace)
 	case ResourceTypeCronJob:
-		return FetchCronJobList(client, namespace)
+		return FetchCronJobList(ctx, client, namespace)
 	case ResourceTypeDaemonSet:
-		return FetchDaemonSetList(client, namespace)
+		return FetchDaemonSetList(ctx, client, namespace)
 	case ResourceTypeStatefulSet:
-		return FetchStatefulSetList(client, namespace)
+		return FetchStatefulSetList(ctx, client, namespace)
 	default:
 		return nil, fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
 }
 
-func GetResourceInfo(client Client, resourceType ResourceType, namespace, name string) (*ResourceInfo, error) {
+func GetResourceInfo(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (*ResourceInfo, error) {
 	if IsCustomResourceType(resourceType) {
-		return GetCustomResourceInfo(client, resourceType, namespace, name)
+		return GetCustomResourceInfo(ctx, client, resourceType, namespace, name)
 	}
 
 	switch resourceType {
 	case ResourceTypePod:
-		pods, err := FetchPods(client, namespace, "")
+		pods, err := FetchPods(ctx, client, namespace, "")
 		if err != nil {
 			return nil, err
 		}
@@ -125,7 +126,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeDeployment:
-		deployments, err := GetDeploymentsTableData(client, namespace)
+		deployments, err := GetDeploymentsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -140,7 +141,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeReplicaSet:
-		replicasets, err := GetReplicaSetsTableData(client, namespace)
+		replicasets, err := GetReplicaSetsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -155,7 +156,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeConfigMap:
-		cms, err := FetchConfigmaps(client, namespace, "")
+		cms, err := FetchConfigmaps(ctx, client, namespace, "")
 		if err != nil {
 			return nil, err
 		}
@@ -170,7 +171,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeIngress:
-		ingresses, err := GetIngressesTableData(client, namespace)
+		ingresses, err := GetIngressesTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -185,7 +186,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeService:
-		services, err := GetServicesTableData(client, namespace)
+		services, err := GetServicesTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -200,7 +201,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeServiceAccount:
-		serviceaccounts, err := GetServiceAccountsTableData(client, namespace)
+		serviceaccounts, err := GetServiceAccountsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -215,7 +216,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeSecret:
-		secrets, err := GetSecretsTableData(client, namespace)
+		secrets, err := GetSecretsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -230,7 +231,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeNode:
-		nodes, err := GetNodesTableData(client)
+		nodes, err := GetNodesTableData(ctx, client)
 		if err != nil {
 			return nil, err
 		}
@@ -245,7 +246,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeJob:
-		jobs, err := GetJobsTableData(client, namespace)
+		jobs, err := GetJobsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -260,7 +261,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeCronJob:
-		cronjobs, err := GetCronJobsTableData(client, namespace)
+		cronjobs, err := GetCronJobsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -275,7 +276,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeDaemonSet:
-		daemonsets, err := GetDaemonSetsTableData(client, namespace)
+		daemonsets, err := GetDaemonSetsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -290,7 +291,7 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 			}
 		}
 	case ResourceTypeStatefulSet:
-		statefulsets, err := GetStatefulSetsTableData(client, namespace)
+		statefulsets, err := GetStatefulSetsTableData(ctx, client, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -309,21 +310,24 @@ func GetResourceInfo(client Client, resourceType ResourceType, namespace, name s
 }
 
 var (
-	GetCustomResourceDataFunc func(client Client, resourceType string, namespace string) ([]types.ResourceData, error)
-	DeleteCustomResourceFunc  func(client Client, resourceType string, namespace string, name string) error
-	GetCustomResourceInfoFunc func(client Client, resourceType string, namespace string, name string) (*ResourceInfo, error)
+	GetCustomResourceDataFunc func(ctx context.Context, client Client, resourceType string, namespace string) ([]types.ResourceData, error)
+	DeleteCustomResourceFunc  func(ctx context.Context, client Client, resourceType string, namespace string, name string) error
+	GetCustomResourceInfoFunc func(ctx context.Context, client Client, resourceType string, namespace string, name string) (*ResourceInfo, error)
+	PatchCustomResourceFunc   func(ctx context.Context, client Client, resourceType string, namespace string, name string, patch []byte) error
 	IsCustomResourceTypeFunc  func(resourceType string) bool
 )
 
 func SetCustomResourceHandlers(
-	getDataFunc func(Client, string, string) ([]types.ResourceData, error),
-	deleteFunc func(Client, string, string, string) error,
-	getInfoFunc func(Client, string, string, string) (*ResourceInfo, error),
+	getDataFunc func(context.Context, Client, string, string) ([]types.ResourceData, error),
+	deleteFunc func(context.Context, Client, string, string, string) error,
+	getInfoFunc func(context.Context, Client, string, string, string) (*ResourceInfo, error),
+	patchFunc func(context.Context, Client, string, string, string, []byte) error,
 	isCustomFunc func(string) bool,
 ) {
 	GetCustomResourceDataFunc = getDataFunc
 	DeleteCustomResourceFunc = deleteFunc
 	GetCustomResourceInfoFunc = getInfoFunc
+	PatchCustomResourceFunc = patchFunc
 	IsCustomResourceTypeFunc = isCustomFunc
 }
 
@@ -334,28 +338,35 @@ func IsCustomResourceType(resourceType ResourceType) bool {
 	return false
 }
 
-func GetCustomResourceData(client Client, resourceType ResourceType, namespace string) ([]types.ResourceData, error) {
+func GetCustomResourceData(ctx context.Context, client Client, resourceType ResourceType, namespace string) ([]types.ResourceData, error) {
 	if GetCustomResourceDataFunc != nil {
-		return GetCustomResourceDataFunc(client, string(resourceType), namespace)
+		return GetCustomResourceDataFunc(ctx, client, string(resourceType), namespace)
 	}
 	return nil, fmt.Errorf("custom resource handler not set")
 }
 
-func DeleteCustomResource(client Client, resourceType ResourceType, namespace string, name string) error {
+func DeleteCustomResource(ctx context.Context, client Client, resourceType ResourceType, namespace string, name string) error {
 	if DeleteCustomResourceFunc != nil {
-		return DeleteCustomResourceFunc(client, string(resourceType), namespace, name)
+		return DeleteCustomResourceFunc(ctx, client, string(resourceType), namespace, name)
 	}
 	return fmt.Errorf("custom resource handler not set")
 }
 
-func GetCustomResourceInfo(client Client, resourceType ResourceType, namespace string, name string) (*ResourceInfo, error) {
+func GetCustomResourceInfo(ctx context.Context, client Client, resourceType ResourceType, namespace string, name string) (*ResourceInfo, error) {
 	if GetCustomResourceInfoFunc != nil {
-		return GetCustomResourceInfoFunc(client, string(resourceType), namespace, name)
+		return GetCustomResourceInfoFunc(ctx, client, string(resourceType), namespace, name)
 	}
 	return nil, fmt.Errorf("custom resource handler not set")
 }
 
-func DescribeResource(client Client, resourceType ResourceType, namespace, name string) (string, error) {
+func PatchCustomResource(ctx context.Context, client Client, resourceType ResourceType, namespace string, name string, patch []byte) error {
+	if PatchCustomResourceFunc != nil {
+		return PatchCustomResourceFunc(ctx, client, string(resourceType), namespace, name, patch)
+	}
+	return fmt.Errorf("custom resource handler not set")
+}
+
+func DescribeResource(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (string, error) {
 	if IsCustomResourceType(resourceType) {
 		return "", fmt.Errorf("custom resource description not implemented")
 	}
@@ -363,43 +374,43 @@ func DescribeResource(client Client, resourceType ResourceType, namespace, name
 	switch resourceType {
 	case ResourceTypePod:
 		pod := NewPod(name, namespace, client)
-		return pod.Describe()
+		return pod.Describe(ctx)
 	case ResourceTypeService:
 		service := NewService(name, namespace, client)
-		return service.Describe()
+		return service.Describe(ctx)
 	case ResourceTypeConfigMap:
 		configmap := NewConfigmap(name, namespace, client)
-		return configmap.Describe()
+		return configmap.Describe(ctx)
 	case ResourceTypeSecret:
 		secret := NewSecret(name, namespace, client)
-		return secret.Describe()
+		return secret.Describe(ctx)
 	case ResourceTypeIngress:
 		ingress := NewIngress(name, namespace, client)
-		return ingress.Describe()
+		return ingress.Describe(ctx)
 	case ResourceTypeJob:
 		job := NewJob(name, namespace, client)
-		return job.Describe()
+		return job.Describe(ctx)
 	case ResourceTypeCronJob:
 		cronjob := NewCronJob(name, namespace, client)
-		return cronjob.Describe()
+		return cronjob.Describe(ctx)
 	case ResourceTypeDaemonSet:
 		daemonset := NewDaemonSet(name, namespace, client)
-		return daemonset.Describe()
+		return daemonset.Describe(ctx)
 	case ResourceTypeStatefulSet:
 		statefulset := NewStatefulSet(name, namespace, client)
-		return statefulset.Describe()
+		return statefulset.Describe(ctx)
 	case ResourceTypeNode:
 		node := NewNode(name, client)
-		return node.Describe()
+		return node.Describe(ctx)
 	case ResourceTypeServiceAccount:
 		serviceaccount := NewServiceAccount(name, namespace, client)
-		return serviceaccount.Describe()
+		return serviceaccount.Describe(ctx)
 	default:
 		return "", fmt.Errorf("unsupported resource type for description: %s", resourceType)
 	}
 }
 
-func GetResourceLogs(client Client, resourceType ResourceType, namespace, name string) (string, error) {
+func GetResourceLogs(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (string, error) {
 	if IsCustomResourceType(resourceType) {
 		return "", fmt.Errorf("custom resource logs not implemented")
 	}
@@ -407,13 +418,13 @@ func GetResourceLogs(client Client, resourceType ResourceType, namespace, name s
 	switch resourceType {
 	case ResourceTypePod:
 		pod := NewPod(name, namespace, client)
-		return pod.GetLogs()
+		return pod.GetLogs(ctx)
 	default:
 		return "", fmt.Errorf("logs not supported for resource type: %s", resourceType)
 	}
 }
 
-func ExecResource(client Client, resourceType ResourceType, namespace, name string, command []string) (string, string, error) {
+func ExecResource(ctx context.Context, client Client, resourceType ResourceType, namespace, name string, command []string) (string, string, error) {
 	if IsCustomResourceType(resourceType) {
 		return "", "", fmt.Errorf("custom resource exec not implemented")
 	}
@@ -421,8 +432,45 @@ func ExecResource(client Client, resourceType ResourceType, namespace, name stri
 	switch resourceType {
 	case ResourceTypePod:
 		pod := NewPod(name, namespace, client)
-		return pod.Exec(command)
+		return pod.Exec(ctx, command)
 	default:
 		return "", "", fmt.Errorf("exec not supported for resource type: %s", resourceType)
 	}
 }
+
+func GetResourceLabelSelector(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (string, error) {
+	switch resourceType {
+	case ResourceTypeDeployment:
+		deployment := NewDeployment(name, namespace, client)
+		if err := deployment.Fetch(ctx); err != nil {
+			return "", err
+		}
+		return deployment.GetLabelSelector()
+	case ResourceTypeReplicaSet:
+		replicaset := NewReplicaSet(name, namespace, client)
+		if err := replicaset.Fetch(ctx); err != nil {
+			return "", err
+		}
+		return replicaset.GetLabelSelector()
+	case ResourceTypeStatefulSet:
+		statefulset := NewStatefulSet(name, namespace, client)
+		if err := statefulset.Fetch(ctx); err != nil {
+			return "", err
+		}
+		return statefulset.GetLabelSelector()
+	case ResourceTypeDaemonSet:
+		daemonset := NewDaemonSet(name, namespace, client)
+		if err := daemonset.Fetch(ctx); err != nil {
+			return "", err
+		}
+		return daemonset.GetLabelSelector()
+	case ResourceTypeJob:
+		job := NewJob(name, namespace, client)
+		if err := job.Fetch(ctx); err != nil {
+			return "", err
+		}
+		return job.GetLabelSelector()
+	default:
+		return "", fmt.Errorf("label selector not supported for resource type: %s", resourceType)
+	}
+}
@@ -0,0 +1,204 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+type RolloutStatus struct {
+	Generation         int64
+	ObservedGeneration int64
+	Desired            int32
+	Updated            int32
+	Ready              int32
+	Available          int32
+	Paused             bool
+	Conditions         []RolloutCondition
+	Message            string
+	Done               bool
+	Failed             bool
+}
+
+type RolloutCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+func RestartResource(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) error {
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`, RestartedAtAnnotation, time.Now().Format(time.RFC3339))
+
+	var err error
+	apps := client.Clientset.AppsV1()
+	switch resourceType {
+	case ResourceTypeDeployment:
+		_, err = apps.Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	case ResourceTypeStatefulSet:
+		_, err = apps.StatefulSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	case ResourceTypeDaemonSet:
+		_, err = apps.DaemonSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	default:
+		return fmt.Errorf("restart not supported for resource type: %s", resourceType)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restart %s %s: %v", resourceType, name, err)
+	}
+	return nil
+}
+
+func SetDeploymentPaused(ctx context.Context, client Client, namespace, name string, paused bool) error {
+	patch := fmt.Sprintf(`{"spec":{"paused":%t}}`, paused)
+	_, err := client.Clientset.AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		action := "resume"
+		if paused {
+			action = "pause"
+		}
+		return fmt.Errorf("failed to %s deployment %s: %v", action, name, err)
+	}
+	return nil
+}
+
+func GetRolloutStatus(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (*RolloutStatus, error) {
+	apps := client.Clientset.AppsV1()
+	switch resourceType {
+	case ResourceTypeDeployment:
+		deployment, err := apps.Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployment %s: %v", name, err)
+		}
+		return DeploymentRolloutStatus(deployment), nil
+	case ResourceTypeStatefulSet:
+		statefulset, err := apps.StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get statefulset %s: %v", name, err)
+		}
+		return StatefulSetRolloutStatus(statefulset), nil
+	case ResourceTypeDaemonSet:
+		daemonset, err := apps.DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daemonset %s: %v", name, err)
+		}
+		return DaemonSetRolloutStatus(daemonset), nil
+	default:
+		return nil, fmt.Errorf("rollout status not supported for resource type: %s", resourceType)
+	}
+}
+
+func DeploymentRolloutStatus(deployment *appsv1.Deployment) *RolloutStatus {
+	status := &RolloutStatus{
+		Generation:         deployment.Generation,
+		ObservedGeneration: deployment.Status.ObservedGeneration,
+		Desired:            1,
+		Updated:            deployment.Status.UpdatedReplicas,
+		Ready:              deployment.Status.ReadyReplicas,
+		Available:          deployment.Status.AvailableReplicas,
+		Paused:             deployment.Spec.Paused,
+	}
+	if deployment.Spec.Replicas != nil {
+		status.Desired = *deployment.Spec.Replicas
+	}
+	for _, c := range deployment.Status.Conditions {
+		status.Conditions = append(status.Conditions, RolloutCondition{string(c.Type), string(c.Status), c.Reason, c.Message})
+		if c.Type == appsv1.DeploymentProgressing && c.Reason == "ProgressDeadlineExceeded" {
+			status.Failed = true
+		}
+	}
+
+	switch {
+	case status.ObservedGeneration < status.Generation:
+		status.Message = "Waiting for deployment spec update to be observed..."
+	case status.Failed:
+		status.Message = fmt.Sprintf("deployment %q exceeded its progress deadline", deployment.Name)
+	case status.Paused:
+		status.Message = "Deployment is paused; resume it to continue the rollout"
+	case status.Updated < status.Desired:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", status.Updated, status.Desired)
+	case deployment.Status.Replicas > status.Updated:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination...", deployment.Status.Replicas-status.Updated)
+	case status.Available < status.Updated:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available...", status.Available, status.Updated)
+	default:
+		status.Message = fmt.Sprintf("deployment %q successfully rolled out", deployment.Name)
+		status.Done = true
+	}
+	return status
+}
+
+func StatefulSetRolloutStatus(statefulset *appsv1.StatefulSet) *RolloutStatus {
+	status := &RolloutStatus{
+		Generation:         statefulset.Generation,
+		ObservedGeneration: statefulset.Status.ObservedGeneration,
+		Desired:            1,
+		Updated:            statefulset.Status.UpdatedReplicas,
+		Ready:              statefulset.Status.ReadyReplicas,
+		Available:          statefulset.Status.AvailableReplicas,
+	}
+	if statefulset.Spec.Replicas != nil {
+		status.Desired = *statefulset.Spec.Replicas
+	}
+	for _, c := range statefulset.Status.Conditions {
+		status.Conditions = append(status.Conditions, RolloutCondition{string(c.Type), string(c.Status), c.Reason, c.Message})
+	}
+
+	var partition int32
+	if rolling := statefulset.Spec.UpdateStrategy.RollingUpdate; rolling != nil && rolling.Partition != nil {
+		partition = *rolling.Partition
+	}
+
+	switch {
+	case statefulset.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType:
+		status.Message = "OnDelete update strategy: pods are only updated when deleted"
+		status.Done = true
+	case status.ObservedGeneration < status.Generation:
+		status.Message = "Waiting for statefulset spec update to be observed..."
+	case status.Ready < status.Desired:
+		status.Message = fmt.Sprintf("Waiting for %d pods to be ready...", status.Desired-status.Ready)
+	case partition > 0 && status.Updated < status.Desired-partition:
+		status.Message = fmt.Sprintf("Waiting for partitioned rollout to finish: %d out of %d new pods have been updated...", status.Updated, status.Desired-partition)
+	case partition == 0 && statefulset.Status.UpdateRevision != statefulset.Status.CurrentRevision:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d out of %d new pods have been updated...", status.Updated, status.Desired)
+	default:
+		status.Message = fmt.Sprintf("statefulset %q successfully rolled out", statefulset.Name)
+		status.Done = true
+	}
+	return status
+}
+
+func DaemonSetRolloutStatus(daemonset *appsv1.DaemonSet) *RolloutStatus {
+	status := &RolloutStatus{
+		Generation:         daemonset.Generation,
+		ObservedGeneration: daemonset.Status.ObservedGeneration,
+		Desired:            daemonset.Status.DesiredNumberScheduled,
+		Updated:            daemonset.Status.UpdatedNumberScheduled,
+		Ready:              daemonset.Status.NumberReady,
+		Available:          daemonset.Status.NumberAvailable,
+	}
+	for _, c := range daemonset.Status.Conditions {
+		status.Conditions = append(status.Conditions, RolloutCondition{string(c.Type), string(c.Status), c.Reason, c.Message})
+	}
+
+	switch {
+	case daemonset.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType:
+		status.Message = "OnDelete update strategy: pods are only updated when deleted"
+		status.Done = true
+	case status.ObservedGeneration < status.Generation:
+		status.Message = "Waiting for daemon set spec update to be observed..."
+	case status.Updated < status.Desired:
+		status.Message = fmt.Sprintf("Waiting for daemon set rollout to finish: %d out of %d new pods have been updated...", status.Updated, status.Desired)
+	case status.Available < status.Desired:
+		status.Message = fmt.Sprintf("Waiting for daemon set rollout to finish: %d of %d updated pods are available...", status.Available, status.Desired)
+	default:
+		status.Message = fmt.Sprintf("daemon set %q successfully rolled out", daemonset.Name)
+		status.Done = true
+	}
+	return status
+}
@@ -0,0 +1,155 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/otavioCosta2110/k8s-tui/pkg/diff"
+
+	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	RevisionAnnotation    = "deployment.kubernetes.io/revision"
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+type DeploymentRevision struct {
+	Revision    int64
+	ReplicaSet  *ReplicaSetInfo
+	ChangeCause string
+	Images      []string
+	Created     time.Time
+	Current     bool
+}
+
+func (d *DeploymentInfo) GetRevisions(ctx context.Context) ([]DeploymentRevision, error) {
+	if d.Raw == nil {
+		if err := d.Fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	selector, err := d.GetLabelSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSets, err := listReplicaSets(ctx, d.Client, d.Namespace, selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replicasets for deployment %s: %v", d.Name, err)
+	}
+
+	current := d.Raw.Annotations[RevisionAnnotation]
+	var revisions []DeploymentRevision
+	for i := range replicaSets {
+		rs := &replicaSets[i]
+		if !metav1.IsControlledBy(rs, d.Raw) {
+			continue
+		}
+		revision, err := strconv.ParseInt(rs.Annotations[RevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		info := NewReplicaSet(rs.Name, rs.Namespace, d.Client)
+		info.Raw = rs
+		var images []string
+		for _, c := range rs.Spec.Template.Spec.Containers {
+			images = append(images, c.Image)
+		}
+		revisions = append(revisions, DeploymentRevision{
+			Revision:    revision,
+			ReplicaSet:  info,
+			ChangeCause: rs.Annotations[ChangeCauseAnnotation],
+			Images:      images,
+			Created:     rs.CreationTimestamp.Time,
+			Current:     rs.Annotations[RevisionAnnotation] == current,
+		})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+	return revisions, nil
+}
+
+func (r DeploymentRevision) TemplateYAML() (string, error) {
+	template := revisionTemplate(r.ReplicaSet.Raw)
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	var obj any
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return "", err
+	}
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func RevisionDiff(from, to DeploymentRevision) (string, error) {
+	fromYAML, err := from.TemplateYAML()
+	if err != nil {
+		return "", err
+	}
+	toYAML, err := to.TemplateYAML()
+	if err != nil {
+		return "", err
+	}
+	return diff.Unified(
+		fmt.Sprintf("revision %d (%s)", from.Revision, from.ReplicaSet.Name),
+		fmt.Sprintf("revision %d (%s)", to.Revision, to.ReplicaSet.Name),
+		fromYAML, toYAML, 3,
+	), nil
+}
+
+func (d *DeploymentInfo) Rollback(ctx context.Context, revision DeploymentRevision) error {
+	if d.Raw == nil {
+		if err := d.Fetch(ctx); err != nil {
+			return err
+		}
+	}
+	if d.Raw.Spec.Paused {
+		return fmt.Errorf("cannot roll back paused deployment %s; resume it first", d.Name)
+	}
+	if revision.Current {
+		return fmt.Errorf("deployment %s is already at revision %d", d.Name, revision.Revision)
+	}
+
+	patch := []map[string]any{
+		{"op": "replace", "path": "/spec/template", "value": revisionTemplate(revision.ReplicaSet.Raw)},
+	}
+	if revision.ChangeCause != "" {
+		if d.Raw.Annotations == nil {
+			patch = append(patch, map[string]any{"op": "add", "path": "/metadata/annotations", "value": map[string]string{}})
+		}
+		patch = append(patch, map[string]any{"op": "add", "path": "/metadata/annotations/kubernetes.io~1change-cause", "value": revision.ChangeCause})
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.Client.Clientset.AppsV1().Deployments(d.Namespace).Patch(ctx, d.Name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to roll back deployment %s to revision %d: %v", d.Name, revision.Revision, err)
+	}
+	return nil
+}
+
+func revisionTemplate(rs *appsv1.ReplicaSet) corev1.PodTemplateSpec {
+	template := *rs.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	return template
+}
@@ -0,0 +1,107 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func historyReplicaSet(deployment *appsv1.Deployment, name, revision, image, cause string) *appsv1.ReplicaSet {
+	controller := true
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+			Annotations: map[string]string{
+				RevisionAnnotation:    revision,
+				ChangeCauseAnnotation: cause,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+				UID:        deployment.UID,
+				Controller: &controller,
+			}},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web", appsv1.DefaultDeploymentUniqueLabelKey: name}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+			},
+		},
+	}
+}
+
+func TestDeploymentRevisionsAndRollback(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web",
+			Namespace:   "default",
+			UID:         "web-uid",
+			Annotations: map[string]string{RevisionAnnotation: "2"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "web:2"}}},
+			},
+		},
+	}
+	orphan := historyReplicaSet(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "other", UID: "other-uid"}}, "other-1", "9", "other:1", "")
+	clientset := fake.NewSimpleClientset(
+		deployment,
+		historyReplicaSet(deployment, "web-1", "1", "web:1", "initial"),
+		historyReplicaSet(deployment, "web-2", "2", "web:2", "bump image"),
+		orphan,
+	)
+	info := NewDeployment("web", "default", Client{Clientset: clientset, Namespace: "default"})
+
+	revisions, err := info.GetRevisions(context.Background())
+	if err != nil {
+		t.Fatalf("GetRevisions failed: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("Expected 2 owned revisions, got %d", len(revisions))
+	}
+	if revisions[0].Revision != 2 || !revisions[0].Current || revisions[0].ChangeCause != "bump image" {
+		t.Errorf("Expected the current revision first, got %+v", revisions[0])
+	}
+	if revisions[1].Images[0] != "web:1" {
+		t.Errorf("Expected image web:1, got %v", revisions[1].Images)
+	}
+
+	changes, err := RevisionDiff(revisions[1], revisions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(changes, "-        - image: web:1") || !strings.Contains(changes, "+        - image: web:2") {
+		t.Errorf("Expected an image change in the diff, got:\n%s", changes)
+	}
+	if strings.Contains(changes, appsv1.DefaultDeploymentUniqueLabelKey) {
+		t.Error("Expected the pod-template-hash label to be ignored")
+	}
+
+	if err := info.Rollback(context.Background(), revisions[0]); err == nil {
+		t.Error("Expected rolling back to the current revision to fail")
+	}
+	if err := info.Rollback(context.Background(), revisions[1]); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	updated, _ := clientset.AppsV1().Deployments("default").Get(context.Background(), "web", metav1.GetOptions{})
+	if image := updated.Spec.Template.Spec.Containers[0].Image; image != "web:1" {
+		t.Errorf("Expected the template to be restored to web:1, got %s", image)
+	}
+	if _, ok := updated.Spec.Template.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok {
+		t.Error("Expected the pod-template-hash label not to be restored")
+	}
+	if updated.Annotations[ChangeCauseAnnotation] != "initial" {
+		t.Errorf("Expected the change-cause to be restored, got %q", updated.Annotations[ChangeCauseAnnotation])
+	}
+}
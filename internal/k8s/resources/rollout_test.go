@@ -0,0 +1,105 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRestartAndPauseDeployment(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+	})
+	client := Client{Clientset: clientset, Namespace: "default"}
+
+	if err := RestartResource(context.Background(), client, ResourceTypeDeployment, "default", "web"); err != nil {
+		t.Fatalf("RestartResource failed: %v", err)
+	}
+	if err := SetDeploymentPaused(context.Background(), client, "default", "web", true); err != nil {
+		t.Fatalf("SetDeploymentPaused failed: %v", err)
+	}
+
+	deployment, _ := clientset.AppsV1().Deployments("default").Get(context.Background(), "web", metav1.GetOptions{})
+	if deployment.Spec.Template.Annotations[RestartedAtAnnotation] == "" {
+		t.Error("Expected the restartedAt annotation to be set on the pod template")
+	}
+	if !deployment.Spec.Paused {
+		t.Error("Expected the deployment to be paused")
+	}
+
+	if err := RestartResource(context.Background(), client, ResourceTypeReplicaSet, "default", "web"); err == nil {
+		t.Error("Expected replicasets to be unsupported")
+	}
+}
+
+func TestDeploymentRolloutStatus(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(3)},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           4,
+			UpdatedReplicas:    3,
+			AvailableReplicas:  3,
+		},
+	}
+
+	status := DeploymentRolloutStatus(deployment)
+	if status.Done || !strings.Contains(status.Message, "1 old replicas are pending termination") {
+		t.Errorf("Expected old replicas to be pending, got %+v", status)
+	}
+
+	deployment.Status.Replicas = 3
+	if status := DeploymentRolloutStatus(deployment); !status.Done {
+		t.Errorf("Expected the rollout to be done, got %q", status.Message)
+	}
+
+	deployment.Status.UpdatedReplicas = 1
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{
+		{Type: appsv1.DeploymentProgressing, Status: "False", Reason: "ProgressDeadlineExceeded"},
+	}
+	if status := DeploymentRolloutStatus(deployment); !status.Failed || status.Done {
+		t.Errorf("Expected the progress deadline to fail the rollout, got %+v", status)
+	}
+
+	deployment.Generation = 3
+	if status := DeploymentRolloutStatus(deployment); !strings.Contains(status.Message, "to be observed") {
+		t.Errorf("Expected to wait for the new generation, got %q", status.Message)
+	}
+}
+
+func TestStatefulSetAndDaemonSetRolloutStatus(t *testing.T) {
+	statefulset := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: int32Ptr(2)},
+		Status: appsv1.StatefulSetStatus{
+			ReadyReplicas:   2,
+			UpdatedReplicas: 1,
+			CurrentRevision: "db-1",
+			UpdateRevision:  "db-2",
+		},
+	}
+	if status := StatefulSetRolloutStatus(statefulset); status.Done {
+		t.Errorf("Expected a pending revision update, got %q", status.Message)
+	}
+	statefulset.Status.CurrentRevision = "db-2"
+	if status := StatefulSetRolloutStatus(statefulset); !status.Done {
+		t.Errorf("Expected the statefulset rollout to be done, got %q", status.Message)
+	}
+
+	daemonset := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent"},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 3,
+			UpdatedNumberScheduled: 3,
+			NumberAvailable:        2,
+		},
+	}
+	if status := DaemonSetRolloutStatus(daemonset); status.Done || !strings.Contains(status.Message, "2 of 3") {
+		t.Errorf("Expected unavailable pods to block the rollout, got %q", status.Message)
+	}
+}
@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func GetResourceScale(ctx context.Context, client Client, resourceType ResourceType, namespace, name string) (*autoscalingv1.Scale, error) {
+	var scale *autoscalingv1.Scale
+	var err error
+
+	apps := client.Clientset.AppsV1()
+	switch resourceType {
+	case ResourceTypeDeployment:
+		scale, err = apps.Deployments(namespace).GetScale(ctx, name, metav1.GetOptions{})
+	case ResourceTypeStatefulSet:
+		scale, err = apps.StatefulSets(namespace).GetScale(ctx, name, metav1.GetOptions{})
+	case ResourceTypeReplicaSet:
+		scale, err = apps.ReplicaSets(namespace).GetScale(ctx, name, metav1.GetOptions{})
+	default:
+		return nil, fmt.Errorf("scale not supported for resource type: %s", resourceType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scale of %s %s: %v", resourceType, name, err)
+	}
+	return scale, nil
+}
+
+func ScaleResource(ctx context.Context, client Client, resourceType ResourceType, namespace, name string, replicas int32) error {
+	if replicas < 0 {
+		return fmt.Errorf("replicas must not be negative")
+	}
+
+	scale, err := GetResourceScale(ctx, client, resourceType, namespace, name)
+	if err != nil {
+		return err
+	}
+	scale.Spec.Replicas = replicas
+
+	apps := client.Clientset.AppsV1()
+	switch resourceType {
+	case ResourceTypeDeployment:
+		_, err = apps.Deployments(namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+	case ResourceTypeStatefulSet:
+		_, err = apps.StatefulSets(namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+	case ResourceTypeReplicaSet:
+		_, err = apps.ReplicaSets(namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to scale %s %s: %v", resourceType, name, err)
+	}
+	return nil
+}
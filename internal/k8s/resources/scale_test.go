@@ -0,0 +1,50 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestScaleResource(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	replicas := map[string]int32{"web": 2}
+	clientset.PrependReactor("get", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		name := action.(k8stesting.GetAction).GetName()
+		return true, &autoscalingv1.Scale{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: replicas[name]},
+		}, nil
+	})
+	clientset.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		scale := action.(k8stesting.UpdateAction).GetObject().(*autoscalingv1.Scale)
+		replicas[scale.Name] = scale.Spec.Replicas
+		return true, scale, nil
+	})
+	client := Client{Clientset: clientset, Namespace: "default"}
+
+	if err := ScaleResource(context.Background(), client, ResourceTypeDeployment, "default", "web", 5); err != nil {
+		t.Fatalf("ScaleResource failed: %v", err)
+	}
+	if replicas["web"] != 5 {
+		t.Errorf("Expected 5 replicas through the scale subresource, got %d", replicas["web"])
+	}
+
+	if err := ScaleResource(context.Background(), client, ResourceTypeDeployment, "default", "web", -1); err == nil {
+		t.Error("Expected negative replicas to be rejected")
+	}
+	if err := ScaleResource(context.Background(), client, ResourceTypeDaemonSet, "default", "agent", 1); err == nil {
+		t.Error("Expected daemonsets to be unsupported")
+	}
+}
@@ -30,8 +30,8 @@ func NewSecret(name, namespace string, k Client) *SecretInfo {
 	}
 }
 
-func FetchSecretList(client Client, namespace string) ([]string, error) {
-	secrets, err := client.Clientset.CoreV1().Secrets(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchSecretList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	secrets, err := client.Clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch secrets: %v", err)
 	}
@@ -44,17 +44,14 @@ func FetchSecretList(client Client, namespace string) ([]string, error) {
 	return secretNames, nil
 }
 
-func GetSecretsTableData(client Client, namespace string) ([]SecretInfo, error) {
-	secrets, err := client.Clientset.CoreV1().Secrets(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetSecretsTableData(ctx context.Context, client Client, namespace string) ([]SecretInfo, error) {
+	secrets, err := listSecrets(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list secrets: %v", err)
 	}
 
 	var secretInfos []SecretInfo
-	for _, secret := range secrets.Items {
+	for _, secret := range secrets {
 		secretType := string(secret.Type)
 
 		dataCount := len(secret.Data)
@@ -74,8 +71,8 @@ func GetSecretsTableData(client Client, namespace string) ([]SecretInfo, error)
 	return secretInfos, nil
 }
 
-func (s *SecretInfo) Fetch() error {
-	secret, err := s.Client.Clientset.CoreV1().Secrets(s.Namespace).Get(context.Background(), s.Name, metav1.GetOptions{})
+func (s *SecretInfo) Fetch(ctx context.Context) error {
+	secret, err := s.Client.Clientset.CoreV1().Secrets(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get secret: %v", err)
 	}
@@ -83,13 +80,13 @@ func (s *SecretInfo) Fetch() error {
 	return nil
 }
 
-func (s *SecretInfo) Describe() (string, error) {
-	return s.DescribeWithVisibility(false)
+func (s *SecretInfo) Describe(ctx context.Context) (string, error) {
+	return s.DescribeWithVisibility(ctx, false)
 }
 
-func (s *SecretInfo) DescribeWithVisibility(showValues bool) (string, error) {
+func (s *SecretInfo) DescribeWithVisibility(ctx context.Context, showValues bool) (string, error) {
 	if s.Raw == nil {
-		if err := s.Fetch(); err != nil {
+		if err := s.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch secret: %v", err)
 		}
 	}
@@ -98,7 +95,7 @@ func (s *SecretInfo) DescribeWithVisibility(showValues bool) (string, error) {
 	var err error
 
 	if s.Client.Clientset != nil {
-		events, err = s.Client.Clientset.CoreV1().Events(s.Namespace).List(context.Background(), metav1.ListOptions{
+		events, err = s.Client.Clientset.CoreV1().Events(s.Namespace).List(ctx, metav1.ListOptions{
 			FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=Secret", s.Name, s.Namespace),
 		})
 		if err != nil {
@@ -196,8 +193,8 @@ func (s *SecretInfo) DescribeSecretWithVisibility(events *corev1.EventList, show
 	return desc, nil
 }
 
-func DeleteSecret(client Client, namespace string, secretName string) error {
-	err := client.Clientset.CoreV1().Secrets(namespace).Delete(context.Background(), secretName, metav1.DeleteOptions{})
+func DeleteSecret(ctx context.Context, client Client, namespace string, secretName string) error {
+	err := client.Clientset.CoreV1().Secrets(namespace).Delete(ctx, secretName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete secret %s: %v", secretName, err)
 	}
@@ -31,8 +31,8 @@ func NewService(name, namespace string, k Client) *ServiceInfo {
 	}
 }
 
-func FetchServiceList(client Client, namespace string) ([]string, error) {
-	services, err := client.Clientset.CoreV1().Services(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchServiceList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	services, err := client.Clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch services: %v", err)
 	}
@@ -45,17 +45,14 @@ func FetchServiceList(client Client, namespace string) ([]string, error) {
 	return serviceNames, nil
 }
 
-func GetServicesTableData(client Client, namespace string) ([]ServiceInfo, error) {
-	services, err := client.Clientset.CoreV1().Services(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetServicesTableData(ctx context.Context, client Client, namespace string) ([]ServiceInfo, error) {
+	services, err := listServices(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list services: %v", err)
 	}
 
 	var serviceInfos []ServiceInfo
-	for _, service := range services.Items {
+	for _, service := range services {
 		serviceType := string(service.Spec.Type)
 
 		clusterIP := service.Spec.ClusterIP
@@ -117,8 +114,8 @@ func GetServicesTableData(client Client, namespace string) ([]ServiceInfo, error
 	return serviceInfos, nil
 }
 
-func (s *ServiceInfo) Fetch() error {
-	service, err := s.Client.Clientset.CoreV1().Services(s.Namespace).Get(context.Background(), s.Name, metav1.GetOptions{})
+func (s *ServiceInfo) Fetch(ctx context.Context) error {
+	service, err := s.Client.Clientset.CoreV1().Services(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get service: %v", err)
 	}
@@ -126,14 +123,14 @@ func (s *ServiceInfo) Fetch() error {
 	return nil
 }
 
-func (s *ServiceInfo) Describe() (string, error) {
+func (s *ServiceInfo) Describe(ctx context.Context) (string, error) {
 	if s.Raw == nil {
-		if err := s.Fetch(); err != nil {
+		if err := s.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch service: %v", err)
 		}
 	}
 
-	events, err := s.Client.Clientset.CoreV1().Events(s.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := s.Client.Clientset.CoreV1().Events(s.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=Service", s.Name, s.Namespace),
 	})
 	if err != nil {
@@ -244,8 +241,8 @@ func (s *ServiceInfo) DescribeService(events *corev1.EventList) (map[string]any,
 	return desc, nil
 }
 
-func DeleteService(client Client, namespace string, serviceName string) error {
-	err := client.Clientset.CoreV1().Services(namespace).Delete(context.Background(), serviceName, metav1.DeleteOptions{})
+func DeleteService(ctx context.Context, client Client, namespace string, serviceName string) error {
+	err := client.Clientset.CoreV1().Services(namespace).Delete(ctx, serviceName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete service %s: %v", serviceName, err)
 	}
@@ -28,8 +28,8 @@ func NewServiceAccount(name, namespace string, k Client) *ServiceAccountInfo {
 	}
 }
 
-func FetchServiceAccountList(client Client, namespace string) ([]string, error) {
-	sas, err := client.Clientset.CoreV1().ServiceAccounts(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchServiceAccountList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	sas, err := client.Clientset.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch serviceaccounts: %v", err)
 	}
@@ -42,17 +42,14 @@ func FetchServiceAccountList(client Client, namespace string) ([]string, error)
 	return saNames, nil
 }
 
-func GetServiceAccountsTableData(client Client, namespace string) ([]ServiceAccountInfo, error) {
-	sas, err := client.Clientset.CoreV1().ServiceAccounts(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetServiceAccountsTableData(ctx context.Context, client Client, namespace string) ([]ServiceAccountInfo, error) {
+	sas, err := listServiceAccounts(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list serviceaccounts: %v", err)
 	}
 
 	var saInfos []ServiceAccountInfo
-	for _, sa := range sas.Items {
+	for _, sa := range sas {
 		secretsCount := len(sa.Secrets)
 		secretsStr := fmt.Sprintf("%d", secretsCount)
 
@@ -69,8 +66,8 @@ func GetServiceAccountsTableData(client Client, namespace string) ([]ServiceAcco
 	return saInfos, nil
 }
 
-func (s *ServiceAccountInfo) Fetch() error {
-	sa, err := s.Client.Clientset.CoreV1().ServiceAccounts(s.Namespace).Get(context.Background(), s.Name, metav1.GetOptions{})
+func (s *ServiceAccountInfo) Fetch(ctx context.Context) error {
+	sa, err := s.Client.Clientset.CoreV1().ServiceAccounts(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get serviceaccount: %v", err)
 	}
@@ -78,9 +75,9 @@ func (s *ServiceAccountInfo) Fetch() error {
 	return nil
 }
 
-func (s *ServiceAccountInfo) Describe() (string, error) {
+func (s *ServiceAccountInfo) Describe(ctx context.Context) (string, error) {
 	if s.Raw == nil {
-		if err := s.Fetch(); err != nil {
+		if err := s.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch serviceaccount: %v", err)
 		}
 	}
@@ -89,7 +86,7 @@ func (s *ServiceAccountInfo) Describe() (string, error) {
 	var err error
 
 	if s.Client.Clientset != nil {
-		events, err = s.Client.Clientset.CoreV1().Events(s.Namespace).List(context.Background(), metav1.ListOptions{
+		events, err = s.Client.Clientset.CoreV1().Events(s.Namespace).List(ctx, metav1.ListOptions{
 			FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=ServiceAccount", s.Name, s.Namespace),
 		})
 		if err != nil {
@@ -171,8 +168,8 @@ func (s *ServiceAccountInfo) DescribeServiceAccount(events *corev1.EventList) (m
 	return desc, nil
 }
 
-func DeleteServiceAccount(client Client, namespace string, saName string) error {
-	err := client.Clientset.CoreV1().ServiceAccounts(namespace).Delete(context.Background(), saName, metav1.DeleteOptions{})
+func DeleteServiceAccount(ctx context.Context, client Client, namespace string, saName string) error {
+	err := client.Clientset.CoreV1().ServiceAccounts(namespace).Delete(ctx, saName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete serviceaccount %s: %v", saName, err)
 	}
@@ -30,8 +30,8 @@ func NewStatefulSet(name, namespace string, k Client) *StatefulSetInfo {
 	}
 }
 
-func FetchStatefulSetList(client Client, namespace string) ([]string, error) {
-	statefulsets, err := client.Clientset.AppsV1().StatefulSets(namespace).List(context.Background(), metav1.ListOptions{})
+func FetchStatefulSetList(ctx context.Context, client Client, namespace string) ([]string, error) {
+	statefulsets, err := client.Clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch statefulsets: %v", err)
 	}
@@ -44,17 +44,14 @@ func FetchStatefulSetList(client Client, namespace string) ([]string, error) {
 	return statefulsetNames, nil
 }
 
-func GetStatefulSetsTableData(client Client, namespace string) ([]StatefulSetInfo, error) {
-	statefulsets, err := client.Clientset.AppsV1().StatefulSets(namespace).List(
-		context.Background(),
-		metav1.ListOptions{},
-	)
+func GetStatefulSetsTableData(ctx context.Context, client Client, namespace string) ([]StatefulSetInfo, error) {
+	statefulsets, err := listStatefulSets(ctx, client, namespace, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list statefulsets: %v", err)
 	}
 
 	var statefulsetInfos []StatefulSetInfo
-	for _, statefulset := range statefulsets.Items {
+	for _, statefulset := range statefulsets {
 		replicas := "0"
 		if statefulset.Spec.Replicas != nil {
 			replicas = fmt.Sprintf("%d", *statefulset.Spec.Replicas)
@@ -76,8 +73,8 @@ func GetStatefulSetsTableData(client Client, namespace string) ([]StatefulSetInf
 	return statefulsetInfos, nil
 }
 
-func (ss *StatefulSetInfo) Fetch() error {
-	statefulset, err := ss.Client.Clientset.AppsV1().StatefulSets(ss.Namespace).Get(context.Background(), ss.Name, metav1.GetOptions{})
+func (ss *StatefulSetInfo) Fetch(ctx context.Context) error {
+	statefulset, err := ss.Client.Clientset.AppsV1().StatefulSets(ss.Namespace).Get(ctx, ss.Name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get statefulset: %v", err)
 	}
@@ -85,14 +82,14 @@ func (ss *StatefulSetInfo) Fetch() error {
 	return nil
 }
 
-func (ss *StatefulSetInfo) Describe() (string, error) {
+func (ss *StatefulSetInfo) Describe(ctx context.Context) (string, error) {
 	if ss.Raw == nil {
-		if err := ss.Fetch(); err != nil {
+		if err := ss.Fetch(ctx); err != nil {
 			return "", fmt.Errorf("failed to fetch statefulset: %v", err)
 		}
 	}
 
-	events, err := ss.Client.Clientset.CoreV1().Events(ss.Namespace).List(context.Background(), metav1.ListOptions{
+	events, err := ss.Client.Clientset.CoreV1().Events(ss.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,involvedObject.kind=StatefulSet", ss.Name, ss.Namespace),
 	})
 	if err != nil {
@@ -213,8 +210,25 @@ func (ss *StatefulSetInfo) DescribeStatefulSet(events *corev1.EventList) (map[st
 	return desc, nil
 }
 
-func DeleteStatefulSet(client Client, namespace string, statefulsetName string) error {
-	err := client.Clientset.AppsV1().StatefulSets(namespace).Delete(context.Background(), statefulsetName, metav1.DeleteOptions{})
+func (ss *StatefulSetInfo) GetLabelSelector() (string, error) {
+	if ss.Raw == nil {
+		return "", fmt.Errorf("statefulset raw data not available")
+	}
+
+	if ss.Raw.Spec.Selector == nil {
+		return "", fmt.Errorf("statefulset has no selector")
+	}
+
+	requirements, err := metav1.LabelSelectorAsSelector(ss.Raw.Spec.Selector)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert label selector: %v", err)
+	}
+
+	return requirements.String(), nil
+}
+
+func DeleteStatefulSet(ctx context.Context, client Client, namespace string, statefulsetName string) error {
+	err := client.Clientset.AppsV1().StatefulSets(namespace).Delete(ctx, statefulsetName, metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete statefulset %s: %v", statefulsetName, err)
 	}
@@ -0,0 +1,188 @@
+package k8s
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const templateNamespacePlaceholder = "{{namespace}}"
+
+type ManifestTemplate struct {
+	Name    string
+	Builtin bool
+	Content string
+}
+
+var builtinTemplates = []ManifestTemplate{
+	{Name: "Deployment", Content: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+  namespace: {{namespace}}
+  labels:
+    app: my-app
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: my-app
+  template:
+    metadata:
+      labels:
+        app: my-app
+    spec:
+      containers:
+        - name: my-app
+          image: nginx:latest
+          ports:
+            - containerPort: 80
+`},
+	{Name: "Service", Content: `apiVersion: v1
+kind: Service
+metadata:
+  name: my-app
+  namespace: {{namespace}}
+spec:
+  type: ClusterIP
+  selector:
+    app: my-app
+  ports:
+    - port: 80
+      targetPort: 80
+`},
+	{Name: "ConfigMap", Content: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+  namespace: {{namespace}}
+data:
+  key: value
+`},
+	{Name: "Secret", Content: `apiVersion: v1
+kind: Secret
+metadata:
+  name: my-secret
+  namespace: {{namespace}}
+type: Opaque
+stringData:
+  username: admin
+  password: change-me
+`},
+	{Name: "Job", Content: `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: my-job
+  namespace: {{namespace}}
+spec:
+  backoffLimit: 3
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+        - name: my-job
+          image: busybox:latest
+          command: ["sh", "-c", "echo hello"]
+`},
+	{Name: "CronJob", Content: `apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: my-cronjob
+  namespace: {{namespace}}
+spec:
+  schedule: "*/5 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: OnFailure
+          containers:
+            - name: my-cronjob
+              image: busybox:latest
+              command: ["sh", "-c", "date"]
+`},
+	{Name: "Ingress", Content: `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: my-ingress
+  namespace: {{namespace}}
+spec:
+  rules:
+    - host: my-app.example.com
+      http:
+        paths:
+          - path: /
+            pathType: Prefix
+            backend:
+              service:
+                name: my-app
+                port:
+                  number: 80
+`},
+	{Name: "PersistentVolumeClaim", Content: `apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: my-claim
+  namespace: {{namespace}}
+spec:
+  accessModes:
+    - ReadWriteOnce
+  resources:
+    requests:
+      storage: 1Gi
+`},
+	{Name: "ServiceAccount", Content: `apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: my-service-account
+  namespace: {{namespace}}
+`},
+}
+
+func renderTemplate(content, namespace string) string {
+	return strings.ReplaceAll(content, templateNamespacePlaceholder, namespace)
+}
+
+func BuiltinTemplates(namespace string) []ManifestTemplate {
+	templates := make([]ManifestTemplate, 0, len(builtinTemplates))
+	for _, template := range builtinTemplates {
+		templates = append(templates, ManifestTemplate{
+			Name:    template.Name,
+			Builtin: true,
+			Content: renderTemplate(template.Content, namespace),
+		})
+	}
+	return templates
+}
+
+func LoadUserTemplates(dir, namespace string) ([]ManifestTemplate, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template directory %s: %v", dir, err)
+	}
+
+	templates := []ManifestTemplate{}
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %v", entry.Name(), err)
+		}
+		templates = append(templates, ManifestTemplate{
+			Name:    strings.TrimSuffix(entry.Name(), ext),
+			Content: renderTemplate(string(data), namespace),
+		})
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
@@ -0,0 +1,108 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const DefaultRequestTimeout = 30 * time.Second
+
+var requestTimeout atomic.Int64
+
+func init() {
+	SetRequestTimeout(DefaultRequestTimeout)
+}
+
+func SetRequestTimeout(timeout time.Duration) {
+	requestTimeout.Store(int64(timeout))
+}
+
+func RequestTimeout() time.Duration {
+	return time.Duration(requestTimeout.Load())
+}
+
+type RequestTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *RequestTimeoutError) Error() string {
+	return fmt.Sprintf("the API server did not respond within %s", e.Timeout)
+}
+
+func (e *RequestTimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+func IsRequestTimeout(err error) bool {
+	var timeout *RequestTimeoutError
+	return errors.As(err, &timeout) || errors.Is(err, context.DeadlineExceeded)
+}
+
+type timeoutRoundTripper struct {
+	next http.RoundTripper
+}
+
+func withRequestTimeout(next http.RoundTripper) http.RoundTripper {
+	return &timeoutRoundTripper{next: next}
+}
+
+func (t *timeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := RequestTimeout()
+	if timeout <= 0 || isLongRunning(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	timer := time.AfterFunc(timeout, cancel)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if !timer.Stop() && req.Context().Err() == nil {
+		if err == nil {
+			resp.Body.Close()
+		}
+		cancel()
+		return nil, &RequestTimeoutError{Timeout: timeout}
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *timeoutRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return t.next
+}
+
+func isLongRunning(req *http.Request) bool {
+	query := req.URL.Query()
+	if query.Get("watch") == "true" || query.Get("follow") == "true" {
+		return true
+	}
+	if strings.EqualFold(req.Header.Get("Connection"), "upgrade") || req.Header.Get("Upgrade") != "" {
+		return true
+	}
+	for _, suffix := range []string{"/exec", "/attach", "/portforward"} {
+		if strings.HasSuffix(req.URL.Path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,146 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func slowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+			io.WriteString(w, "ok")
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func withTimeout(t *testing.T, timeout time.Duration) {
+	t.Helper()
+	previous := RequestTimeout()
+	SetRequestTimeout(timeout)
+	t.Cleanup(func() { SetRequestTimeout(previous) })
+}
+
+func TestRequestTimeoutAbandonsSlowRequests(t *testing.T) {
+	withTimeout(t, 50*time.Millisecond)
+	server := slowServer(t, 5*time.Second)
+	client := &http.Client{Transport: withRequestTimeout(http.DefaultTransport)}
+
+	start := time.Now()
+	_, err := client.Get(server.URL + "/api/v1/pods")
+	if err == nil {
+		t.Fatal("Expected the request to time out")
+	}
+	if !IsRequestTimeout(err) {
+		t.Errorf("Expected a request timeout error, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the error to match context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected the request to be abandoned quickly, took %s", elapsed)
+	}
+}
+
+func TestRequestTimeoutReadsBodyAfterResponse(t *testing.T) {
+	withTimeout(t, time.Second)
+	server := slowServer(t, 0)
+	client := &http.Client{Transport: withRequestTimeout(http.DefaultTransport)}
+
+	resp, err := client.Get(server.URL + "/api/v1/pods")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "ok" {
+		t.Errorf("Expected body %q, got %q (%v)", "ok", body, err)
+	}
+}
+
+func TestRequestTimeoutAllowsSlowBodyReads(t *testing.T) {
+	withTimeout(t, 50*time.Millisecond)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "first ")
+		w.(http.Flusher).Flush()
+		select {
+		case <-time.After(200 * time.Millisecond):
+			io.WriteString(w, "last")
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: withRequestTimeout(http.DefaultTransport)}
+
+	resp, err := client.Get(server.URL + "/api/v1/namespaces/default/pods/web/log")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "first last" {
+		t.Errorf("Expected the whole body past the timeout, got %q (%v)", body, err)
+	}
+}
+
+func TestRequestTimeoutSkipsLongRunningRequests(t *testing.T) {
+	withTimeout(t, 50*time.Millisecond)
+	server := slowServer(t, 200*time.Millisecond)
+	client := &http.Client{Transport: withRequestTimeout(http.DefaultTransport)}
+
+	for _, path := range []string{
+		"/api/v1/pods?watch=true",
+		"/api/v1/namespaces/default/pods/web/log?follow=true",
+		"/api/v1/namespaces/default/pods/web/exec",
+		"/api/v1/namespaces/default/pods/web/portforward",
+	} {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Errorf("Expected %s not to be limited, got %v", path, err)
+			continue
+		}
+		resp.Body.Close()
+	}
+}
+
+func TestRequestTimeoutKeepsCallerCancellation(t *testing.T) {
+	withTimeout(t, 5*time.Second)
+	server := slowServer(t, 5*time.Second)
+	client := &http.Client{Transport: withRequestTimeout(http.DefaultTransport)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/pods", nil)
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.Do(req)
+	if err == nil {
+		t.Fatal("Expected the request to be cancelled")
+	}
+	if IsRequestTimeout(err) {
+		t.Errorf("Expected a cancellation rather than a timeout, got %v", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestDisabledRequestTimeout(t *testing.T) {
+	withTimeout(t, 0)
+	server := slowServer(t, 100*time.Millisecond)
+	client := &http.Client{Transport: withRequestTimeout(http.DefaultTransport)}
+
+	resp, err := client.Get(server.URL + "/api/v1/pods")
+	if err != nil {
+		t.Fatalf("Expected no error with the timeout disabled, got %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,276 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	appsinformers "k8s.io/client-go/informers/apps/v1"
+	batchinformers "k8s.io/client-go/informers/batch/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	networkinginformers "k8s.io/client-go/informers/networking/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+type informerFunc func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer
+
+var watchableInformers = map[ResourceType]informerFunc{
+	ResourceTypePod: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return coreinformers.NewPodInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeService: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return coreinformers.NewServiceInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeServiceAccount: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return coreinformers.NewServiceAccountInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeConfigMap: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return coreinformers.NewConfigMapInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeSecret: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return coreinformers.NewSecretInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeNode: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return coreinformers.NewNodeInformer(client, 0, indexers)
+	},
+	ResourceTypeDeployment: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return appsinformers.NewDeploymentInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeReplicaSet: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return appsinformers.NewReplicaSetInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeDaemonSet: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return appsinformers.NewDaemonSetInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeStatefulSet: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return appsinformers.NewStatefulSetInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeJob: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return batchinformers.NewJobInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeCronJob: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return batchinformers.NewCronJobInformer(client, namespace, 0, indexers)
+	},
+	ResourceTypeIngress: func(client kubernetes.Interface, namespace string, indexers cache.Indexers) cache.SharedIndexInformer {
+		return networkinginformers.NewIngressInformer(client, namespace, 0, indexers)
+	},
+}
+
+type watchKey struct {
+	resourceType ResourceType
+	namespace    string
+}
+
+type watchEntry struct {
+	informer    cache.SharedIndexInformer
+	stop        chan struct{}
+	subscribers map[*WatchSubscription]struct{}
+}
+
+type WatchCache struct {
+	mu        sync.Mutex
+	clientset kubernetes.Interface
+	entries   map[watchKey]*watchEntry
+}
+
+type WatchSubscription struct {
+	cache  *WatchCache
+	key    watchKey
+	events chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+func NewWatchCache(clientset kubernetes.Interface) *WatchCache {
+	return &WatchCache{
+		clientset: clientset,
+		entries:   map[watchKey]*watchEntry{},
+	}
+}
+
+func IsWatchable(resourceType ResourceType) bool {
+	_, ok := watchableInformers[resourceType]
+	return ok
+}
+
+func (c *WatchCache) Subscribe(resourceType ResourceType, namespace string) (*WatchSubscription, error) {
+	newInformer, ok := watchableInformers[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("watching %s is not supported", resourceType)
+	}
+	if resourceType == ResourceTypeNode {
+		namespace = ""
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := watchKey{resourceType: resourceType, namespace: namespace}
+	entry, exists := c.entries[key]
+	if !exists {
+		entry = &watchEntry{
+			informer:    newInformer(c.clientset, namespace, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+			stop:        make(chan struct{}),
+			subscribers: map[*WatchSubscription]struct{}{},
+		}
+		notify := func() { c.notify(key) }
+		entry.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(any) { notify() },
+			UpdateFunc: func(any, any) { notify() },
+			DeleteFunc: func(any) { notify() },
+		})
+		c.entries[key] = entry
+		go entry.informer.Run(entry.stop)
+	}
+
+	sub := &WatchSubscription{
+		cache:  c,
+		key:    key,
+		events: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	entry.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+func (c *WatchCache) notify(key watchKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	for sub := range entry.subscribers {
+		select {
+		case sub.events <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (c *WatchCache) release(sub *WatchSubscription) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[sub.key]
+	if !ok {
+		return
+	}
+	delete(entry.subscribers, sub)
+	if len(entry.subscribers) == 0 {
+		close(entry.stop)
+		delete(c.entries, sub.key)
+	}
+}
+
+func (c *WatchCache) Watching(resourceType ResourceType, namespace string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.entries[watchKey{resourceType: resourceType, namespace: namespace}]
+	return ok
+}
+
+func (c *WatchCache) Stop() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		close(entry.stop)
+		for sub := range entry.subscribers {
+			sub.once.Do(func() { close(sub.done) })
+		}
+		delete(c.entries, key)
+	}
+}
+
+func (c *WatchCache) list(resourceType ResourceType, namespace, selector string) ([]any, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if resourceType == ResourceTypeNode {
+		namespace = ""
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[watchKey{resourceType: resourceType, namespace: namespace}]
+	if !ok && namespace != "" {
+		entry, ok = c.entries[watchKey{resourceType: resourceType}]
+	}
+	c.mu.Unlock()
+	if !ok || !entry.informer.HasSynced() {
+		return nil, false
+	}
+
+	var objects []any
+	var err error
+	if namespace == "" {
+		objects = entry.informer.GetStore().List()
+	} else {
+		objects, err = entry.informer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	parsed := labels.Everything()
+	if selector != "" {
+		if parsed, err = labels.Parse(selector); err != nil {
+			return nil, false
+		}
+	}
+
+	matched := make([]any, 0, len(objects))
+	for _, obj := range objects {
+		accessor, ok := obj.(metav1.Object)
+		if !ok || !parsed.Matches(labels.Set(accessor.GetLabels())) {
+			continue
+		}
+		matched = append(matched, obj)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i].(metav1.Object), matched[j].(metav1.Object)
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+	return matched, true
+}
+
+func (s *WatchSubscription) Events() <-chan struct{} {
+	return s.events
+}
+
+func (s *WatchSubscription) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *WatchSubscription) Close() {
+	s.once.Do(func() {
+		close(s.done)
+		s.cache.release(s)
+	})
+}
+
+func listObjects[T any](client Client, resourceType ResourceType, namespace, selector string, list func(opts metav1.ListOptions) ([]T, error)) ([]T, error) {
+	if objects, ok := client.Cache.list(resourceType, namespace, selector); ok {
+		items := make([]T, 0, len(objects))
+		for _, obj := range objects {
+			if item, ok := obj.(*T); ok {
+				items = append(items, *item)
+			}
+		}
+		return items, nil
+	}
+	return list(metav1.ListOptions{LabelSelector: selector})
+}
@@ -0,0 +1,117 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func watchPod(name, namespace string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+}
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWatchCacheServesListsFromInformer(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		watchPod("web-1", "default", map[string]string{"app": "web"}),
+		watchPod("db-1", "default", map[string]string{"app": "db"}),
+		watchPod("other", "kube-system", nil),
+	)
+	watchCache := NewWatchCache(clientset)
+	defer watchCache.Stop()
+	client := Client{Clientset: clientset, Cache: watchCache}
+
+	sub, err := watchCache.Subscribe(ResourceTypePod, "default")
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	waitFor(t, "the informer to sync", func() bool {
+		_, ok := watchCache.list(ResourceTypePod, "default", "")
+		return ok
+	})
+	waitFor(t, "the informer to watch", func() bool {
+		for _, action := range clientset.Actions() {
+			if action.GetVerb() == "watch" {
+				return true
+			}
+		}
+		return false
+	})
+
+	clientset.ClearActions()
+	pods, err := FetchPods(context.Background(), client, "default", "app=web")
+	if err != nil {
+		t.Fatalf("FetchPods failed: %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "web-1" {
+		t.Errorf("Expected only web-1, got %v", pods)
+	}
+	if actions := clientset.Actions(); len(actions) != 0 {
+		t.Errorf("Expected FetchPods to be served from the cache, got %d API calls", len(actions))
+	}
+
+	if _, err := FetchPods(context.Background(), client, "kube-system", ""); err != nil {
+		t.Fatalf("FetchPods failed: %v", err)
+	}
+	if actions := clientset.Actions(); len(actions) != 1 || actions[0].GetVerb() != "list" {
+		t.Errorf("Expected an unwatched namespace to be listed from the API, got %v", actions)
+	}
+
+	for len(sub.Events()) > 0 {
+		<-sub.Events()
+	}
+	clientset.CoreV1().Pods("default").Create(context.Background(), watchPod("web-2", "default", map[string]string{"app": "web"}), metav1.CreateOptions{})
+	select {
+	case <-sub.Events():
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a watch event after creating a pod")
+	}
+	pods, _ = FetchPods(context.Background(), client, "default", "app=web")
+	if len(pods) != 2 || pods[0].Name != "web-1" || pods[1].Name != "web-2" {
+		t.Errorf("Expected web-1 and web-2 in name order, got %v", pods)
+	}
+}
+
+func TestWatchCacheStopsInformerWhenUnused(t *testing.T) {
+	watchCache := NewWatchCache(fake.NewSimpleClientset())
+	defer watchCache.Stop()
+
+	first, err := watchCache.Subscribe(ResourceTypeDeployment, "default")
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	second, _ := watchCache.Subscribe(ResourceTypeDeployment, "default")
+
+	first.Close()
+	first.Close()
+	if !watchCache.Watching(ResourceTypeDeployment, "default") {
+		t.Error("Expected the informer to keep running while a subscriber remains")
+	}
+	second.Close()
+	if watchCache.Watching(ResourceTypeDeployment, "default") {
+		t.Error("Expected the informer to stop once no subscriber needs it")
+	}
+	select {
+	case <-second.Done():
+	default:
+		t.Error("Expected Done to be closed after Close")
+	}
+
+	if _, err := watchCache.Subscribe(ResourceTypeEvent, "default"); err == nil {
+		t.Error("Expected an error for a kind that cannot be watched")
+	}
+}
@@ -0,0 +1,270 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type field struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{min: 0, max: 59}
+	hourField   = field{min: 0, max: 23}
+	domField    = field{min: 1, max: 31}
+	monthField  = field{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = field{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = []string{"", "January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+
+var dayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+type Schedule struct {
+	Expression string
+	Location   *time.Location
+
+	minutes, hours, doms, months, dows []bool
+	fields                             []string
+	domStar, dowStar                   bool
+}
+
+func Parse(expression string) (*Schedule, error) {
+	spec := strings.TrimSpace(expression)
+	location := time.UTC
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		tz, rest, _ := strings.Cut(spec, " ")
+		loc, err := time.LoadLocation(tz[strings.Index(tz, "=")+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone in schedule %q: %v", expression, err)
+		}
+		location = loc
+		spec = strings.TrimSpace(rest)
+	}
+	if macro, ok := macros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields, got %d", expression, len(fields))
+	}
+
+	s := &Schedule{Expression: expression, Location: location, fields: fields}
+	var err error
+	if s.minutes, err = parseField(fields[0], minuteField); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %v", err)
+	}
+	if s.hours, err = parseField(fields[1], hourField); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %v", err)
+	}
+	if s.doms, err = parseField(fields[2], domField); err != nil {
+		return nil, fmt.Errorf("invalid day-of-month field: %v", err)
+	}
+	if s.months, err = parseField(fields[3], monthField); err != nil {
+		return nil, fmt.Errorf("invalid month field: %v", err)
+	}
+	if s.dows, err = parseField(fields[4], dowField); err != nil {
+		return nil, fmt.Errorf("invalid day-of-week field: %v", err)
+	}
+	s.dows[0] = s.dows[0] || s.dows[7]
+	s.dows = s.dows[:7]
+	s.domStar = isStar(fields[2])
+	s.dowStar = isStar(fields[4])
+	return s, nil
+}
+
+func (s *Schedule) In(location *time.Location) *Schedule {
+	copy := *s
+	copy.Location = location
+	return &copy
+}
+
+func (s *Schedule) Next(after time.Time) time.Time {
+	t := after.In(s.Location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] {
+			t = advance(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.Location))
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = advance(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.Location))
+			continue
+		}
+		if !s.hours[t.Hour()] {
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func advance(current, next time.Time) time.Time {
+	if next.After(current) {
+		return next
+	}
+	return current.Add(time.Duration(60-current.Minute()) * time.Minute)
+}
+
+func (s *Schedule) NextN(after time.Time, n int) []time.Time {
+	var runs []time.Time
+	for len(runs) < n {
+		next := s.Next(after)
+		if next.IsZero() {
+			break
+		}
+		runs = append(runs, next)
+		after = next
+	}
+	return runs
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.doms[t.Day()]
+	dow := s.dows[int(t.Weekday())]
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (s *Schedule) Describe() string {
+	minute, hour, dom, month, dow := s.fields[0], s.fields[1], s.fields[2], s.fields[3], s.fields[4]
+
+	var parts []string
+	switch {
+	case isStar(minute) && isStar(hour):
+		parts = append(parts, "Every minute")
+	case strings.HasPrefix(minute, "*/") && isStar(hour):
+		parts = append(parts, fmt.Sprintf("Every %s minutes", minute[2:]))
+	case isNumber(minute) && isNumber(hour):
+		h, _ := strconv.Atoi(hour)
+		m, _ := strconv.Atoi(minute)
+		parts = append(parts, fmt.Sprintf("At %02d:%02d", h, m))
+	case isNumber(minute) && isStar(hour):
+		parts = append(parts, fmt.Sprintf("At minute %s past every hour", minute))
+	case isNumber(minute) && strings.HasPrefix(hour, "*/"):
+		parts = append(parts, fmt.Sprintf("At minute %s past every %s hours", minute, hour[2:]))
+	default:
+		parts = append(parts, fmt.Sprintf("At minute %s past hour %s", minute, hour))
+	}
+
+	if !isStar(dom) {
+		parts = append(parts, "on day "+dom+" of the month")
+	}
+	if !isStar(dow) {
+		if !isStar(dom) {
+			parts = append(parts, "and")
+		}
+		parts = append(parts, "on "+listNames(s.dows, dayNames, 0))
+	}
+	if !isStar(month) {
+		parts = append(parts, "in "+listNames(s.months, monthNames, 1))
+	}
+	return strings.Join(parts, " ")
+}
+
+func listNames(set []bool, names []string, offset int) string {
+	var selected []string
+	for i := offset; i < len(set); i++ {
+		if set[i] {
+			selected = append(selected, names[i])
+		}
+	}
+	if len(selected) > 1 {
+		return strings.Join(selected[:len(selected)-1], ", ") + " and " + selected[len(selected)-1]
+	}
+	return strings.Join(selected, "")
+}
+
+func parseField(spec string, f field) ([]bool, error) {
+	set := make([]bool, f.max+1)
+	for _, part := range strings.Split(spec, ",") {
+		rangeSpec, stepSpec, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepSpec)
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepSpec)
+			}
+		}
+
+		start, end := f.min, f.max
+		switch {
+		case isStar(rangeSpec):
+		case strings.Contains(rangeSpec, "-"):
+			lo, hi, _ := strings.Cut(rangeSpec, "-")
+			var err error
+			if start, err = f.value(lo); err != nil {
+				return nil, err
+			}
+			if end, err = f.value(hi); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range %q", rangeSpec)
+			}
+		default:
+			value, err := f.value(rangeSpec)
+			if err != nil {
+				return nil, err
+			}
+			start = value
+			if !hasStep {
+				end = value
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			set[i] = true
+		}
+	}
+	return set, nil
+}
+
+func (f field) value(s string) (int, error) {
+	if value, ok := f.names[strings.ToLower(s)]; ok {
+		return value, nil
+	}
+	value, err := strconv.Atoi(s)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("value %q out of range %d-%d", s, f.min, f.max)
+	}
+	return value, nil
+}
+
+func isStar(s string) bool {
+	return s == "*" || s == "?"
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
@@ -0,0 +1,103 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleNext(t *testing.T) {
+	start := time.Date(2024, 1, 31, 23, 59, 30, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		expected   time.Time
+	}{
+		{"*/15 * * * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2024, 2, 1, 2, 30, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1 * 0", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		schedule, err := Parse(tt.expression)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", tt.expression, err)
+		}
+		if next := schedule.Next(start); !next.Equal(tt.expected) {
+			t.Errorf("Next(%q) = %v, expected %v", tt.expression, next, tt.expected)
+		}
+	}
+}
+
+func TestScheduleTimeZone(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	schedule, err := Parse("0 9 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runs := schedule.In(location).NextN(time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC), 2)
+	if len(runs) != 2 {
+		t.Fatalf("Expected 2 runs, got %d", len(runs))
+	}
+	if runs[0].UTC() != time.Date(2024, 3, 9, 14, 0, 0, 0, time.UTC) {
+		t.Errorf("Expected 09:00 EST, got %v", runs[0].UTC())
+	}
+	if runs[1].UTC() != time.Date(2024, 3, 10, 13, 0, 0, 0, time.UTC) {
+		t.Errorf("Expected 09:00 EDT after the DST change, got %v", runs[1].UTC())
+	}
+}
+
+func TestScheduleDescribe(t *testing.T) {
+	tests := map[string]string{
+		"* * * * *":        "Every minute",
+		"*/5 * * * *":      "Every 5 minutes",
+		"30 2 * * *":       "At 02:30",
+		"0 9 * * 1-5":      "At 09:00 on Monday, Tuesday, Wednesday, Thursday and Friday",
+		"15 * 1 jan,jul *": "At minute 15 past every hour on day 1 of the month in January and July",
+		"0 */6 * * *":      "At minute 0 past every 6 hours",
+	}
+
+	for expression, expected := range tests {
+		schedule, err := Parse(expression)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", expression, err)
+		}
+		if got := schedule.Describe(); got != expected {
+			t.Errorf("Describe(%q) = %q, expected %q", expression, got, expected)
+		}
+	}
+}
+
+func TestParseDayOfWeekSeven(t *testing.T) {
+	tests := map[string]string{
+		"1-7": "Sunday, Monday, Tuesday, Wednesday, Thursday, Friday and Saturday",
+		"5-7": "Sunday, Friday and Saturday",
+		"*/7": "Sunday",
+		"0,7": "Sunday",
+	}
+
+	for dow, expected := range tests {
+		schedule, err := Parse("0 0 * * " + dow)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", dow, err)
+		}
+		if got := listNames(schedule.dows, dayNames, 0); got != expected {
+			t.Errorf("day-of-week %q = %q, expected %q", dow, got, expected)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expression := range []string{"* * * *", "60 * * * *", "5-1 * * * *", "*/0 * * * *", "* * * foo *", "* * * * 8"} {
+		if _, err := Parse(expression); err == nil {
+			t.Errorf("Expected Parse(%q) to fail", expression)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Op byte
+
+const (
+	Equal  Op = ' '
+	Delete Op = '-'
+	Insert Op = '+'
+)
+
+type Line struct {
+	Op   Op
+	Text string
+}
+
+const maxEdits = 1000
+
+func Lines(a, b string) []Line {
+	x := splitLines(a)
+	y := splitLines(b)
+
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	var lines []Line
+	for _, text := range x[:prefix] {
+		lines = append(lines, Line{Equal, text})
+	}
+	lines = append(lines, edits(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])...)
+	for _, text := range x[len(x)-suffix:] {
+		lines = append(lines, Line{Equal, text})
+	}
+	return lines
+}
+
+func edits(x, y []string) []Line {
+	offset := len(x) + len(y)
+	v := make([]int, 2*offset+2)
+	var trace [][]int
+	for d := 0; d <= min(offset, maxEdits); d++ {
+		for k := -d; k <= d; k += 2 {
+			var i int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				i = v[offset+k+1]
+			} else {
+				i = v[offset+k-1] + 1
+			}
+			j := i - k
+			for i < len(x) && j < len(y) && x[i] == y[j] {
+				i++
+				j++
+			}
+			v[offset+k] = i
+			if i >= len(x) && j >= len(y) {
+				return backtrack(x, y, trace)
+			}
+		}
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+	}
+
+	var lines []Line
+	for _, text := range x {
+		lines = append(lines, Line{Delete, text})
+	}
+	for _, text := range y {
+		lines = append(lines, Line{Insert, text})
+	}
+	return lines
+}
+
+func backtrack(x, y []string, trace [][]int) []Line {
+	var lines []Line
+	i, j := len(x), len(y)
+	for d := len(trace); d > 0; d-- {
+		prev := trace[d-1]
+		at := func(k int) int { return prev[k+d-1] }
+		k := i - j
+		prevK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		}
+		prevI := at(prevK)
+		prevJ := prevI - prevK
+		for i > prevI && j > prevJ {
+			lines = append(lines, Line{Equal, x[i-1]})
+			i--
+			j--
+		}
+		if i == prevI {
+			lines = append(lines, Line{Insert, y[j-1]})
+			j--
+		} else {
+			lines = append(lines, Line{Delete, x[i-1]})
+			i--
+		}
+	}
+	for ; i > 0; i-- {
+		lines = append(lines, Line{Equal, x[i-1]})
+	}
+	for l, r := 0, len(lines)-1; l < r; l, r = l+1, r-1 {
+		lines[l], lines[r] = lines[r], lines[l]
+	}
+	return lines
+}
+
+func Unified(fromName, toName, a, b string, context int) string {
+	lines := Lines(a, b)
+
+	changed := false
+	for _, line := range lines {
+		if line.Op != Equal {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+
+	for start := 0; start < len(lines); {
+		for start < len(lines) && lines[start].Op == Equal {
+			start++
+		}
+		if start == len(lines) {
+			break
+		}
+
+		first := max(start-context, 0)
+		end := start
+		for end < len(lines) {
+			if lines[end].Op != Equal {
+				end++
+				continue
+			}
+			next := end
+			for next < len(lines) && lines[next].Op == Equal {
+				next++
+			}
+			if next == len(lines) || next-end > 2*context {
+				break
+			}
+			end = next
+		}
+		last := min(end+context, len(lines))
+
+		fromLine, toLine := 1, 1
+		for _, line := range lines[:first] {
+			if line.Op != Insert {
+				fromLine++
+			}
+			if line.Op != Delete {
+				toLine++
+			}
+		}
+		fromCount, toCount := 0, 0
+		for _, line := range lines[first:last] {
+			if line.Op != Insert {
+				fromCount++
+			}
+			if line.Op != Delete {
+				toCount++
+			}
+		}
+		if fromCount == 0 {
+			fromLine--
+		}
+		if toCount == 0 {
+			toLine--
+		}
+
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", fromLine, fromCount, toLine, toCount)
+		for _, line := range lines[first:last] {
+			out.WriteByte(byte(line.Op))
+			out.WriteString(line.Text)
+			out.WriteByte('\n')
+		}
+		start = last
+	}
+	return out.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,106 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	a := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+	b := "a\nb\nc\nD\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+
+	expected := `--- old
++++ new
+@@ -1,7 +1,7 @@
+ a
+ b
+ c
+-d
++D
+ e
+ f
+ g
+@@ -10,3 +10,4 @@
+ j
+ k
+ l
++m
+`
+	if got := Unified("old", "new", a, b, 3); got != expected {
+		t.Errorf("Unexpected diff:\n%s", got)
+	}
+}
+
+func TestUnifiedMergesCloseHunks(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n"
+	b := "1\nx\n3\n4\ny\n"
+
+	got := Unified("a", "b", a, b, 1)
+	if strings.Count(got, "@@ ") != 1 {
+		t.Errorf("Expected a single hunk, got:\n%s", got)
+	}
+	if !strings.Contains(got, "@@ -1,5 +1,5 @@") {
+		t.Errorf("Unexpected hunk header:\n%s", got)
+	}
+}
+
+func TestUnifiedNoChanges(t *testing.T) {
+	if got := Unified("a", "b", "same\n", "same\n", 3); got != "" {
+		t.Errorf("Expected no diff, got %q", got)
+	}
+	if got := Unified("a", "b", "", "new\n", 3); !strings.Contains(got, "@@ -0,0 +1,1 @@\n+new") {
+		t.Errorf("Expected an insertion into an empty file, got %q", got)
+	}
+}
+
+func TestLinesLargeInputs(t *testing.T) {
+	var a, b, c strings.Builder
+	for i := 0; i < 20000; i++ {
+		fmt.Fprintf(&a, "key%d: value%d\n", i, i)
+		if i%1000 == 500 {
+			fmt.Fprintf(&b, "key%d: changed\n", i)
+		} else {
+			fmt.Fprintf(&b, "key%d: value%d\n", i, i)
+		}
+		fmt.Fprintf(&c, "other%d\n", i)
+	}
+
+	changed := 0
+	for _, line := range Lines(a.String(), b.String()) {
+		if line.Op != Equal {
+			changed++
+		}
+	}
+	if changed != 40 {
+		t.Errorf("Expected 20 replaced lines, got %d changed lines", changed)
+	}
+
+	lines := Lines(a.String(), c.String())
+	if len(lines) != 40000 || lines[0].Op != Delete || lines[len(lines)-1].Op != Insert {
+		t.Errorf("Expected a full replacement of unrelated inputs, got %d lines", len(lines))
+	}
+}
+
+func TestLinesMinimalEdits(t *testing.T) {
+	got := Lines("a\nb\nc\na\nb\nb\na\n", "c\nb\na\nb\na\nc\n")
+	var from, to []string
+	edits := 0
+	for _, line := range got {
+		if line.Op != Insert {
+			from = append(from, line.Text)
+		}
+		if line.Op != Delete {
+			to = append(to, line.Text)
+		}
+		if line.Op != Equal {
+			edits++
+		}
+	}
+	if strings.Join(from, "") != "abcabba" || strings.Join(to, "") != "cbabac" {
+		t.Errorf("Expected the edit script to rebuild both inputs, got %v", got)
+	}
+	if edits != 5 {
+		t.Errorf("Expected the shortest edit script of 5 edits, got %d: %v", edits, got)
+	}
+}
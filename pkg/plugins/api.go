@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"context"
 	"fmt"
 	k8s "github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/pkg/logger"
@@ -202,7 +203,7 @@ func (api *PluginAPIImpl) SetClient(client k8s.Client) {
 
 
 
-func (api *PluginAPIImpl) GetPods(namespace string, selector ...string) ([]k8s.PodInfo, error) {
+func (api *PluginAPIImpl) GetPods(ctx context.Context, namespace string, selector ...string) ([]k8s.PodInfo, error) {
 	selectorStr := ""
 	if len(selector) > 0 && selector[0] != "" {
 		selectorStr = selector[0]
@@ -218,112 +219,112 @@ func (api *PluginAPIImpl) GetPods(namespace string, selector ...string) ([]k8s.P
 	
 	if !exists || handler == nil || selectorStr != "" {
 		logger.Debug(fmt.Sprintf("Using direct k8s client with selector: %s", selectorStr))
-		return k8s.FetchPods(api.client, namespace, selectorStr)
+		return k8s.FetchPods(ctx, api.client, namespace, selectorStr)
 	}
 
 	
 	logger.Debug("Using custom handler (no selector provided)")
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypePod, namespace)
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypePod, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.PodInfo), nil
 }
 
-func (api *PluginAPIImpl) GetServices(namespace string) ([]k8s.ServiceInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeService, namespace)
+func (api *PluginAPIImpl) GetServices(ctx context.Context, namespace string) ([]k8s.ServiceInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeService, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.ServiceInfo), nil
 }
 
-func (api *PluginAPIImpl) GetDeployments(namespace string) ([]k8s.DeploymentInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeDeployment, namespace)
+func (api *PluginAPIImpl) GetDeployments(ctx context.Context, namespace string) ([]k8s.DeploymentInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeDeployment, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.DeploymentInfo), nil
 }
 
-func (api *PluginAPIImpl) GetConfigMaps(namespace string) ([]k8s.Configmap, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeConfigMap, namespace)
+func (api *PluginAPIImpl) GetConfigMaps(ctx context.Context, namespace string) ([]k8s.Configmap, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeConfigMap, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.Configmap), nil
 }
 
-func (api *PluginAPIImpl) GetSecrets(namespace string) ([]k8s.SecretInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeSecret, namespace)
+func (api *PluginAPIImpl) GetSecrets(ctx context.Context, namespace string) ([]k8s.SecretInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeSecret, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.SecretInfo), nil
 }
 
-func (api *PluginAPIImpl) GetIngresses(namespace string) ([]k8s.IngressInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeIngress, namespace)
+func (api *PluginAPIImpl) GetIngresses(ctx context.Context, namespace string) ([]k8s.IngressInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeIngress, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.IngressInfo), nil
 }
 
-func (api *PluginAPIImpl) GetJobs(namespace string) ([]k8s.JobInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeJob, namespace)
+func (api *PluginAPIImpl) GetJobs(ctx context.Context, namespace string) ([]k8s.JobInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeJob, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.JobInfo), nil
 }
 
-func (api *PluginAPIImpl) GetCronJobs(namespace string) ([]k8s.CronJobInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeCronJob, namespace)
+func (api *PluginAPIImpl) GetCronJobs(ctx context.Context, namespace string) ([]k8s.CronJobInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeCronJob, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.CronJobInfo), nil
 }
 
-func (api *PluginAPIImpl) GetDaemonSets(namespace string) ([]k8s.DaemonSetInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeDaemonSet, namespace)
+func (api *PluginAPIImpl) GetDaemonSets(ctx context.Context, namespace string) ([]k8s.DaemonSetInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeDaemonSet, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.DaemonSetInfo), nil
 }
 
-func (api *PluginAPIImpl) GetStatefulSets(namespace string) ([]k8s.StatefulSetInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeStatefulSet, namespace)
+func (api *PluginAPIImpl) GetStatefulSets(ctx context.Context, namespace string) ([]k8s.StatefulSetInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeStatefulSet, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.StatefulSetInfo), nil
 }
 
-func (api *PluginAPIImpl) GetReplicaSets(namespace string) ([]k8s.ReplicaSetInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeReplicaSet, namespace)
+func (api *PluginAPIImpl) GetReplicaSets(ctx context.Context, namespace string) ([]k8s.ReplicaSetInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeReplicaSet, namespace)
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.ReplicaSetInfo), nil
 }
 
-func (api *PluginAPIImpl) GetNodes() ([]k8s.NodeInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeNode, "")
+func (api *PluginAPIImpl) GetNodes(ctx context.Context) ([]k8s.NodeInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeNode, "")
 	if err != nil {
 		return nil, err
 	}
 	return result.([]k8s.NodeInfo), nil
 }
 
-func (api *PluginAPIImpl) GetNamespaces() ([]string, error) {
-	return k8s.FetchNamespaces(api.client)
+func (api *PluginAPIImpl) GetNamespaces(ctx context.Context) ([]string, error) {
+	return k8s.FetchNamespaces(ctx, api.client)
 }
 
-func (api *PluginAPIImpl) GetServiceAccounts(namespace string) ([]k8s.ServiceAccountInfo, error) {
-	result, err := api.resourceRegistry.GetResource(api.client, k8s.ResourceTypeServiceAccount, namespace)
+func (api *PluginAPIImpl) GetServiceAccounts(ctx context.Context, namespace string) ([]k8s.ServiceAccountInfo, error) {
+	result, err := api.resourceRegistry.GetResource(ctx, api.client, k8s.ResourceTypeServiceAccount, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -332,106 +333,106 @@ func (api *PluginAPIImpl) GetServiceAccounts(namespace string) ([]k8s.ServiceAcc
 
 
 
-func (api *PluginAPIImpl) DeletePod(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypePod, namespace, name)
+func (api *PluginAPIImpl) DeletePod(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypePod, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteService(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeService, namespace, name)
+func (api *PluginAPIImpl) DeleteService(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeService, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteDeployment(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeDeployment, namespace, name)
+func (api *PluginAPIImpl) DeleteDeployment(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeDeployment, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteConfigMap(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeConfigMap, namespace, name)
+func (api *PluginAPIImpl) DeleteConfigMap(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeConfigMap, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteSecret(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeSecret, namespace, name)
+func (api *PluginAPIImpl) DeleteSecret(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeSecret, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteIngress(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeIngress, namespace, name)
+func (api *PluginAPIImpl) DeleteIngress(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeIngress, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteJob(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeJob, namespace, name)
+func (api *PluginAPIImpl) DeleteJob(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeJob, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteCronJob(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeCronJob, namespace, name)
+func (api *PluginAPIImpl) DeleteCronJob(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeCronJob, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteDaemonSet(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeDaemonSet, namespace, name)
+func (api *PluginAPIImpl) DeleteDaemonSet(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeDaemonSet, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteStatefulSet(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeStatefulSet, namespace, name)
+func (api *PluginAPIImpl) DeleteStatefulSet(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeStatefulSet, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteReplicaSet(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeReplicaSet, namespace, name)
+func (api *PluginAPIImpl) DeleteReplicaSet(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeReplicaSet, namespace, name)
 }
 
-func (api *PluginAPIImpl) DeleteServiceAccount(namespace, name string) error {
-	return api.resourceRegistry.DeleteResource(api.client, k8s.ResourceTypeServiceAccount, namespace, name)
+func (api *PluginAPIImpl) DeleteServiceAccount(ctx context.Context, namespace, name string) error {
+	return api.resourceRegistry.DeleteResource(ctx, api.client, k8s.ResourceTypeServiceAccount, namespace, name)
 }
 
 
 
-func (api *PluginAPIImpl) DescribePod(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypePod, namespace, name)
+func (api *PluginAPIImpl) DescribePod(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypePod, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeService(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeService, namespace, name)
+func (api *PluginAPIImpl) DescribeService(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeService, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeDeployment(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeDeployment, namespace, name)
+func (api *PluginAPIImpl) DescribeDeployment(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeDeployment, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeConfigMap(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeConfigMap, namespace, name)
+func (api *PluginAPIImpl) DescribeConfigMap(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeConfigMap, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeSecret(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeSecret, namespace, name)
+func (api *PluginAPIImpl) DescribeSecret(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeSecret, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeIngress(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeIngress, namespace, name)
+func (api *PluginAPIImpl) DescribeIngress(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeIngress, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeJob(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeJob, namespace, name)
+func (api *PluginAPIImpl) DescribeJob(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeJob, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeCronJob(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeCronJob, namespace, name)
+func (api *PluginAPIImpl) DescribeCronJob(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeCronJob, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeDaemonSet(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeDaemonSet, namespace, name)
+func (api *PluginAPIImpl) DescribeDaemonSet(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeDaemonSet, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeStatefulSet(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeStatefulSet, namespace, name)
+func (api *PluginAPIImpl) DescribeStatefulSet(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeStatefulSet, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeReplicaSet(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeReplicaSet, namespace, name)
+func (api *PluginAPIImpl) DescribeReplicaSet(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeReplicaSet, namespace, name)
 }
 
-func (api *PluginAPIImpl) DescribeNode(name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeNode, "", name)
+func (api *PluginAPIImpl) DescribeNode(ctx context.Context, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeNode, "", name)
 }
 
-func (api *PluginAPIImpl) DescribeServiceAccount(namespace, name string) (string, error) {
-	return api.resourceRegistry.DescribeResource(api.client, k8s.ResourceTypeServiceAccount, namespace, name)
+func (api *PluginAPIImpl) DescribeServiceAccount(ctx context.Context, namespace, name string) (string, error) {
+	return api.resourceRegistry.DescribeResource(ctx, api.client, k8s.ResourceTypeServiceAccount, namespace, name)
 }
 
 
@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"context"
 	k8s "github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/types"
 	"time"
@@ -33,13 +34,18 @@ type ResourcePlugin interface {
 	GetResourceTypes() []CustomResourceType
 
 	
-	GetResourceData(client k8s.Client, resourceType string, namespace string) ([]types.ResourceData, error)
+	GetResourceData(ctx context.Context, client k8s.Client, resourceType string, namespace string) ([]types.ResourceData, error)
 
 	
-	DeleteResource(client k8s.Client, resourceType string, namespace string, name string) error
+	DeleteResource(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string) error
 
 	
-	GetResourceInfo(client k8s.Client, resourceType string, namespace string, name string) (*k8s.ResourceInfo, error)
+	GetResourceInfo(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string) (*k8s.ResourceInfo, error)
+}
+
+type ResourcePatcher interface {
+	
+	PatchResource(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string, patch []byte) error
 }
 
 type UIPlugin interface {
@@ -276,35 +282,35 @@ type PluginAPI interface {
 	SetClient(client k8s.Client)
 
 	
-	GetPods(namespace string, selector ...string) ([]k8s.PodInfo, error)
-	GetServices(namespace string) ([]k8s.ServiceInfo, error)
-	GetDeployments(namespace string) ([]k8s.DeploymentInfo, error)
-	GetConfigMaps(namespace string) ([]k8s.Configmap, error)
-	GetSecrets(namespace string) ([]k8s.SecretInfo, error)
-	GetIngresses(namespace string) ([]k8s.IngressInfo, error)
-	GetJobs(namespace string) ([]k8s.JobInfo, error)
-	GetCronJobs(namespace string) ([]k8s.CronJobInfo, error)
-	GetDaemonSets(namespace string) ([]k8s.DaemonSetInfo, error)
-	GetStatefulSets(namespace string) ([]k8s.StatefulSetInfo, error)
-	GetReplicaSets(namespace string) ([]k8s.ReplicaSetInfo, error)
-	GetNodes() ([]k8s.NodeInfo, error)
-	GetNamespaces() ([]string, error)
-	GetServiceAccounts(namespace string) ([]k8s.ServiceAccountInfo, error)
-
-	
-	DescribePod(namespace, name string) (string, error)
-	DescribeService(namespace, name string) (string, error)
-	DescribeDeployment(namespace, name string) (string, error)
-	DescribeConfigMap(namespace, name string) (string, error)
-	DescribeSecret(namespace, name string) (string, error)
-	DescribeIngress(namespace, name string) (string, error)
-	DescribeJob(namespace, name string) (string, error)
-	DescribeCronJob(namespace, name string) (string, error)
-	DescribeDaemonSet(namespace, name string) (string, error)
-	DescribeStatefulSet(namespace, name string) (string, error)
-	DescribeReplicaSet(namespace, name string) (string, error)
-	DescribeNode(name string) (string, error)
-	DescribeServiceAccount(namespace, name string) (string, error)
+	GetPods(ctx context.Context, namespace string, selector ...string) ([]k8s.PodInfo, error)
+	GetServices(ctx context.Context, namespace string) ([]k8s.ServiceInfo, error)
+	GetDeployments(ctx context.Context, namespace string) ([]k8s.DeploymentInfo, error)
+	GetConfigMaps(ctx context.Context, namespace string) ([]k8s.Configmap, error)
+	GetSecrets(ctx context.Context, namespace string) ([]k8s.SecretInfo, error)
+	GetIngresses(ctx context.Context, namespace string) ([]k8s.IngressInfo, error)
+	GetJobs(ctx context.Context, namespace string) ([]k8s.JobInfo, error)
+	GetCronJobs(ctx context.Context, namespace string) ([]k8s.CronJobInfo, error)
+	GetDaemonSets(ctx context.Context, namespace string) ([]k8s.DaemonSetInfo, error)
+	GetStatefulSets(ctx context.Context, namespace string) ([]k8s.StatefulSetInfo, error)
+	GetReplicaSets(ctx context.Context, namespace string) ([]k8s.ReplicaSetInfo, error)
+	GetNodes(ctx context.Context) ([]k8s.NodeInfo, error)
+	GetNamespaces(ctx context.Context) ([]string, error)
+	GetServiceAccounts(ctx context.Context, namespace string) ([]k8s.ServiceAccountInfo, error)
+
+	
+	DescribePod(ctx context.Context, namespace, name string) (string, error)
+	DescribeService(ctx context.Context, namespace, name string) (string, error)
+	DescribeDeployment(ctx context.Context, namespace, name string) (string, error)
+	DescribeConfigMap(ctx context.Context, namespace, name string) (string, error)
+	DescribeSecret(ctx context.Context, namespace, name string) (string, error)
+	DescribeIngress(ctx context.Context, namespace, name string) (string, error)
+	DescribeJob(ctx context.Context, namespace, name string) (string, error)
+	DescribeCronJob(ctx context.Context, namespace, name string) (string, error)
+	DescribeDaemonSet(ctx context.Context, namespace, name string) (string, error)
+	DescribeStatefulSet(ctx context.Context, namespace, name string) (string, error)
+	DescribeReplicaSet(ctx context.Context, namespace, name string) (string, error)
+	DescribeNode(ctx context.Context, name string) (string, error)
+	DescribeServiceAccount(ctx context.Context, namespace, name string) (string, error)
 
 	
 	RegisterResourceHandler(resourceType k8s.ResourceType, handler ResourceHandler)
@@ -312,16 +318,16 @@ type PluginAPI interface {
 	GetResourceHandler(resourceType k8s.ResourceType) (ResourceHandler, bool)
 
 	
-	DeletePod(namespace, name string) error
-	DeleteService(namespace, name string) error
-	DeleteDeployment(namespace, name string) error
-	DeleteConfigMap(namespace, name string) error
-	DeleteSecret(namespace, name string) error
-	DeleteIngress(namespace, name string) error
-	DeleteJob(namespace, name string) error
-	DeleteCronJob(namespace, name string) error
-	DeleteDaemonSet(namespace, name string) error
-	DeleteStatefulSet(namespace, name string) error
-	DeleteReplicaSet(namespace, name string) error
-	DeleteServiceAccount(namespace, name string) error
+	DeletePod(ctx context.Context, namespace, name string) error
+	DeleteService(ctx context.Context, namespace, name string) error
+	DeleteDeployment(ctx context.Context, namespace, name string) error
+	DeleteConfigMap(ctx context.Context, namespace, name string) error
+	DeleteSecret(ctx context.Context, namespace, name string) error
+	DeleteIngress(ctx context.Context, namespace, name string) error
+	DeleteJob(ctx context.Context, namespace, name string) error
+	DeleteCronJob(ctx context.Context, namespace, name string) error
+	DeleteDaemonSet(ctx context.Context, namespace, name string) error
+	DeleteStatefulSet(ctx context.Context, namespace, name string) error
+	DeleteReplicaSet(ctx context.Context, namespace, name string) error
+	DeleteServiceAccount(ctx context.Context, namespace, name string) error
 }
@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -249,12 +250,14 @@ func (lp *LuaPlugin) parseDisplayComponent(tbl *lua.LTable) DisplayComponent {
 	return dc
 }
 
-func (lp *LuaPlugin) GetResourceData(client k8s.Client, resourceType string, namespace string) ([]types.ResourceData, error) {
+func (lp *LuaPlugin) GetResourceData(ctx context.Context, client k8s.Client, resourceType string, namespace string) ([]types.ResourceData, error) {
 	logger.PluginDebug(lp.pluginName, fmt.Sprintf("Calling GetResourceData(%s, %s)", resourceType, namespace))
 
 	
 	lp.L.SetGlobal("k8s_client", lua.LString("available")) 
 
+	lp.L.SetContext(ctx)
+	defer lp.L.RemoveContext()
 	if err := lp.L.CallByParam(lua.P{
 		Fn:      lp.L.GetGlobal("GetResourceData"),
 		NRet:    1,
@@ -314,13 +317,15 @@ func (lp *LuaPlugin) parseResourceData(tbl *lua.LTable) types.ResourceData {
 	}
 }
 
-func (lp *LuaPlugin) DeleteResource(client k8s.Client, resourceType string, namespace string, name string) error {
+func (lp *LuaPlugin) DeleteResource(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string) error {
 	logger.Debug(fmt.Sprintf("🔌 Lua Plugin: Calling DeleteResource(%s, %s, %s)", resourceType, namespace, name))
 
 	if lp.L.GetGlobal("DeleteResource").Type() != lua.LTFunction {
 		logger.Error("🔌 Lua Plugin: DeleteResource function not defined")
 		return fmt.Errorf("DeleteResource function not defined")
 	}
+	lp.L.SetContext(ctx)
+	defer lp.L.RemoveContext()
 	if err := lp.L.CallByParam(lua.P{
 		Fn:      lp.L.GetGlobal("DeleteResource"),
 		NRet:    1,
@@ -341,13 +346,43 @@ func (lp *LuaPlugin) DeleteResource(client k8s.Client, resourceType string, name
 	return nil
 }
 
-func (lp *LuaPlugin) GetResourceInfo(client k8s.Client, resourceType string, namespace string, name string) (*k8s.ResourceInfo, error) {
+func (lp *LuaPlugin) PatchResource(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string, patch []byte) error {
+	logger.Debug(fmt.Sprintf("🔌 Lua Plugin: Calling PatchResource(%s, %s, %s)", resourceType, namespace, name))
+
+	if lp.L.GetGlobal("PatchResource").Type() != lua.LTFunction {
+		return fmt.Errorf("%s does not support editing %s resources", lp.Name(), resourceType)
+	}
+	lp.L.SetContext(ctx)
+	defer lp.L.RemoveContext()
+	if err := lp.L.CallByParam(lua.P{
+		Fn:      lp.L.GetGlobal("PatchResource"),
+		NRet:    1,
+		Protect: true,
+	}, lua.LString(resourceType), lua.LString(namespace), lua.LString(name), lua.LString(patch)); err != nil {
+		logger.Error(fmt.Sprintf("🔌 Lua Plugin: Error calling PatchResource(): %v", err))
+		return err
+	}
+	ret := lp.L.Get(-1)
+	lp.L.Pop(1)
+	if ret.Type() == lua.LTString {
+		errorMsg := ret.String()
+		logger.Error(fmt.Sprintf("🔌 Lua Plugin: PatchResource() returned error: %s", errorMsg))
+		return fmt.Errorf("%s", errorMsg)
+	}
+
+	logger.Debug("🔌 Lua Plugin: PatchResource() completed successfully")
+	return nil
+}
+
+func (lp *LuaPlugin) GetResourceInfo(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string) (*k8s.ResourceInfo, error) {
 	logger.Debug(fmt.Sprintf("🔌 Lua Plugin: Calling GetResourceInfo(%s, %s, %s)", resourceType, namespace, name))
 
 	if lp.L.GetGlobal("GetResourceInfo").Type() != lua.LTFunction {
 		logger.Error("🔌 Lua Plugin: GetResourceInfo function not defined")
 		return nil, fmt.Errorf("GetResourceInfo function not defined")
 	}
+	lp.L.SetContext(ctx)
+	defer lp.L.RemoveContext()
 	if err := lp.L.CallByParam(lua.P{
 		Fn:      lp.L.GetGlobal("GetResourceInfo"),
 		NRet:    1,
@@ -470,6 +505,13 @@ func (lp *LuaPlugin) parseInteraction(tbl *lua.LTable) Interaction {
 	}
 }
 
+func luaContext(L *lua.LState) context.Context {
+	if ctx := L.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
 func (lp *LuaPlugin) getStringField(tbl *lua.LTable, key string) string {
 	val := tbl.RawGetString(key)
 	if val.Type() == lua.LTString {
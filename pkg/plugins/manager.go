@@ -206,7 +206,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			pods, err := k8s.FetchPods(client, namespace, "")
+			pods, err := k8s.FetchPods(luaContext(L), client, namespace, "")
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch pods: %v", err)))
 				return 1
@@ -233,7 +233,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			services, err := k8s.GetServicesTableData(client, namespace)
+			services, err := k8s.GetServicesTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch services: %v", err)))
 				return 1
@@ -261,7 +261,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			deployments, err := k8s.GetDeploymentsTableData(client, namespace)
+			deployments, err := k8s.GetDeploymentsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch deployments: %v", err)))
 				return 1
@@ -288,7 +288,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			configmaps, err := k8s.FetchConfigmaps(client, namespace, "")
+			configmaps, err := k8s.FetchConfigmaps(luaContext(L), client, namespace, "")
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch configmaps: %v", err)))
 				return 1
@@ -313,7 +313,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			secrets, err := k8s.GetSecretsTableData(client, namespace)
+			secrets, err := k8s.GetSecretsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch secrets: %v", err)))
 				return 1
@@ -339,7 +339,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			ingresses, err := k8s.GetIngressesTableData(client, namespace)
+			ingresses, err := k8s.GetIngressesTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch ingresses: %v", err)))
 				return 1
@@ -367,7 +367,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			jobs, err := k8s.GetJobsTableData(client, namespace)
+			jobs, err := k8s.GetJobsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch jobs: %v", err)))
 				return 1
@@ -393,7 +393,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			cronjobs, err := k8s.GetCronJobsTableData(client, namespace)
+			cronjobs, err := k8s.GetCronJobsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch cronjobs: %v", err)))
 				return 1
@@ -421,7 +421,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			daemonsets, err := k8s.GetDaemonSetsTableData(client, namespace)
+			daemonsets, err := k8s.GetDaemonSetsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch daemonsets: %v", err)))
 				return 1
@@ -450,7 +450,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			statefulsets, err := k8s.GetStatefulSetsTableData(client, namespace)
+			statefulsets, err := k8s.GetStatefulSetsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch statefulsets: %v", err)))
 				return 1
@@ -475,7 +475,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			replicasets, err := k8s.GetReplicaSetsTableData(client, namespace)
+			replicasets, err := k8s.GetReplicaSetsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch replicasets: %v", err)))
 				return 1
@@ -501,7 +501,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			nodes, err := k8s.GetNodesTableData(client)
+			nodes, err := k8s.GetNodesTableData(luaContext(L), client)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch nodes: %v", err)))
 				return 1
@@ -526,7 +526,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			namespaces, err := k8s.FetchNamespaces(client)
+			namespaces, err := k8s.FetchNamespaces(luaContext(L), client)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch namespaces: %v", err)))
 				return 1
@@ -546,7 +546,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			serviceaccounts, err := k8s.GetServiceAccountsTableData(client, namespace)
+			serviceaccounts, err := k8s.GetServiceAccountsTableData(luaContext(L), client, namespace)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch serviceaccounts: %v", err)))
 				return 1
@@ -573,7 +573,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeletePod(client, namespace, name)
+			err := k8s.DeletePod(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete pod: %v", err)))
 				return 1
@@ -590,7 +590,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteService(client, namespace, name)
+			err := k8s.DeleteService(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete service: %v", err)))
 				return 1
@@ -607,7 +607,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteDeployment(client, namespace, name)
+			err := k8s.DeleteDeployment(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete deployment: %v", err)))
 				return 1
@@ -624,7 +624,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteConfigmap(client, namespace, name)
+			err := k8s.DeleteConfigmap(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete configmap: %v", err)))
 				return 1
@@ -641,7 +641,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteSecret(client, namespace, name)
+			err := k8s.DeleteSecret(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete secret: %v", err)))
 				return 1
@@ -658,7 +658,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteIngress(client, namespace, name)
+			err := k8s.DeleteIngress(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete ingress: %v", err)))
 				return 1
@@ -675,7 +675,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteJob(client, namespace, name)
+			err := k8s.DeleteJob(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete job: %v", err)))
 				return 1
@@ -692,7 +692,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteCronJob(client, namespace, name)
+			err := k8s.DeleteCronJob(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete cronjob: %v", err)))
 				return 1
@@ -709,7 +709,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteDaemonSet(client, namespace, name)
+			err := k8s.DeleteDaemonSet(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete daemonset: %v", err)))
 				return 1
@@ -726,7 +726,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteStatefulSet(client, namespace, name)
+			err := k8s.DeleteStatefulSet(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete statefulset: %v", err)))
 				return 1
@@ -743,7 +743,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteReplicaSet(client, namespace, name)
+			err := k8s.DeleteReplicaSet(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete replicaset: %v", err)))
 				return 1
@@ -760,7 +760,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 				L.Push(lua.LString("no kubernetes client available"))
 				return 1
 			}
-			err := k8s.DeleteServiceAccount(client, namespace, name)
+			err := k8s.DeleteServiceAccount(luaContext(L), client, namespace, name)
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to delete serviceaccount: %v", err)))
 				return 1
@@ -781,7 +781,7 @@ func (pm *PluginManager) loadLuaPlugin(path string) error {
 			}
 
 			
-			endpointSlices, err := client.Clientset.DiscoveryV1().EndpointSlices(namespace).List(context.Background(), metav1.ListOptions{})
+			endpointSlices, err := client.Clientset.DiscoveryV1().EndpointSlices(namespace).List(luaContext(L), metav1.ListOptions{})
 			if err != nil {
 				L.Push(lua.LString(fmt.Sprintf("failed to fetch endpoint slices: %v", err)))
 				return 1
@@ -983,36 +983,51 @@ func (pm *PluginManager) TriggerEvent(event PluginEvent, data interface{}) {
 	pm.api.TriggerEvent(event, data)
 }
 
-func (pm *PluginManager) GetCustomResourceData(client k8s.Client, resourceType string, namespace string) ([]types.ResourceData, error) {
+func (pm *PluginManager) GetCustomResourceData(ctx context.Context, client k8s.Client, resourceType string, namespace string) ([]types.ResourceData, error) {
 	
 	pm.api.SetClient(client)
 
 	for _, plugin := range pm.registry.resourcePlugins {
 		for _, rt := range plugin.GetResourceTypes() {
 			if rt.Type == resourceType {
-				return plugin.GetResourceData(client, resourceType, namespace)
+				return plugin.GetResourceData(ctx, client, resourceType, namespace)
 			}
 		}
 	}
 	return nil, fmt.Errorf("custom resource type %s not found", resourceType)
 }
 
-func (pm *PluginManager) DeleteCustomResource(client k8s.Client, resourceType string, namespace string, name string) error {
+func (pm *PluginManager) DeleteCustomResource(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string) error {
 	for _, plugin := range pm.registry.resourcePlugins {
 		for _, rt := range plugin.GetResourceTypes() {
 			if rt.Type == resourceType {
-				return plugin.DeleteResource(client, resourceType, namespace, name)
+				return plugin.DeleteResource(ctx, client, resourceType, namespace, name)
 			}
 		}
 	}
 	return fmt.Errorf("custom resource type %s not found", resourceType)
 }
 
-func (pm *PluginManager) GetCustomResourceInfo(client k8s.Client, resourceType string, namespace string, name string) (*k8s.ResourceInfo, error) {
+func (pm *PluginManager) PatchCustomResource(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string, patch []byte) error {
 	for _, plugin := range pm.registry.resourcePlugins {
 		for _, rt := range plugin.GetResourceTypes() {
 			if rt.Type == resourceType {
-				return plugin.GetResourceInfo(client, resourceType, namespace, name)
+				patcher, ok := plugin.(ResourcePatcher)
+				if !ok {
+					return fmt.Errorf("custom resource type %s does not support editing", resourceType)
+				}
+				return patcher.PatchResource(ctx, client, resourceType, namespace, name, patch)
+			}
+		}
+	}
+	return fmt.Errorf("custom resource type %s not found", resourceType)
+}
+
+func (pm *PluginManager) GetCustomResourceInfo(ctx context.Context, client k8s.Client, resourceType string, namespace string, name string) (*k8s.ResourceInfo, error) {
+	for _, plugin := range pm.registry.resourcePlugins {
+		for _, rt := range plugin.GetResourceTypes() {
+			if rt.Type == resourceType {
+				return plugin.GetResourceInfo(ctx, client, resourceType, namespace, name)
 			}
 		}
 	}
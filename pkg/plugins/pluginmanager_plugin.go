@@ -1,7 +1,6 @@
 package plugins
 
 import (
-	"context"
 	"fmt"
 	"github.com/otavioCosta2110/k8s-tui/pkg/format"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
@@ -388,7 +387,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetPods(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	pods, err := k8s.FetchPods(client, namespace, "")
+	pods, err := k8s.FetchPods(luaContext(L), client, namespace, "")
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch pods: %v", err)))
 		return 1
@@ -415,7 +414,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetServices(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	services, err := k8s.GetServicesTableData(client, namespace)
+	services, err := k8s.GetServicesTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch services: %v", err)))
 		return 1
@@ -443,7 +442,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetDeployments(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	deployments, err := k8s.GetDeploymentsTableData(client, namespace)
+	deployments, err := k8s.GetDeploymentsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch deployments: %v", err)))
 		return 1
@@ -470,7 +469,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetConfigMaps(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	configmaps, err := k8s.FetchConfigmaps(client, namespace, "")
+	configmaps, err := k8s.FetchConfigmaps(luaContext(L), client, namespace, "")
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch configmaps: %v", err)))
 		return 1
@@ -495,7 +494,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetSecrets(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	secrets, err := k8s.GetSecretsTableData(client, namespace)
+	secrets, err := k8s.GetSecretsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch secrets: %v", err)))
 		return 1
@@ -521,7 +520,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetIngresses(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	ingresses, err := k8s.GetIngressesTableData(client, namespace)
+	ingresses, err := k8s.GetIngressesTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch ingresses: %v", err)))
 		return 1
@@ -549,7 +548,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetJobs(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	jobs, err := k8s.GetJobsTableData(client, namespace)
+	jobs, err := k8s.GetJobsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch jobs: %v", err)))
 		return 1
@@ -575,7 +574,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetCronJobs(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	cronjobs, err := k8s.GetCronJobsTableData(client, namespace)
+	cronjobs, err := k8s.GetCronJobsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch cronjobs: %v", err)))
 		return 1
@@ -603,7 +602,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetDaemonSets(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	daemonsets, err := k8s.GetDaemonSetsTableData(client, namespace)
+	daemonsets, err := k8s.GetDaemonSetsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch daemonsets: %v", err)))
 		return 1
@@ -632,7 +631,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetStatefulSets(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	statefulsets, err := k8s.GetStatefulSetsTableData(client, namespace)
+	statefulsets, err := k8s.GetStatefulSetsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch statefulsets: %v", err)))
 		return 1
@@ -657,7 +656,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetReplicaSets(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	replicasets, err := k8s.GetReplicaSetsTableData(client, namespace)
+	replicasets, err := k8s.GetReplicaSetsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch replicasets: %v", err)))
 		return 1
@@ -683,7 +682,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetNodes(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	nodes, err := k8s.GetNodesTableData(client)
+	nodes, err := k8s.GetNodesTableData(luaContext(L), client)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch nodes: %v", err)))
 		return 1
@@ -708,7 +707,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetNamespaces(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	namespaces, err := k8s.FetchNamespaces(client)
+	namespaces, err := k8s.FetchNamespaces(luaContext(L), client)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch namespaces: %v", err)))
 		return 1
@@ -728,7 +727,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetServiceAccounts(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	serviceaccounts, err := k8s.GetServiceAccountsTableData(client, namespace)
+	serviceaccounts, err := k8s.GetServiceAccountsTableData(luaContext(L), client, namespace)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch serviceaccounts: %v", err)))
 		return 1
@@ -755,7 +754,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeletePod(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeletePod(client, namespace, name)
+	err := k8s.DeletePod(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete pod: %v", err)))
 		return 1
@@ -772,7 +771,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteService(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteService(client, namespace, name)
+	err := k8s.DeleteService(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete service: %v", err)))
 		return 1
@@ -789,7 +788,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteDeployment(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteDeployment(client, namespace, name)
+	err := k8s.DeleteDeployment(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete deployment: %v", err)))
 		return 1
@@ -806,7 +805,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteConfigMap(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteConfigmap(client, namespace, name)
+	err := k8s.DeleteConfigmap(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete configmap: %v", err)))
 		return 1
@@ -823,7 +822,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteSecret(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteSecret(client, namespace, name)
+	err := k8s.DeleteSecret(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete secret: %v", err)))
 		return 1
@@ -840,7 +839,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteIngress(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteIngress(client, namespace, name)
+	err := k8s.DeleteIngress(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete ingress: %v", err)))
 		return 1
@@ -857,7 +856,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteJob(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteJob(client, namespace, name)
+	err := k8s.DeleteJob(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete job: %v", err)))
 		return 1
@@ -874,7 +873,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteCronJob(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteCronJob(client, namespace, name)
+	err := k8s.DeleteCronJob(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete cronjob: %v", err)))
 		return 1
@@ -891,7 +890,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteDaemonSet(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteDaemonSet(client, namespace, name)
+	err := k8s.DeleteDaemonSet(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete daemonset: %v", err)))
 		return 1
@@ -908,7 +907,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteStatefulSet(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteStatefulSet(client, namespace, name)
+	err := k8s.DeleteStatefulSet(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete statefulset: %v", err)))
 		return 1
@@ -925,7 +924,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteReplicaSet(L *lua.LState) int {
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteReplicaSet(client, namespace, name)
+	err := k8s.DeleteReplicaSet(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete replicaset: %v", err)))
 		return 1
@@ -942,7 +941,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaDeleteServiceAccount(L *lua.LState) int
 		L.Push(lua.LString("no kubernetes client available"))
 		return 1
 	}
-	err := k8s.DeleteServiceAccount(client, namespace, name)
+	err := k8s.DeleteServiceAccount(luaContext(L), client, namespace, name)
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to delete serviceaccount: %v", err)))
 		return 1
@@ -962,7 +961,7 @@ func (p *PluginmanagerStyleLuaPlugin) luaGetEndpoints(L *lua.LState) int {
 	}
 
 	
-	endpointSlices, err := client.Clientset.DiscoveryV1().EndpointSlices(namespace).List(context.Background(), metav1.ListOptions{})
+	endpointSlices, err := client.Clientset.DiscoveryV1().EndpointSlices(namespace).List(luaContext(L), metav1.ListOptions{})
 	if err != nil {
 		L.Push(lua.LString(fmt.Sprintf("failed to fetch endpoint slices: %v", err)))
 		return 1
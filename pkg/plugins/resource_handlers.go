@@ -1,19 +1,20 @@
 package plugins
 
 import (
+	"context"
 	"github.com/otavioCosta2110/k8s-tui/internal/k8s/resources"
 )
 
 
 type ResourceHandler interface {
 	
-	Get(client k8s.Client, namespace string) (interface{}, error)
+	Get(ctx context.Context, client k8s.Client, namespace string) (interface{}, error)
 
 	
-	Delete(client k8s.Client, namespace, name string) error
+	Delete(ctx context.Context, client k8s.Client, namespace, name string) error
 
 	
-	Describe(client k8s.Client, namespace, name string) (string, error)
+	Describe(ctx context.Context, client k8s.Client, namespace, name string) (string, error)
 
 	
 	GetType() k8s.ResourceType
@@ -25,47 +26,47 @@ type DefaultResourceHandler struct {
 }
 
 
-func (h *DefaultResourceHandler) Get(client k8s.Client, namespace string) (interface{}, error) {
+func (h *DefaultResourceHandler) Get(ctx context.Context, client k8s.Client, namespace string) (interface{}, error) {
 	switch h.ResourceType {
 	case k8s.ResourceTypePod:
-		return k8s.FetchPods(client, namespace, "")
+		return k8s.FetchPods(ctx, client, namespace, "")
 	case k8s.ResourceTypeService:
-		return k8s.GetServicesTableData(client, namespace)
+		return k8s.GetServicesTableData(ctx, client, namespace)
 	case k8s.ResourceTypeDeployment:
-		return k8s.GetDeploymentsTableData(client, namespace)
+		return k8s.GetDeploymentsTableData(ctx, client, namespace)
 	case k8s.ResourceTypeConfigMap:
-		return k8s.FetchConfigmaps(client, namespace, "")
+		return k8s.FetchConfigmaps(ctx, client, namespace, "")
 	case k8s.ResourceTypeSecret:
-		return k8s.GetSecretsTableData(client, namespace)
+		return k8s.GetSecretsTableData(ctx, client, namespace)
 	case k8s.ResourceTypeIngress:
-		return k8s.GetIngressesTableData(client, namespace)
+		return k8s.GetIngressesTableData(ctx, client, namespace)
 	case k8s.ResourceTypeJob:
-		return k8s.GetJobsTableData(client, namespace)
+		return k8s.GetJobsTableData(ctx, client, namespace)
 	case k8s.ResourceTypeCronJob:
-		return k8s.GetCronJobsTableData(client, namespace)
+		return k8s.GetCronJobsTableData(ctx, client, namespace)
 	case k8s.ResourceTypeDaemonSet:
-		return k8s.GetDaemonSetsTableData(client, namespace)
+		return k8s.GetDaemonSetsTableData(ctx, client, namespace)
 	case k8s.ResourceTypeStatefulSet:
-		return k8s.GetStatefulSetsTableData(client, namespace)
+		return k8s.GetStatefulSetsTableData(ctx, client, namespace)
 	case k8s.ResourceTypeReplicaSet:
-		return k8s.GetReplicaSetsTableData(client, namespace)
+		return k8s.GetReplicaSetsTableData(ctx, client, namespace)
 	case k8s.ResourceTypeNode:
-		return k8s.GetNodesTableData(client)
+		return k8s.GetNodesTableData(ctx, client)
 	case k8s.ResourceTypeServiceAccount:
-		return k8s.GetServiceAccountsTableData(client, namespace)
+		return k8s.GetServiceAccountsTableData(ctx, client, namespace)
 	default:
 		return nil, ErrResourceTypeNotSupported{ResourceType: h.ResourceType}
 	}
 }
 
 
-func (h *DefaultResourceHandler) Delete(client k8s.Client, namespace, name string) error {
-	return k8s.DeleteResource(client, h.ResourceType, namespace, name)
+func (h *DefaultResourceHandler) Delete(ctx context.Context, client k8s.Client, namespace, name string) error {
+	return k8s.DeleteResource(ctx, client, h.ResourceType, namespace, name)
 }
 
 
-func (h *DefaultResourceHandler) Describe(client k8s.Client, namespace, name string) (string, error) {
-	return k8s.DescribeResource(client, h.ResourceType, namespace, name)
+func (h *DefaultResourceHandler) Describe(ctx context.Context, client k8s.Client, namespace, name string) (string, error) {
+	return k8s.DescribeResource(ctx, client, h.ResourceType, namespace, name)
 }
 
 
@@ -120,25 +121,25 @@ func (r *ResourceRegistry) GetHandler(resourceType k8s.ResourceType) (ResourceHa
 }
 
 
-func (r *ResourceRegistry) GetResource(client k8s.Client, resourceType k8s.ResourceType, namespace string) (interface{}, error) {
+func (r *ResourceRegistry) GetResource(ctx context.Context, client k8s.Client, resourceType k8s.ResourceType, namespace string) (interface{}, error) {
 	if handler, exists := r.handlers[resourceType]; exists {
-		return handler.Get(client, namespace)
+		return handler.Get(ctx, client, namespace)
 	}
 	return nil, ErrResourceTypeNotSupported{ResourceType: resourceType}
 }
 
 
-func (r *ResourceRegistry) DeleteResource(client k8s.Client, resourceType k8s.ResourceType, namespace, name string) error {
+func (r *ResourceRegistry) DeleteResource(ctx context.Context, client k8s.Client, resourceType k8s.ResourceType, namespace, name string) error {
 	if handler, exists := r.handlers[resourceType]; exists {
-		return handler.Delete(client, namespace, name)
+		return handler.Delete(ctx, client, namespace, name)
 	}
 	return ErrResourceTypeNotSupported{ResourceType: resourceType}
 }
 
 
-func (r *ResourceRegistry) DescribeResource(client k8s.Client, resourceType k8s.ResourceType, namespace, name string) (string, error) {
+func (r *ResourceRegistry) DescribeResource(ctx context.Context, client k8s.Client, resourceType k8s.ResourceType, namespace, name string) (string, error) {
 	if handler, exists := r.handlers[resourceType]; exists {
-		return handler.Describe(client, namespace, name)
+		return handler.Describe(ctx, client, namespace, name)
 	}
 	return "", ErrResourceTypeNotSupported{ResourceType: resourceType}
 }